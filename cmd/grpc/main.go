@@ -5,24 +5,35 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"net"
 	"os"
 	"time"
 
 	"github.com/joho/godotenv" //import manual
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/currency"
 	grpcmiddleware "github.com/luzmareto/go-grpc-ecommerce-be/internal/grpcMiddleware"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/handler"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/logger"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/mailer"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/metrics"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/payment"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/shipping"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/tax"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/auth"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/cart"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/newsletter"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/notification"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/order"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/product"
+	pbshipping "github.com/luzmareto/go-grpc-ecommerce-be/pb/shipping"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/voucher"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/wishlist"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
 	gocache "github.com/patrickmn/go-cache"
-	"github.com/xendit/xendit-go"
 	"google.golang.org/grpc"            //import manual
 	"google.golang.org/grpc/reflection" //import manual
 )
@@ -30,61 +41,167 @@ import (
 func main() {
 	ctx := context.Background()
 	godotenv.Load()
+	logger.Setup()
+	grpcmiddleware.LoadErrorModeFromEnv()
 
-	xendit.Opt.SecretKey = os.Getenv("XENDIT_SECRET")
+	err := utils.CheckCartTokenSecret()
+	if err != nil {
+		slog.Error("error when load cart token secret", "error", err)
+		os.Exit(1)
+	}
 
 	lis, err := net.Listen("tcp", ":50052")
 	if err != nil {
-		log.Panicf("error when listen %v", err)
+		slog.Error("error when listen", "error", err)
+		os.Exit(1)
 	}
 
 	db := database.ConnectDB(ctx, os.Getenv("DB_URI"))
-	log.Println("Connected to database")
+	slog.Info("Connected to database")
 
 	cacheService := gocache.New(time.Hour*24, time.Hour)
 
-	authMiddleware := grpcmiddleware.NewAuthMiddleware(cacheService)
+	paymentGateway := payment.NewPaymentGatewayFromEnv()
+	slog.Info("Payment provider", "provider", paymentGateway.Name())
+
+	rateTable := currency.NewRateTableFromEnv(ctx)
+	go rateTable.Run(ctx)
+
+	mailService, err := mailer.NewMailerFromEnv()
+	if err != nil {
+		slog.Error("error when create mailer", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("Mailer provider", "provider", mailService.Name())
+
+	revokedTokenRepository := repository.NewRevokedTokenRepository(db)
+	tokenRevocationService := service.NewTokenRevocationService(revokedTokenRepository, cacheService)
+	go tokenRevocationService.Run(ctx)
+
+	authMiddleware := grpcmiddleware.NewAuthMiddleware(tokenRevocationService)
+
+	cartRepository := repository.NewCartRepository(db)
 
 	authRepository := repository.NewAuthRepository(db)
-	authService := service.NewAuthService(authRepository, cacheService)
+	authService := service.NewAuthService(db, authRepository, cartRepository, tokenRevocationService)
 	authHandler := handler.NewAuthHandler(authService)
 
 	productRepository := repository.NewProductRepository(db)
-	productService := service.NewProductService(productRepository)
+	outboxRepository := repository.NewOutboxRepository(db)
+	productService := service.NewProductService(db, productRepository, outboxRepository, rateTable)
 	productHandler := handler.NewProductHandler(productService)
 
-	cartRepository := repository.NewCartRepository(db)
-	cartService := service.NewCartService(productRepository, cartRepository)
+	productSubscriptionRepository := repository.NewProductSubscriptionRepository(db)
+	productSubscriptionService := service.NewProductSubscriptionService(productRepository, productSubscriptionRepository)
+	productSubscriptionHandler := handler.NewProductSubscriptionHandler(productSubscriptionService)
+
+	cartService := service.NewCartService(db, productRepository, cartRepository, rateTable)
 	cartHandler := handler.NewCartHandler(cartService)
 
+	cartAbandonmentTracker := service.NewCartAbandonmentTracker(cartRepository)
+	go cartAbandonmentTracker.Run(ctx)
+
+	guestCartCleaner := service.NewGuestCartCleaner(cartRepository)
+	go guestCartCleaner.Run(ctx)
+
+	wishlistRepository := repository.NewWishlistRepository(db)
+	wishlistService := service.NewWishlistService(db, wishlistRepository, productRepository, cartRepository, rateTable)
+	wishlistHandler := handler.NewWishlistHandler(wishlistService)
+
+	addressRepository := repository.NewAddressRepository(db)
+	shippingRateCalculator := shipping.NewShippingRateCalculatorFromEnv()
+	shippingService := service.NewShippingService(db, addressRepository, productRepository, shippingRateCalculator)
+	shippingHandler := handler.NewShippingHandler(shippingService)
+
+	voucherRepository := repository.NewVoucherRepository(db)
+	voucherService := service.NewVoucherService(voucherRepository)
+	voucherHandler := handler.NewVoucherHandler(voucherService)
+
+	taxCalculator := tax.NewTaxCalculatorFromEnv()
 	orderRepository := repository.NewOrderRepository(db)
-	orderService := service.NewOrderService(db, orderRepository, productRepository)
+	refundRepository := repository.NewRefundRepository(db)
+	orderService := service.NewOrderService(db, orderRepository, productRepository, outboxRepository, refundRepository, paymentGateway, addressRepository, shippingRateCalculator, voucherRepository, taxCalculator, rateTable)
 	orderHandler := handler.NewOrderHandler(orderService)
 
+	refundStatusDispatcher := service.NewRefundStatusDispatcher(db, orderRepository, refundRepository, paymentGateway)
+	go refundStatusDispatcher.Run(ctx)
+
+	paymentLinkDispatcher := service.NewPaymentLinkDispatcher(db, orderRepository, outboxRepository, paymentGateway)
+	go paymentLinkDispatcher.Run(ctx)
+
+	orderEmailDispatcher := service.NewOrderEmailDispatcher(orderRepository, authRepository, outboxRepository, mailService)
+	go orderEmailDispatcher.Run(ctx)
+
+	// notifikasi diteruskan lewat LISTEN/NOTIFY postgres agar stream di semua instance server menerimanya
+	notificationBroker := service.NewPostgresNotificationBroker(db, os.Getenv("DB_URI"))
+	go notificationBroker.Run(ctx)
+	notificationRepository := repository.NewNotificationRepository(db)
+	notificationService := service.NewNotificationService(notificationRepository, notificationBroker, tokenRevocationService)
+	notificationHandler := handler.NewNotificationHandler(notificationService)
+
+	orderNotificationDispatcher := service.NewOrderNotificationDispatcher(orderRepository, notificationRepository, outboxRepository, notificationBroker)
+	go orderNotificationDispatcher.Run(ctx)
+
+	productNotificationDispatcher := service.NewProductNotificationDispatcher(productRepository, productSubscriptionRepository, outboxRepository, mailService)
+	go productNotificationDispatcher.Run(ctx)
+
 	newsletterRepository := repository.NewNewsLetterRespository((db))
-	newsletterService := service.NewNewsLetterService(newsletterRepository)
-	newsletterHandler := handler.NewNewsletterHandler(newsletterService)
+	newsletterService := service.NewNewsLetterService(db, newsletterRepository, outboxRepository)
+	newsletterHandler := handler.NewNewsletterHandler(newsletterService, tokenRevocationService)
+
+	newsletterConfirmDispatcher := service.NewNewsletterConfirmDispatcher(newsletterRepository, outboxRepository, mailService)
+	go newsletterConfirmDispatcher.Run(ctx)
+
+	newsletterCampaignRepository := repository.NewNewsletterCampaignRepository(db)
+	newsletterCampaignService := service.NewNewsletterCampaignService(newsletterCampaignRepository)
+	newsletterCampaignHandler := handler.NewNewsletterCampaignHandler(newsletterCampaignService)
+
+	newsletterCampaignWorker := service.NewNewsletterCampaignWorker(db, newsletterCampaignRepository, mailService)
+	go newsletterCampaignWorker.Run(ctx)
 
 	serv := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
+			grpcmiddleware.LoggingMiddleware,
+			grpcmiddleware.MetricsMiddleware,
 			grpcmiddleware.ErrorMiddleware,
 			authMiddleware.Middleware,
+			grpcmiddleware.ValidationMiddleware,
+		),
+		grpc.ChainStreamInterceptor(
+			grpcmiddleware.StreamLoggingMiddleware,
+			grpcmiddleware.StreamMetricsMiddleware,
+			grpcmiddleware.StreamErrorMiddleware,
+			authMiddleware.StreamMiddleware,
 		),
 	)
 
 	auth.RegisterAuthServiceServer(serv, authHandler)
 	product.RegisterProductServiceServer(serv, productHandler)
+	product.RegisterProductSubscriptionServiceServer(serv, productSubscriptionHandler)
 	cart.RegisterCartServiceServer(serv, cartHandler)
 	order.RegisterOrderServiceServer(serv, orderHandler)
 	newsletter.RegisterNewsletterServiceServer(serv, newsletterHandler)
+	newsletter.RegisterNewsletterCampaignServiceServer(serv, newsletterCampaignHandler)
+	notification.RegisterNotificationServiceServer(serv, notificationHandler)
+	pbshipping.RegisterShippingServiceServer(serv, shippingHandler)
+	voucher.RegisterVoucherServiceServer(serv, voucherHandler)
+	wishlist.RegisterWishlistServiceServer(serv, wishlistHandler)
 
 	if os.Getenv("ENVIRONMENT") == "dev" {
 		reflection.Register(serv)
-		log.Println("Reflection is registered.")
+		slog.Info("Reflection is registered.")
+	}
+
+	// endpoint /metrics untuk prometheus dijalankan di port terpisah dari grpc
+	metricsAddr := os.Getenv("METRICS_ADDR")
+	if metricsAddr == "" {
+		metricsAddr = ":9090"
 	}
+	go metrics.Serve(metricsAddr)
 
-	log.Println("Server is runing on :50052 port.")
+	slog.Info("Server is runing on :50052 port.")
 	if err := serv.Serve(lis); err != nil {
-		log.Panicf("Server is error %v", err)
+		slog.Error("Server is error", "error", err)
+		os.Exit(1)
 	}
 }
@@ -5,19 +5,25 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"mime"
 	"net/http"
 	"os"
 	"path"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/joho/godotenv"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/handler"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/logger"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/metrics"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/payment"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+	restmiddleware "github.com/luzmareto/go-grpc-ecommerce-be/internal/restMiddleware"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
+	gocache "github.com/patrickmn/go-cache"
 )
 
 func handlerGetFileName(c *fiber.Ctx) error {
@@ -27,13 +33,13 @@ func handlerGetFileName(c *fiber.Ctx) error {
 		if os.IsNotExist(err) {
 			return c.Status(http.StatusNotFound).SendString("Not found")
 		}
-		log.Println(err)
+		slog.ErrorContext(c.UserContext(), "stat product image failed", "error", err)
 		return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
 	}
 
 	file, err := os.Open(filePath)
 	if err != nil {
-		log.Println(err)
+		slog.ErrorContext(c.UserContext(), "open product image failed", "error", err)
 		return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
 	}
 
@@ -47,14 +53,36 @@ func handlerGetFileName(c *fiber.Ctx) error {
 
 func main() {
 	godotenv.Load()
+	logger.Setup()
 	ctx := context.Background()
 	app := fiber.New()
 
 	db := database.ConnectDB(ctx, os.Getenv("DB_URI"))
 	orderRepository := repository.NewOrderRepository(db)
-	webhookService := service.NewWebhookService(orderRepository)
-	webHookHandler := handler.NewWebhookHandler(webhookService)
+	paymentGateway := payment.NewPaymentGatewayFromEnv()
+	webhookEventRepository := repository.NewWebhookEventRepository(db)
+	outboxRepository := repository.NewOutboxRepository(db)
+	webhookService := service.NewWebhookService(db, orderRepository, webhookEventRepository, outboxRepository)
+	webHookHandler := handler.NewWebhookHandler(webhookService, paymentGateway)
 
+	productRepository := repository.NewProductRepository(db)
+	productSubscriptionRepository := repository.NewProductSubscriptionRepository(db)
+	productSubscriptionService := service.NewProductSubscriptionService(productRepository, productSubscriptionRepository)
+	productSubscriptionHandler := handler.NewProductSubscriptionHandler(productSubscriptionService)
+
+	newsletterRepository := repository.NewNewsLetterRespository(db)
+	newsletterService := service.NewNewsLetterService(db, newsletterRepository, outboxRepository)
+	// daftar token logout dibaca dari database yang sama dengan server grpc
+	revokedTokenRepository := repository.NewRevokedTokenRepository(db)
+	tokenRevocationService := service.NewTokenRevocationService(revokedTokenRepository, gocache.New(time.Hour, time.Hour))
+	newsletterHandler := handler.NewNewsletterHandler(newsletterService, tokenRevocationService)
+
+	newsletterCampaignRepository := repository.NewNewsletterCampaignRepository(db)
+	newsletterCampaignService := service.NewNewsletterCampaignService(newsletterCampaignRepository)
+	newsletterCampaignHandler := handler.NewNewsletterCampaignHandler(newsletterCampaignService)
+
+	app.Use(restmiddleware.LoggingMiddleware)
+	app.Use(restmiddleware.MetricsMiddleware)
 	app.Use(cors.New())
 
 	app.Get("/storage/products/:filename", handlerGetFileName) // Untuk List Product
@@ -65,5 +93,36 @@ func main() {
 
 	app.Post("/webhook/xendit/invoice", webHookHandler.ReceiveInvoice)
 
+	// link unsubscribe notifikasi produk dari email, GET hanya menampilkan halaman konfirmasi
+	app.Get("/product-subscription/unsubscribe", productSubscriptionHandler.UnsubscribePage)
+	app.Post("/product-subscription/unsubscribe", productSubscriptionHandler.Unsubscribe)
+
+	// link konfirmasi dan unsubscribe newsletter dari email, GET unsubscribe hanya menampilkan halaman konfirmasi
+	app.Get("/newsletter/confirm", newsletterHandler.Confirm)
+	app.Get("/newsletter/unsubscribe", newsletterHandler.UnsubscribePage)
+	app.Post("/newsletter/unsubscribe", newsletterHandler.Unsubscribe)
+
+	// export dan import subscriber newsletter untuk admin
+	app.Get("/newsletter/subscribers/export", newsletterHandler.ExportSubscribers)
+	app.Post("/newsletter/subscribers/import", newsletterHandler.ImportSubscribers)
+
+	// tracking buka email dan klik link campaign newsletter
+	app.Get("/newsletter/track/open", newsletterCampaignHandler.TrackOpen)
+	app.Get("/newsletter/track/click", newsletterCampaignHandler.TrackClick)
+
+	// halaman pembayaran simulasi untuk development lokal
+	if fakePaymentGateway, ok := paymentGateway.(payment.IFakePaymentGateway); ok {
+		fakePaymentHandler := handler.NewFakePaymentHandler(webhookService, fakePaymentGateway)
+		app.Get("/payment/fake/invoices/:invoiceId", fakePaymentHandler.InvoicePage)
+		app.Post("/payment/fake/invoices/:invoiceId/pay", fakePaymentHandler.Pay)
+	}
+
+	// endpoint /metrics tidak dipasang di app publik, prometheus membaca dari port terpisah
+	metricsAddr := os.Getenv("REST_METRICS_ADDR")
+	if metricsAddr == "" {
+		metricsAddr = ":9091"
+	}
+	go metrics.Serve(metricsAddr)
+
 	app.Listen(":3000")
 }
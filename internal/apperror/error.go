@@ -0,0 +1,232 @@
+package apperror
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Domain dikirim di ErrorInfo agar client bisa membedakan error dari service ini
+const Domain = "go-grpc-ecommerce-be"
+
+const (
+	ReasonValidation         = "VALIDATION_FAILED"
+	ReasonBadRequest         = "BAD_REQUEST"
+	ReasonNotFound           = "NOT_FOUND"
+	ReasonPermissionDenied   = "PERMISSION_DENIED"
+	ReasonUnauthenticated    = "UNAUTHENTICATED"
+	ReasonAlreadyExists      = "ALREADY_EXISTS"
+	ReasonFailedPrecondition = "FAILED_PRECONDITION"
+	ReasonRateLimited        = "RATE_LIMITED"
+	ReasonUnavailable        = "UNAVAILABLE"
+	ReasonInternal           = "INTERNAL"
+)
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error adalah error domain yang dipetakan ke status grpc beserta detailnya
+type Error struct {
+	Code            codes.Code
+	Reason          string
+	Message         string
+	Metadata        map[string]string
+	FieldViolations []*FieldViolation
+	// diisi jika client boleh mencoba lagi setelah durasi ini
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Reason, e.Message)
+}
+
+// WithMetadata menambahkan informasi tambahan ke ErrorInfo, contoh id produk yang tidak ditemukan
+func (e *Error) WithMetadata(key string, value string) *Error {
+	if e.Metadata == nil {
+		e.Metadata = make(map[string]string)
+	}
+	e.Metadata[key] = value
+
+	return e
+}
+
+// BaseResponse untuk client lama yang masih membaca field base
+func (e *Error) BaseResponse() *common.BaseResponse {
+	base := &common.BaseResponse{
+		StatusCode: httpStatusCodes[e.Code],
+		Message:    e.Message,
+		IsError:    true,
+	}
+	if base.StatusCode == 0 {
+		base.StatusCode = http.StatusInternalServerError
+	}
+	if len(e.FieldViolations) > 0 {
+		base.Message = "validation error"
+		base.ValidationErrors = make([]*common.ValidationError, 0)
+		for _, violation := range e.FieldViolations {
+			base.ValidationErrors = append(base.ValidationErrors, &common.ValidationError{
+				Field:   violation.Field,
+				Message: violation.Description,
+			})
+		}
+	}
+
+	return base
+}
+
+// GRPCStatus dipakai status.FromError dan status.Code, detail berisi ErrorInfo, BadRequest, RetryInfo dan BaseResponse
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(e.Code, e.Message)
+
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{
+			Reason:   e.Reason,
+			Domain:   Domain,
+			Metadata: e.Metadata,
+		},
+	}
+	if len(e.FieldViolations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, violation := range e.FieldViolations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+			})
+		}
+		details = append(details, badRequest)
+	}
+	if e.RetryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{
+			RetryDelay: durationpb.New(e.RetryAfter),
+		})
+	}
+	details = append(details, e.BaseResponse())
+
+	stWithDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+
+	return stWithDetails
+}
+
+// IsStatus mengembalikan true jika status dibuat dari Error, contoh status yang sudah dikembalikan interceptor lain
+func IsStatus(st *status.Status) bool {
+	for _, detail := range st.Details() {
+		if errorInfo, ok := detail.(*errdetails.ErrorInfo); ok && errorInfo.Domain == Domain {
+			return true
+		}
+	}
+
+	return false
+}
+
+func New(code codes.Code, reason string, message string) *Error {
+	return &Error{
+		Code:    code,
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+func BadRequest(message string) *Error {
+	return New(codes.InvalidArgument, ReasonBadRequest, message)
+}
+
+func Validation(violations []*FieldViolation) *Error {
+	err := New(codes.InvalidArgument, ReasonValidation, "validation error")
+	err.FieldViolations = violations
+
+	return err
+}
+
+func NotFound(message string) *Error {
+	return New(codes.NotFound, ReasonNotFound, message)
+}
+
+func PermissionDenied(message string) *Error {
+	return New(codes.PermissionDenied, ReasonPermissionDenied, message)
+}
+
+func AlreadyExists(message string) *Error {
+	return New(codes.AlreadyExists, ReasonAlreadyExists, message)
+}
+
+func FailedPrecondition(message string) *Error {
+	return New(codes.FailedPrecondition, ReasonFailedPrecondition, message)
+}
+
+func RateLimited(message string, retryAfter time.Duration) *Error {
+	err := New(codes.ResourceExhausted, ReasonRateLimited, message)
+	err.RetryAfter = retryAfter
+
+	return err
+}
+
+func Unavailable(message string, retryAfter time.Duration) *Error {
+	err := New(codes.Unavailable, ReasonUnavailable, message)
+	err.RetryAfter = retryAfter
+
+	return err
+}
+
+// Internal tidak membawa pesan error asli agar detail internal tidak bocor ke client
+func Internal() *Error {
+	return New(codes.Internal, ReasonInternal, "internal server error")
+}
+
+// FromBaseResponse mengubah BaseResponse error dari service menjadi Error sesuai status_code-nya
+func FromBaseResponse(base *common.BaseResponse) *Error {
+	if len(base.ValidationErrors) > 0 {
+		violations := make([]*FieldViolation, 0)
+		for _, validationError := range base.ValidationErrors {
+			violations = append(violations, &FieldViolation{
+				Field:       validationError.Field,
+				Description: validationError.Message,
+			})
+		}
+		return Validation(violations)
+	}
+
+	switch base.StatusCode {
+	case http.StatusBadRequest:
+		return BadRequest(base.Message)
+	case http.StatusUnauthorized:
+		return New(codes.Unauthenticated, ReasonUnauthenticated, base.Message)
+	case http.StatusForbidden:
+		return PermissionDenied(base.Message)
+	case http.StatusNotFound:
+		return NotFound(base.Message)
+	case http.StatusConflict:
+		return AlreadyExists(base.Message)
+	case http.StatusTooManyRequests:
+		return RateLimited(base.Message, 0)
+	case http.StatusServiceUnavailable:
+		return Unavailable(base.Message, 0)
+	}
+
+	return New(codes.Unknown, ReasonInternal, base.Message)
+}
+
+var httpStatusCodes = map[codes.Code]int64{
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.Aborted:            http.StatusConflict,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.Internal:           http.StatusInternalServerError,
+}
@@ -0,0 +1,58 @@
+package currency
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+)
+
+const (
+	SourceFile = "FILE"
+
+	defaultRateFile = "config/currency_rates.json"
+)
+
+// fileRateSource membaca kurs dari file json sebagai pengganti API kurs,
+// file dibaca ulang setiap refresh sehingga perubahan kurs tidak perlu restart
+type fileRateSource struct {
+	path string
+}
+
+type fileRates struct {
+	Base  string             `json:"base"`
+	Rates map[string]float64 `json:"rates"`
+}
+
+func (fs *fileRateSource) Name() string {
+	return SourceFile
+}
+
+func (fs *fileRateSource) FetchRates(ctx context.Context) (*RateSnapshot, error) {
+	content, err := os.ReadFile(fs.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rates fileRates
+	err = json.Unmarshal(content, &rates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RateSnapshot{
+		Base:      rates.Base,
+		Rates:     rates.Rates,
+		FetchedAt: time.Now(),
+	}, nil
+}
+
+func NewFileRateSource(path string) IRateSource {
+	if path == "" {
+		path = defaultRateFile
+	}
+
+	return &fileRateSource{
+		path: path,
+	}
+}
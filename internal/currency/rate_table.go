@@ -0,0 +1,266 @@
+package currency
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
+)
+
+const defaultRefreshInterval = time.Hour
+
+var ErrUnsupportedCurrency = errors.New("currency is not supported")
+
+// RateSnapshot berisi kurs dari satu kali pengambilan,
+// Rates[c] adalah nilai 1 satuan mayor Base dalam satuan mayor c
+type RateSnapshot struct {
+	Base      string
+	Rates     map[string]float64
+	FetchedAt time.Time
+}
+
+// IRateSource adalah sumber kurs, API kurs asli cukup memenuhi interface ini
+type IRateSource interface {
+	Name() string
+	FetchRates(ctx context.Context) (*RateSnapshot, error)
+}
+
+type IRateTable interface {
+	Run(ctx context.Context)
+	Refresh(ctx context.Context) error
+	BaseCurrency() string
+	IsSupported(currency string) bool
+	GetRate(from string, to string) (float64, error)
+	Convert(amount money.Money, to string) (money.Money, error)
+	Snapshot() *RateSnapshot
+}
+
+type rateTable struct {
+	source          IRateSource
+	baseCurrency    string
+	refreshInterval time.Duration
+
+	mu        sync.RWMutex
+	rates     map[string]float64
+	fetchedAt time.Time
+}
+
+// Run memperbarui kurs secara berkala sampai ctx dibatalkan
+func (rt *rateTable) Run(ctx context.Context) {
+	ticker := time.NewTicker(rt.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := rt.Refresh(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "currency rate refresh error", "error", err)
+		}
+	}
+}
+
+// Refresh mengambil kurs terbaru dari source, jika gagal kurs sebelumnya tetap dipakai
+func (rt *rateTable) Refresh(ctx context.Context) error {
+	snapshot, err := rt.source.FetchRates(ctx)
+	if err != nil {
+		return err
+	}
+
+	snapshotBase := money.NormalizeCurrency(snapshot.Base)
+	baseRate, ok := snapshot.Rates[rt.baseCurrency]
+	if snapshotBase == rt.baseCurrency {
+		baseRate, ok = 1, true
+	}
+	if !ok || baseRate <= 0 {
+		return errors.New("rate source does not contain the base currency " + rt.baseCurrency)
+	}
+
+	// kurs disimpan relatif terhadap base currency aplikasi
+	rates := map[string]float64{
+		rt.baseCurrency: 1,
+	}
+	if snapshotBase != rt.baseCurrency {
+		rates[snapshotBase] = 1 / baseRate
+	}
+	for currency, rate := range snapshot.Rates {
+		currency = money.NormalizeCurrency(currency)
+		if rate <= 0 || currency == rt.baseCurrency {
+			continue
+		}
+		rates[currency] = rate / baseRate
+	}
+
+	rt.mu.Lock()
+	rt.rates = rates
+	rt.fetchedAt = snapshot.FetchedAt
+	rt.mu.Unlock()
+
+	slog.InfoContext(ctx, "currency rates refreshed", "source", rt.source.Name(), "currencies", len(rates))
+	return nil
+}
+
+func (rt *rateTable) BaseCurrency() string {
+	return rt.baseCurrency
+}
+
+func (rt *rateTable) IsSupported(currency string) bool {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+
+	_, ok := rt.rates[money.NormalizeCurrency(currency)]
+	return ok
+}
+
+// GetRate mengembalikan nilai 1 satuan mayor from dalam satuan mayor to
+func (rt *rateTable) GetRate(from string, to string) (float64, error) {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+
+	return rateBetween(rt.rates, from, to)
+}
+
+// Convert mengubah nominal ke mata uang to dengan pembulatan money.FromMajor,
+// to kosong berarti nominal dikembalikan dalam mata uang aslinya
+func (rt *rateTable) Convert(amount money.Money, to string) (money.Money, error) {
+	if strings.TrimSpace(to) == "" {
+		return money.New(amount.Amount, amount.Currency), nil
+	}
+
+	rate, err := rt.GetRate(amount.Currency, to)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return ConvertWithRate(amount, to, rate), nil
+}
+
+// Snapshot menyalin kurs saat ini relatif terhadap base currency,
+// dipakai jika beberapa nominal harus dikonversi dengan kurs yang sama walaupun tabel diperbarui di tengah proses
+func (rt *rateTable) Snapshot() *RateSnapshot {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+
+	rates := make(map[string]float64, len(rt.rates))
+	for currency, rate := range rt.rates {
+		rates[currency] = rate
+	}
+
+	return &RateSnapshot{
+		Base:      rt.baseCurrency,
+		Rates:     rates,
+		FetchedAt: rt.fetchedAt,
+	}
+}
+
+// GetRate mengembalikan nilai 1 satuan mayor from dalam satuan mayor to dari kurs snapshot
+func (rs *RateSnapshot) GetRate(from string, to string) (float64, error) {
+	rates := map[string]float64{
+		money.NormalizeCurrency(rs.Base): 1,
+	}
+	for currency, rate := range rs.Rates {
+		rates[money.NormalizeCurrency(currency)] = rate
+	}
+
+	return rateBetween(rates, from, to)
+}
+
+// Convert sama dengan IRateTable.Convert tetapi memakai kurs snapshot
+func (rs *RateSnapshot) Convert(amount money.Money, to string) (money.Money, error) {
+	if strings.TrimSpace(to) == "" {
+		return money.New(amount.Amount, amount.Currency), nil
+	}
+
+	rate, err := rs.GetRate(amount.Currency, to)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return ConvertWithRate(amount, to, rate), nil
+}
+
+// rateBetween menghitung kurs from ke to dari rates yang relatif terhadap satu base
+func rateBetween(rates map[string]float64, from string, to string) (float64, error) {
+	from = money.NormalizeCurrency(from)
+	to = money.NormalizeCurrency(to)
+	if from == to {
+		return 1, nil
+	}
+
+	fromRate, ok := rates[from]
+	if !ok {
+		return 0, ErrUnsupportedCurrency
+	}
+	toRate, ok := rates[to]
+	if !ok {
+		return 0, ErrUnsupportedCurrency
+	}
+
+	return toRate / fromRate, nil
+}
+
+// ConvertWithRate mengubah nominal dengan kurs yang sudah dikunci, contoh kurs yang tersimpan di order
+func ConvertWithRate(amount money.Money, to string, rate float64) money.Money {
+	to = money.NormalizeCurrency(to)
+	if money.NormalizeCurrency(amount.Currency) == to {
+		return money.New(amount.Amount, to)
+	}
+
+	return money.FromMajor(amount.Major()*rate, to)
+}
+
+func NewRateTable(source IRateSource, baseCurrency string, refreshInterval time.Duration) IRateTable {
+	if refreshInterval <= 0 {
+		refreshInterval = defaultRefreshInterval
+	}
+
+	baseCurrency = money.NormalizeCurrency(baseCurrency)
+	return &rateTable{
+		source:          source,
+		baseCurrency:    baseCurrency,
+		refreshInterval: refreshInterval,
+		rates: map[string]float64{
+			baseCurrency: 1,
+		},
+	}
+}
+
+// NewRateTableFromEnv membaca CURRENCY_BASE, CURRENCY_RATE_SOURCE, CURRENCY_RATE_FILE dan CURRENCY_RATE_REFRESH_INTERVAL,
+// kurs langsung diambil sekali saat start
+func NewRateTableFromEnv(ctx context.Context) IRateTable {
+	var source IRateSource
+	switch strings.ToLower(os.Getenv("CURRENCY_RATE_SOURCE")) {
+	case "file", "":
+		source = NewFileRateSource(os.Getenv("CURRENCY_RATE_FILE"))
+	default:
+		slog.Warn("unknown currency rate source, using file", "source", os.Getenv("CURRENCY_RATE_SOURCE"))
+		source = NewFileRateSource(os.Getenv("CURRENCY_RATE_FILE"))
+	}
+
+	refreshInterval := defaultRefreshInterval
+	if value := os.Getenv("CURRENCY_RATE_REFRESH_INTERVAL"); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			slog.Warn("invalid CURRENCY_RATE_REFRESH_INTERVAL, using default", "value", value, "default", defaultRefreshInterval)
+		} else {
+			refreshInterval = interval
+		}
+	}
+
+	table := NewRateTable(source, os.Getenv("CURRENCY_BASE"), refreshInterval)
+	err := table.Refresh(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "initial currency rate refresh failed, only base currency is supported", "base_currency", table.BaseCurrency(), "error", err)
+	}
+
+	return table
+}
@@ -10,14 +10,14 @@ type XenditInvoiceRequest struct {
 	PaymentMethod          string    `json:"payment_method"`
 	Status                 string    `json:"status"`
 	MerchantName           string    `json:"merchant_name"`
-	Amount                 int       `json:"amount"`
-	PaidAmount             int       `json:"paid_amount"`
+	Amount                 float64   `json:"amount"`
+	PaidAmount             float64   `json:"paid_amount"`
 	BankCode               string    `json:"bank_code"`
 	PaidAt                 time.Time `json:"paid_at"`
 	PayerEmail             string    `json:"payer_email"`
 	Description            string    `json:"description"`
-	AdjustedReceivedAmount int       `json:"adjusted_received_amount"`
-	FeesPaidAmount         int       `json:"fees_paid_amount"`
+	AdjustedReceivedAmount float64   `json:"adjusted_received_amount"`
+	FeesPaidAmount         float64   `json:"fees_paid_amount"`
 	Updated                time.Time `json:"updated"`
 	Created                time.Time `json:"created"`
 	Currency               string    `json:"currency"`
@@ -3,14 +3,43 @@ package entity
 import "time"
 
 type UserCart struct {
-	Id        string
-	UserId    string
-	ProductId string
-	Quantity  int
-	CreatedAt time.Time
-	CreatedBy string
-	UpdatedAt *time.Time
-	UpdatedBy *string
+	Id          string
+	UserId      string
+	GuestCartId *string
+	ProductId   string
+	Quantity    int
+	// harga produk saat terakhir ditambahkan ke cart, nil untuk cart lama
+	PriceAtAdd    *int64
+	CurrencyAtAdd *string
+	CreatedAt     time.Time
+	CreatedBy     string
+	UpdatedAt     *time.Time
+	UpdatedBy     *string
 
 	Product *Product
-}
\ No newline at end of file
+}
+
+// nama pembuat/pengubah cart milik pengunjung yang belum login
+const GuestCartActor = "guest"
+
+// batas quantity satu produk di cart, sama dengan validasi request cart
+const MaxCartQuantity = 1000
+
+// CartOwner adalah pemilik cart, user yang login atau pengunjung dengan cart token
+type CartOwner struct {
+	UserId      string
+	GuestCartId string
+	Actor       string
+}
+
+func (co *CartOwner) IsGuest() bool {
+	return co.UserId == ""
+}
+
+func (co *CartOwner) Owns(cart *UserCart) bool {
+	if co.IsGuest() {
+		return cart.GuestCartId != nil && *cart.GuestCartId == co.GuestCartId
+	}
+
+	return cart.UserId == co.UserId
+}
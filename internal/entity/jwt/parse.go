@@ -23,7 +23,12 @@ func ParseTokenFromContext(ctx context.Context) (string, error) {
 		return "", utils.UnauthenticatedResponse()
 	}
 
-	tokenSplit := strings.Split(bearerToken[0], " ")
+	return ParseTokenFromHeader(bearerToken[0])
+}
+
+// ParseTokenFromHeader mengambil token dari nilai header "Bearer <token>", dipakai juga oleh endpoint rest
+func ParseTokenFromHeader(bearerToken string) (string, error) {
+	tokenSplit := strings.Split(bearerToken, " ")
 	if len(tokenSplit) != 2 {
 		return "", utils.UnauthenticatedResponse()
 	}
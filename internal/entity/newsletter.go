@@ -2,15 +2,47 @@ package entity
 
 import "time"
 
+const (
+	NewsletterStatusPending   = "pending"
+	NewsletterStatusConfirmed = "confirmed"
+)
+
+// topik newsletter yang bisa dipilih subscriber
+const (
+	NewsletterTopicPromotions  = "promotions"
+	NewsletterTopicNewArrivals = "new_arrivals"
+	NewsletterTopicArticles    = "articles"
+)
+
+var NewsletterTopics = []string{
+	NewsletterTopicPromotions,
+	NewsletterTopicNewArrivals,
+	NewsletterTopicArticles,
+}
+
 type Newsletter struct {
-	Id        string
-	Fullname  string
-	Email     string
-	CreatedAt time.Time
-	CreatedBy string
-	UpdatedAt *time.Time
-	UpdatedBy *string
-	DeletedAt *time.Time
-	DeletedBy *string
-	IsDeleted bool
+	Id                    string
+	Fullname              string
+	Email                 string
+	Status                string
+	Topics                []string
+	ConfirmTokenHash      *string
+	ConfirmTokenExpiresAt *time.Time
+	ConfirmedAt           *time.Time
+	CreatedAt             time.Time
+	CreatedBy             string
+	UpdatedAt             *time.Time
+	UpdatedBy             *string
+	DeletedAt             *time.Time
+	DeletedBy             *string
+	IsDeleted             bool
+}
+
+// NewsletterImportResult adalah ringkasan import subscriber dari csv
+type NewsletterImportResult struct {
+	Imported  int
+	Duplicate int
+	Invalid   int
+	// pesan error per baris, dibatasi agar response tidak terlalu besar
+	Errors []string
 }
@@ -0,0 +1,77 @@
+package entity
+
+import "time"
+
+const (
+	NewsletterCampaignStatusDraft     = "draft"
+	NewsletterCampaignStatusScheduled = "scheduled"
+	NewsletterCampaignStatusSending   = "sending"
+	NewsletterCampaignStatusSent      = "sent"
+	NewsletterCampaignStatusCanceled  = "canceled"
+)
+
+// segmen penerima campaign, semua segmen hanya berisi subscriber yang sudah konfirmasi
+const (
+	NewsletterSegmentAll       = "all"
+	NewsletterSegmentCustomers = "customers"
+	NewsletterSegmentTopic     = "topic"
+)
+
+const (
+	NewsletterRecipientStatusPending = "pending"
+	NewsletterRecipientStatusSent    = "sent"
+	NewsletterRecipientStatusFailed  = "failed"
+	// subscriber sudah unsubscribe atau dihapus setelah daftar penerima dibuat
+	NewsletterRecipientStatusSkipped = "skipped"
+)
+
+type NewsletterCampaign struct {
+	Id              string
+	Name            string
+	TemplateCode    string
+	Subject         string
+	Title           string
+	Body            string
+	CtaText         *string
+	CtaUrl          *string
+	Segment         string
+	Topic           *string
+	Status          string
+	ScheduledAt     *time.Time
+	StartedAt       *time.Time
+	CompletedAt     *time.Time
+	TotalRecipients int64
+	CreatedAt       time.Time
+	CreatedBy       string
+	UpdatedAt       *time.Time
+	UpdatedBy       *string
+	DeletedAt       *time.Time
+	DeletedBy       *string
+	IsDeleted       bool
+}
+
+// NewsletterCampaignStats adalah ringkasan status pengiriman per penerima
+type NewsletterCampaignStats struct {
+	PendingCount int64
+	SentCount    int64
+	FailedCount  int64
+	SkippedCount int64
+	OpenedCount  int64
+	ClickedCount int64
+}
+
+type NewsletterCampaignRecipient struct {
+	Id            string
+	CampaignId    string
+	NewsletterId  string
+	Email         string
+	FullName      string
+	Status        string
+	AttemptCount  int
+	NextAttemptAt time.Time
+	LastError     *string
+	SentAt        *time.Time
+	OpenedAt      *time.Time
+	ClickedAt     *time.Time
+	CreatedAt     time.Time
+}
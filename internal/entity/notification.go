@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+const (
+	NotificationTypeOrder   = "order"
+	NotificationTypePayment = "payment"
+)
+
+type UserNotification struct {
+	Id              string
+	UserId          string
+	Type            string
+	Event           string
+	Title           string
+	Body            string
+	OrderId         *string
+	OrderStatusCode *string
+	OutboxId        *string
+	ReadAt          *time.Time
+	CreatedAt       time.Time
+}
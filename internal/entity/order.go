@@ -3,12 +3,36 @@ package entity
 import "time"
 
 const (
-	OrderStatusCodeUnpaid   = "unpaid"
-	OrderStatusCodePaid     = "paid"
-	OrderStatusCodeShipped  = "shipped"
-	OrderStatusCodeDone     = "done"
-	OrderStatusCodeExpired  = "expired"
-	OrderStatusCodeCanceled = "canceled"
+	OrderStatusCodePendingPaymentLink = "pending_payment_link"
+	OrderStatusCodeUnpaid             = "unpaid"
+	OrderStatusCodePaid               = "paid"
+	OrderStatusCodeShipped            = "shipped"
+	OrderStatusCodeDone               = "done"
+	OrderStatusCodeExpired            = "expired"
+	OrderStatusCodeCanceled           = "canceled"
+	OrderStatusCodePaymentReview      = "payment_review"
+	OrderStatusCodeRefundPending      = "refund_pending"
+	OrderStatusCodePartiallyRefunded  = "partially_refunded"
+	OrderStatusCodeRefunded           = "refunded"
+)
+
+// alasan order perlu dicek manual oleh admin setelah webhook pembayaran
+const (
+	PaymentReviewReasonUnderpaid         = "underpaid"
+	PaymentReviewReasonOverpaid          = "overpaid"
+	PaymentReviewReasonUnexpectedPayment = "unexpected_payment"
+	PaymentReviewReasonCurrencyMismatch  = "currency_mismatch"
+)
+
+// domain event order yang diteruskan ke email dan notifikasi lewat outbox
+const (
+	OrderEventCreated       = "order_created"
+	OrderEventPaid          = "order_paid"
+	OrderEventPaymentReview = "order_payment_review"
+	OrderEventShipped       = "order_shipped"
+	OrderEventCompleted     = "order_completed"
+	OrderEventCanceled      = "order_canceled"
+	OrderEventExpired       = "order_expired"
 )
 
 type Order struct {
@@ -20,7 +44,10 @@ type Order struct {
 	Address              string
 	PhoneNumber          string
 	Notes                *string
-	Total                float64
+	Total                int64
+	Currency             string
+	BaseCurrency         string
+	ExchangeRate         float64
 	ExpiredAt            *time.Time
 	CreatedAt            time.Time
 	CreatedBy            string
@@ -32,8 +59,31 @@ type Order struct {
 	XenditInvoiceId      *string
 	XenditInvoiceUrl     *string
 	XenditPaidAt         *time.Time
+	XenditPaidAmount     *int64
 	XenditPaymentMethod  *string
 	XenditPaymentChannel *string
+	PaymentReviewReason  *string
+	ShippingAddressId    *string
+	ShippingCourier      *string
+	ShippingService      *string
+	ShippingCost         int64
+	ShippingWeightGram   int64
+	TrackingCourier      *string
+	TrackingNumber       *string
+	ShippedAt            *time.Time
+	VoucherId            *string
+	VoucherCode          *string
+	DiscountAmount       int64
+	// bagian DiscountAmount untuk produk, sisanya diskon ongkir
+	ProductDiscountAmount int64
+	Subtotal              int64
+	TaxName               *string
+	TaxRate               float64
+	TaxInclusive          bool
+	TaxAmount             int64
+	Locale                *string
+	// stok produk sudah dikurangi saat checkout dan dikembalikan saat order dibatalkan atau expired
+	StockReserved bool
 
 	Items []*OrderItem
 }
@@ -43,7 +93,7 @@ type OrderItem struct {
 	ProductId            string
 	ProductName          string
 	ProductImageFileName string
-	ProductPrice         float64
+	ProductPrice         int64
 	Quantity             int64
 	OrderId              string
 	CreatedAt            time.Time
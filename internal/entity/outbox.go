@@ -0,0 +1,56 @@
+package entity
+
+import "time"
+
+const (
+	OutboxEventTypeCreateInvoice       = "create_invoice"
+	OutboxEventTypeProductNotification = "product_notification"
+	OutboxEventTypeOrderEmail          = "order_email"
+	OutboxEventTypeOrderNotification   = "order_notification"
+	OutboxEventTypeNewsletterConfirm   = "newsletter_confirm"
+)
+
+const (
+	OutboxStatusPending = "pending"
+	OutboxStatusDone    = "done"
+	OutboxStatusFailed  = "failed"
+)
+
+type Outbox struct {
+	Id            string
+	EventType     string
+	AggregateId   string
+	Payload       []byte
+	Status        string
+	AttemptCount  int
+	NextAttemptAt time.Time
+	LastError     *string
+	CreatedAt     time.Time
+	UpdatedAt     *time.Time
+}
+
+type CreateInvoicePayload struct {
+	OrderId      string `json:"order_id"`
+	CustomerName string `json:"customer_name"`
+}
+
+// ProductNotificationPayload berisi perubahan produk saat event terjadi, nominal dalam minor unit Currency
+type ProductNotificationPayload struct {
+	ProductId string `json:"product_id"`
+	EventType string `json:"event_type"`
+	OldPrice  int64  `json:"old_price"`
+	NewPrice  int64  `json:"new_price"`
+	Currency  string `json:"currency"`
+}
+
+// OrderEventPayload adalah domain event perubahan order, Event berisi salah satu OrderEvent*
+type OrderEventPayload struct {
+	OrderId    string    `json:"order_id"`
+	Event      string    `json:"event"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// NewsletterConfirmPayload hanya berisi id subscriber, token konfirmasi dibuat saat email dikirim agar tidak tersimpan di outbox
+type NewsletterConfirmPayload struct {
+	NewsletterId string `json:"newsletter_id"`
+}
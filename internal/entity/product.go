@@ -2,17 +2,33 @@ package entity
 
 import "time"
 
+// alasan produk di cart atau wishlist tidak bisa dibeli
+const (
+	ProductUnavailableReasonDeleted  = "product_deleted"
+	ProductUnavailableReasonNotFound = "product_not_found"
+)
+
 type Product struct {
 	Id            string
 	Name          string
 	Description   string
-	Price         float64
+	Price         int64
+	Currency      string
 	ImageFileName string
-	CreatedAt     time.Time
-	CreatedBy     string
-	UpdatedAt     time.Time
-	UpdatedBy     *string
-	DeletedAt     time.Time
-	DeletedBy     *string
-	IsDeleted     bool
+	WeightGram    int64
+	// nil berarti stok tidak dicatat
+	Stock     *int64
+	Category  string
+	CreatedAt time.Time
+	CreatedBy string
+	UpdatedAt time.Time
+	UpdatedBy *string
+	DeletedAt time.Time
+	DeletedBy *string
+	IsDeleted bool
+}
+
+// HasStock mengecek stok cukup untuk quantity, produk tanpa stok tercatat selalu tersedia
+func (p *Product) HasStock(quantity int64) bool {
+	return p.Stock == nil || *p.Stock >= quantity
 }
@@ -0,0 +1,42 @@
+package entity
+
+import "time"
+
+const (
+	ProductEventBackInStock = "back_in_stock"
+	ProductEventPriceDrop   = "price_drop"
+)
+
+const (
+	ProductNotificationStatusSent        = "sent"
+	ProductNotificationStatusRateLimited = "rate_limited"
+)
+
+type ProductSubscription struct {
+	Id        string
+	UserId    string
+	ProductId string
+	EventType string
+	CreatedAt time.Time
+	CreatedBy string
+	UpdatedAt *time.Time
+	UpdatedBy *string
+	DeletedAt *time.Time
+	DeletedBy *string
+	IsDeleted bool
+
+	// diisi saat subscriber diambil untuk dikirimi notifikasi
+	UserEmail    string
+	UserFullName string
+}
+
+type ProductNotification struct {
+	Id             string
+	OutboxId       string
+	SubscriptionId string
+	UserId         string
+	ProductId      string
+	EventType      string
+	Status         string
+	CreatedAt      time.Time
+}
@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+const (
+	RefundStatusRequested  = "requested"
+	RefundStatusProcessing = "processing"
+	RefundStatusSucceeded  = "succeeded"
+	RefundStatusFailed     = "failed"
+	RefundStatusRejected   = "rejected"
+)
+
+type OrderRefund struct {
+	Id                      string
+	OrderId                 string
+	Status                  string
+	Reason                  string
+	RejectReason            *string
+	Amount                  int64
+	PreviousOrderStatusCode string
+	ProviderRefundId        *string
+	CreatedAt               time.Time
+	CreatedBy               string
+	UpdatedAt               *time.Time
+	UpdatedBy               *string
+
+	Items []*OrderRefundItem
+}
+
+type OrderRefundItem struct {
+	Id          string
+	RefundId    string
+	OrderItemId string
+	ProductId   string
+	Quantity    int64
+	Amount      int64
+	CreatedAt   time.Time
+	CreatedBy   string
+}
@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+type UserAddress struct {
+	Id            string
+	UserId        string
+	Label         string
+	RecipientName string
+	PhoneNumber   string
+	Address       string
+	City          string
+	Province      string
+	PostalCode    string
+	IsDefault     bool
+	CreatedAt     time.Time
+	CreatedBy     string
+	UpdatedAt     *time.Time
+	UpdatedBy     *string
+	DeletedAt     *time.Time
+	DeletedBy     *string
+	IsDeleted     bool
+}
@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+const (
+	VoucherTypePercentage   = "percentage"
+	VoucherTypeFixedAmount  = "fixed_amount"
+	VoucherTypeFreeShipping = "free_shipping"
+)
+
+// DiscountValue berisi persen untuk percentage dan minor unit Currency untuk fixed_amount
+type Voucher struct {
+	Id                string
+	Code              string
+	Description       string
+	DiscountType      string
+	DiscountValue     float64
+	MaxDiscount       *int64
+	MinSpend          int64
+	Currency          string
+	UsageLimit        *int64
+	PerUserUsageLimit *int64
+	StartsAt          time.Time
+	EndsAt            time.Time
+	ProductIds        []string
+	Categories        []string
+	IsActive          bool
+	CreatedAt         time.Time
+	CreatedBy         string
+	UpdatedAt         *time.Time
+	UpdatedBy         *string
+	DeletedAt         *time.Time
+	DeletedBy         *string
+	IsDeleted         bool
+}
+
+type VoucherUsage struct {
+	Id             string
+	VoucherId      string
+	UserId         string
+	OrderId        string
+	DiscountAmount int64
+	CreatedAt      time.Time
+}
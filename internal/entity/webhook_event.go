@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type WebhookEvent struct {
+	Id         string
+	Provider   string
+	EventId    string
+	InvoiceId  string
+	ExternalId string
+	Status     string
+	ReceivedAt time.Time
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type UserWishlist struct {
+	Id        string
+	UserId    string
+	ProductId string
+	CreatedAt time.Time
+	CreatedBy string
+
+	Product *Product
+}
@@ -4,13 +4,15 @@ import (
 	"context"
 
 	jwtentity "github.com/luzmareto/go-grpc-ecommerce-be/internal/entity/jwt"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/logger"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
-	gocache "github.com/patrickmn/go-cache"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 type authMiddleware struct {
-	cacheService *gocache.Cache
+	tokenRevocationService service.ITokenRevocationService
 }
 
 // api yang tidak perlu login
@@ -23,11 +25,54 @@ var publicApis = map[string]bool{
 	"/product.ProductService/HighlightProducts":        true,
 	"/product.ProductService/HighlightProduct":         true,
 	"/newsletter.NewsletterService/SubcribeNewsletter": true,
+	"/newsletter.NewsletterService/ConfirmNewsletter":  true,
+}
+
+// api yang boleh dipanggil tanpa login memakai cart token, jika token jwt dikirim tetap diverifikasi
+var guestApis = map[string]bool{
+	"/cart.CartService/AddProductToCart":   true,
+	"/cart.CartService/ListCart":           true,
+	"/cart.CartService/DeleteCart":         true,
+	"/cart.CartService/UpdateCartQuantity": true,
+	"/cart.CartService/BulkUpdateCart":     true,
+	"/cart.CartService/ClearCart":          true,
 }
 
 func (am *authMiddleware) Middleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
-	if publicApis[info.FullMethod] {
-		return handler(ctx, req)
+	ctx, err = am.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := handler(ctx, req)
+
+	return res, err
+}
+
+// StreamMiddleware memakai aturan yang sama dengan Middleware, claims disematkan ke context stream
+func (am *authMiddleware) StreamMiddleware(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := am.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &contextServerStream{
+		ServerStream: ss,
+		ctx:          ctx,
+	})
+}
+
+// authenticate mengembalikan context yang sudah berisi claims jwt, context tidak diubah untuk api publik / guest
+func (am *authMiddleware) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	if publicApis[fullMethod] {
+		return ctx, nil
+	}
+
+	if guestApis[fullMethod] {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok || len(md.Get("authorization")) == 0 {
+			return ctx, nil
+		}
 	}
 
 	// ambil token dari metada
@@ -36,9 +81,12 @@ func (am *authMiddleware) Middleware(ctx context.Context, req any, info *grpc.Un
 		return nil, err
 	}
 
-	// cek token dari logout cache
-	_, ok := am.cacheService.Get(tokenstr)
-	if ok {
+	// cek token yang sudah logout
+	revoked, err := am.tokenRevocationService.IsRevoked(ctx, tokenstr)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
 		return nil, utils.UnauthenticatedResponse()
 	}
 
@@ -48,16 +96,15 @@ func (am *authMiddleware) Middleware(ctx context.Context, req any, info *grpc.Un
 		return nil, err
 	}
 
-	// sematkan entity ke context
-	ctx = claims.SetToContext(ctx)
-
-	res, err := handler(ctx, req)
+	// user id ikut ditulis di log request
+	logger.SetUserId(ctx, claims.Subject)
 
-	return res, err
+	// sematkan entity ke context
+	return claims.SetToContext(ctx), nil
 }
 
-func NewAuthMiddleware(cacheService *gocache.Cache) *authMiddleware {
+func NewAuthMiddleware(tokenRevocationService service.ITokenRevocationService) *authMiddleware {
 	return &authMiddleware{
-		cacheService: cacheService,
+		tokenRevocationService: tokenRevocationService,
 	}
 }
@@ -0,0 +1,87 @@
+package grpcmiddleware
+
+import (
+	"strings"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// nama field BaseResponse yang dipakai semua response message
+const baseResponseField = "base"
+
+// newResponseMessage membuat response kosong untuk method grpc, contoh "/cart.CartService/ListCart"
+func newResponseMessage(fullMethod string) (proto.Message, bool) {
+	serviceName, methodName, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return nil, false
+	}
+
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil, false
+	}
+	serviceDescriptor, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, false
+	}
+	methodDescriptor := serviceDescriptor.Methods().ByName(protoreflect.Name(methodName))
+	if methodDescriptor == nil {
+		return nil, false
+	}
+
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(methodDescriptor.Output().FullName())
+	if err != nil {
+		return nil, false
+	}
+
+	return messageType.New().Interface(), true
+}
+
+func baseResponseFieldOf(message protoreflect.Message) protoreflect.FieldDescriptor {
+	field := message.Descriptor().Fields().ByName(baseResponseField)
+	if field == nil || field.Message() == nil || field.Message().FullName() != (&common.BaseResponse{}).ProtoReflect().Descriptor().FullName() {
+		return nil
+	}
+
+	return field
+}
+
+// getBaseResponse mengembalikan nil jika response tidak memiliki field base atau field base kosong
+func getBaseResponse(resp any) *common.BaseResponse {
+	message, ok := resp.(proto.Message)
+	if !ok || message == nil {
+		return nil
+	}
+
+	reflectMessage := message.ProtoReflect()
+	if !reflectMessage.IsValid() {
+		return nil
+	}
+	field := baseResponseFieldOf(reflectMessage)
+	if field == nil || !reflectMessage.Has(field) {
+		return nil
+	}
+
+	base, ok := reflectMessage.Get(field).Message().Interface().(*common.BaseResponse)
+	if !ok {
+		return nil
+	}
+
+	return base
+}
+
+// setBaseResponse mengembalikan false jika response tidak memiliki field base
+func setBaseResponse(resp proto.Message, base *common.BaseResponse) bool {
+	reflectMessage := resp.ProtoReflect()
+	field := baseResponseFieldOf(reflectMessage)
+	if field == nil {
+		return false
+	}
+
+	reflectMessage.Set(field, protoreflect.ValueOfMessage(base.ProtoReflect()))
+
+	return true
+}
@@ -2,32 +2,117 @@ package grpcmiddleware
 
 import (
 	"context"
-	"log"
+	"errors"
+	"log/slog"
+	"os"
 	"runtime/debug"
 
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/apperror"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// error dikembalikan sebagai status grpc dengan errdetails, BaseResponse ikut dikirim di details
+	ErrorModeStatus = "status"
+	// error bisnis tetap dikembalikan sebagai response sukses dengan base.is_error untuk client lama
+	ErrorModeBase = "base"
+)
+
+// errorMode default base agar client lama yang membaca field base dari response sukses tetap berjalan
+var errorMode = ErrorModeBase
+
+// LoadErrorModeFromEnv membaca GRPC_ERROR_MODE satu kali saat server dijalankan, dipanggil setelah env dimuat
+func LoadErrorModeFromEnv() {
+	switch value := os.Getenv("GRPC_ERROR_MODE"); value {
+	case "", ErrorModeBase:
+		errorMode = ErrorModeBase
+	case ErrorModeStatus:
+		errorMode = ErrorModeStatus
+	default:
+		slog.Warn("invalid GRPC_ERROR_MODE, using default", "value", value, "default", ErrorModeBase)
+		errorMode = ErrorModeBase
+	}
+}
+
 func ErrorMiddleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			log.Println(r)
-			debug.PrintStack()
-			err = status.Errorf(codes.Internal, "internal server error")
+			slog.ErrorContext(ctx, "panic recovered", "method", info.FullMethod, "panic", r, "stack", string(debug.Stack()))
+			err = apperror.Internal().GRPCStatus().Err()
 		}
 	}()
 	res, err := handler(ctx, req)
 	if err != nil {
-		log.Println(err)
-		if st, ok := status.FromError(err); ok {
-			if st.Code() == codes.Unauthenticated {
-				return nil, err
-			}
+		var appErr *apperror.Error
+		if errors.As(err, &appErr) {
+			return errorResponse(info.FullMethod, appErr)
 		}
 
-		return nil, status.Error(codes.Internal, "internal server error")
+		return nil, handleError(ctx, err)
+	}
+
+	if errorMode == ErrorModeStatus {
+		if base := getBaseResponse(res); base != nil && base.IsError {
+			return nil, apperror.FromBaseResponse(base).GRPCStatus().Err()
+		}
 	}
+
 	return res, err
 }
+
+// errorResponse pada mode base mengembalikan response method dengan field base berisi error agar client lama tetap bisa membacanya,
+// selain itu atau jika response tidak memiliki field base error dikembalikan sebagai status grpc.
+// Unauthenticated dan PermissionDenied selalu dikirim sebagai status grpc di kedua mode, sama seperti sebelum mode base ada
+func errorResponse(fullMethod string, appErr *apperror.Error) (any, error) {
+	if errorMode == ErrorModeBase && appErr.Code != codes.Unauthenticated && appErr.Code != codes.PermissionDenied {
+		if resp, ok := newResponseMessage(fullMethod); ok && setBaseResponse(resp, appErr.BaseResponse()) {
+			return resp, nil
+		}
+	}
+
+	return nil, appErr.GRPCStatus().Err()
+}
+
+// StreamErrorMiddleware sama dengan ErrorMiddleware untuk rpc streaming, panic di handler stream tidak mematikan server
+func StreamErrorMiddleware(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.ErrorContext(ss.Context(), "panic recovered", "method", info.FullMethod, "panic", r, "stack", string(debug.Stack()))
+			err = apperror.Internal().GRPCStatus().Err()
+		}
+	}()
+	err = handler(srv, ss)
+	if err != nil {
+		return handleError(ss.Context(), err)
+	}
+	return nil
+}
+
+// handleError meneruskan error domain, unauthenticated dan pembatalan request, error lain disembunyikan dari client sebagai internal
+func handleError(ctx context.Context, err error) error {
+	var appErr *apperror.Error
+	if errors.As(err, &appErr) {
+		return appErr.GRPCStatus().Err()
+	}
+
+	// client menutup koneksi atau deadline habis, bukan error server
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	if st, ok := status.FromError(err); ok {
+		if st.Code() == codes.Unauthenticated || st.Code() == codes.Canceled || st.Code() == codes.DeadlineExceeded || apperror.IsStatus(st) {
+			return err
+		}
+	}
+
+	// contoh: stream.Send ke client yang sudah disconnect mengembalikan Unavailable
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	slog.ErrorContext(ctx, "unhandled error", "error", err)
+	return apperror.Internal().GRPCStatus().Err()
+}
@@ -0,0 +1,55 @@
+package grpcmiddleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/apperror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHandleError(t *testing.T) {
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		err      error
+		wantCode codes.Code
+	}{
+		{name: "context canceled", ctx: context.Background(), err: context.Canceled, wantCode: codes.Canceled},
+		{name: "wrapped deadline exceeded", ctx: context.Background(), err: fmt.Errorf("query: %w", context.DeadlineExceeded), wantCode: codes.DeadlineExceeded},
+		{name: "grpc canceled", ctx: context.Background(), err: status.Error(codes.Canceled, "context canceled"), wantCode: codes.Canceled},
+		{name: "unavailable after client disconnect", ctx: canceledCtx, err: status.Error(codes.Unavailable, "transport is closing"), wantCode: codes.Canceled},
+		{name: "unauthenticated", ctx: context.Background(), err: status.Error(codes.Unauthenticated, "unauthenticated"), wantCode: codes.Unauthenticated},
+		{name: "app error", ctx: context.Background(), err: apperror.NotFound("order not found"), wantCode: codes.NotFound},
+		{name: "unknown error", ctx: context.Background(), err: errors.New("connection refused"), wantCode: codes.Internal},
+		{name: "unavailable while client connected", ctx: context.Background(), err: status.Error(codes.Unavailable, "upstream down"), wantCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := status.Code(handleError(tt.ctx, tt.err))
+			if got != tt.wantCode {
+				t.Errorf("handleError() code = %v, want %v", got, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestStreamErrorMiddlewareClientCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := StreamErrorMiddleware(nil, &contextServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/notification.NotificationService/Subscribe"}, func(srv any, stream grpc.ServerStream) error {
+		return stream.Context().Err()
+	})
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("StreamErrorMiddleware() code = %v, want %v", status.Code(err), codes.Canceled)
+	}
+}
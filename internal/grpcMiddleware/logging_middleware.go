@@ -0,0 +1,78 @@
+package grpcmiddleware
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// LoggingMiddleware dipasang paling luar agar status code yang dicatat sama dengan yang diterima client
+func LoggingMiddleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	ctx, requestId := requestIdContext(ctx)
+	// request id dikembalikan ke client di header response
+	grpc.SetHeader(ctx, metadata.Pairs(logger.RequestIdHeader, requestId))
+
+	start := time.Now()
+	res, err := handler(ctx, req)
+	logRequest(ctx, info.FullMethod, start, err)
+
+	return res, err
+}
+
+func StreamLoggingMiddleware(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, requestId := requestIdContext(ss.Context())
+	ss.SetHeader(metadata.Pairs(logger.RequestIdHeader, requestId))
+
+	start := time.Now()
+	err := handler(srv, &contextServerStream{
+		ServerStream: ss,
+		ctx:          ctx,
+	})
+	logRequest(ctx, info.FullMethod, start, err)
+
+	return err
+}
+
+// requestIdContext memakai x-request-id dari metadata client, jika tidak ada dibuat id baru
+func requestIdContext(ctx context.Context) (context.Context, string) {
+	requestId := ""
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if values := md.Get(logger.RequestIdHeader); len(values) > 0 {
+			requestId = values[0]
+		}
+	}
+	requestId = logger.NormalizeRequestId(requestId)
+
+	return logger.WithRequestId(ctx, requestId), requestId
+}
+
+func logRequest(ctx context.Context, fullMethod string, start time.Time, err error) {
+	code := status.Code(err)
+	attrs := []slog.Attr{
+		slog.String("method", fullMethod),
+		slog.String("code", code.String()),
+		slog.Float64("duration_ms", float64(time.Since(start).Microseconds())/1000),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		attrs = append(attrs, slog.String("peer", p.Addr.String()))
+	}
+
+	level := slog.LevelInfo
+	switch code {
+	case codes.OK:
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		level = slog.LevelError
+	default:
+		level = slog.LevelWarn
+	}
+
+	slog.LogAttrs(ctx, level, "grpc request", attrs...)
+}
@@ -0,0 +1,28 @@
+package grpcmiddleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// MetricsMiddleware dipasang setelah LoggingMiddleware agar status code yang dicatat sama dengan yang diterima client
+func MetricsMiddleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	res, err := handler(ctx, req)
+	metrics.ObserveGrpcRequest(info.FullMethod, status.Code(err).String(), time.Since(start).Seconds())
+
+	return res, err
+}
+
+// StreamMetricsMiddleware mencatat durasi stream dari awal sampai stream ditutup
+func StreamMetricsMiddleware(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	metrics.ObserveGrpcRequest(info.FullMethod, status.Code(err).String(), time.Since(start).Seconds())
+
+	return err
+}
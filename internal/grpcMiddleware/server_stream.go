@@ -0,0 +1,17 @@
+package grpcmiddleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// contextServerStream mengganti context stream, dipakai interceptor stream yang menambahkan nilai ke context
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,28 @@
+package grpcmiddleware
+
+import (
+	"context"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/apperror"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidationMiddleware memvalidasi request dengan aturan buf.validate sebelum handler dipanggil
+func ValidationMiddleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	validationErrors, err := utils.CheckValidation(message)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return errorResponse(info.FullMethod, apperror.FromBaseResponse(utils.ValidationErrorResponse(validationErrors)))
+	}
+
+	return handler(ctx, req)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
-	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/auth"
 )
 
@@ -16,16 +15,6 @@ type authHandler struct {
 
 
 func (sh *authHandler) Register(ctx context.Context, request *auth.RegisterRequest) (*auth.RegisterResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-	if validationErrors != nil {
-		return &auth.RegisterResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	// process Register
 	res, err := sh.authService.Register(ctx, request)
 	if err != nil {
@@ -37,16 +26,6 @@ func (sh *authHandler) Register(ctx context.Context, request *auth.RegisterReque
 }
 
 func (sh *authHandler) Login(ctx context.Context, request *auth.LoginRequest) (*auth.LoginResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-	if validationErrors != nil {
-		return &auth.LoginResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	// process Register
 	res, err := sh.authService.Login(ctx, request)
 	if err != nil {
@@ -57,16 +36,6 @@ func (sh *authHandler) Login(ctx context.Context, request *auth.LoginRequest) (*
 }
 
 func (sh *authHandler) Logout(ctx context.Context, request *auth.LogoutRequest) (*auth.LogoutResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-	if validationErrors != nil {
-		return &auth.LogoutResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	res, err := sh.authService.Logout(ctx, request)
 	if err != nil {
 		return nil, err
@@ -76,15 +45,6 @@ func (sh *authHandler) Logout(ctx context.Context, request *auth.LogoutRequest)
 }
 
 func (sh *authHandler) ChangePassword(ctx context.Context, request *auth.ChangePasswordRequest) (*auth.ChangePasswordResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-	if validationErrors != nil {
-		return &auth.ChangePasswordResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
 	res, err := sh.authService.ChangePassword(ctx, request)
 	if err != nil {
 		return nil, err
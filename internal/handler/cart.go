@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
-	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/cart"
 )
 
@@ -15,16 +14,6 @@ type cartHandler struct {
 }
 
 func (ch *cartHandler) AddProductToCart(ctx context.Context, request *cart.AddProductToCartRequest) (*cart.AddProductToCartResponse, error){
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-	if validationErrors != nil {
-		return &cart.AddProductToCartResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	res, err := ch.cartService.AddProductToCart(ctx, request)
 	if err != nil {
 		return nil, err
@@ -35,16 +24,6 @@ func (ch *cartHandler) AddProductToCart(ctx context.Context, request *cart.AddPr
 
 
 func (ch *cartHandler)  ListCart(ctx context.Context,request *cart.ListCartRequest) (*cart.ListCartResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-	if validationErrors != nil {
-		return &cart.ListCartResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	res, err := ch.cartService.ListCart (ctx, request)
 	if err != nil {
 		return nil, err
@@ -53,17 +32,16 @@ func (ch *cartHandler)  ListCart(ctx context.Context,request *cart.ListCartReque
 	return  res, nil
 }
 func (ch *cartHandler) DeleteCart(ctx context.Context,request *cart.DeleteCartRequest) (*cart.DeleteCartResponse, error){
-	validationErrors, err := utils.CheckValidation(request)
+	res, err := ch.cartService.DeleteCart (ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	if validationErrors != nil {
-		return &cart.DeleteCartResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
 
-	res, err := ch.cartService.DeleteCart (ctx, request)
+	return  res, nil
+}
+
+func (ch *cartHandler) UpdateCartQuantity(ctx context.Context, request *cart.UpdateCartQuantityRequest) (*cart.UpdateCartQuantityResponse, error) {
+	res, err := ch.cartService.UpdateCartQuantity (ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -71,23 +49,22 @@ func (ch *cartHandler) DeleteCart(ctx context.Context,request *cart.DeleteCartRe
 	return  res, nil
 }
 
-func (ch *cartHandler) UpdateCartQuantity(ctx context.Context, request *cart.UpdateCartQuantityRequest) (*cart.UpdateCartQuantityResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
+func (ch *cartHandler) BulkUpdateCart(ctx context.Context, request *cart.BulkUpdateCartRequest) (*cart.BulkUpdateCartResponse, error) {
+	res, err := ch.cartService.BulkUpdateCart(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	if validationErrors != nil {
-		return &cart.UpdateCartQuantityResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
 
-	res, err := ch.cartService.UpdateCartQuantity (ctx, request)
+	return res, nil
+}
+
+func (ch *cartHandler) ClearCart(ctx context.Context, request *cart.ClearCartRequest) (*cart.ClearCartResponse, error) {
+	res, err := ch.cartService.ClearCart(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
-	return  res, nil
+	return res, nil
 }
 
 func NewCartHandler(cartService service.ICartService) *cartHandler {
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"fmt"
+	"html"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/payment"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
+)
+
+// fakePaymentHandler menggantikan halaman pembayaran provider saat PAYMENT_PROVIDER=fake
+type fakePaymentHandler struct {
+	webhookService service.IWebhookService
+	paymentGateway payment.IFakePaymentGateway
+}
+
+func (fh *fakePaymentHandler) InvoicePage(c *fiber.Ctx) error {
+	invoiceId := html.EscapeString(c.Params("invoiceId"))
+	externalId := html.EscapeString(c.Query("external_id"))
+	amount := html.EscapeString(c.Query("amount"))
+	currency := html.EscapeString(c.Query("currency"))
+
+	c.Set("Content-Type", "text/html; charset=utf-8")
+	return c.SendString(fmt.Sprintf(`<!DOCTYPE html>
+<html>
+<body>
+<h1>Fake payment</h1>
+<p>Invoice %s for order %s: %s %s</p>
+<form method="POST" action="/payment/fake/invoices/%s/pay">
+<input type="hidden" name="external_id" value="%s">
+<input type="hidden" name="amount" value="%s">
+<input type="hidden" name="currency" value="%s">
+<button name="status" value="%s">Pay</button>
+<button name="status" value="%s">Expire</button>
+</form>
+</body>
+</html>`, invoiceId, externalId, currency, amount, invoiceId, externalId, amount, currency, payment.InvoiceStatusPaid, payment.InvoiceStatusExpired))
+}
+
+func (fh *fakePaymentHandler) Pay(c *fiber.Ctx) error {
+	amount, err := strconv.ParseInt(c.FormValue("amount"), 10, 64)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).SendString("invalid amount")
+	}
+
+	status := c.FormValue("status", payment.InvoiceStatusPaid)
+	header, body, err := fh.paymentGateway.SimulateCallback(c.Params("invoiceId"), c.FormValue("external_id"), amount, c.FormValue("currency"), status)
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "simulate payment callback failed", "error", err)
+		return c.SendStatus(http.StatusInternalServerError)
+	}
+
+	// callback diproses sama seperti webhook dari provider asli
+	event, err := fh.paymentGateway.ParseWebhook(c.UserContext(), header, body)
+	if err != nil {
+		slog.WarnContext(c.UserContext(), "invalid simulated payment callback", "error", err)
+		return c.SendStatus(http.StatusBadRequest)
+	}
+
+	err = fh.webhookService.ReceiveInvoice(c.UserContext(), event)
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "receive simulated payment failed", "error", err)
+		return c.SendStatus(http.StatusInternalServerError)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"status":  status,
+	})
+}
+
+func NewFakePaymentHandler(webhookService service.IWebhookService, paymentGateway payment.IFakePaymentGateway) *fakePaymentHandler {
+	return &fakePaymentHandler{
+		webhookService: webhookService,
+		paymentGateway: paymentGateway,
+	}
+}
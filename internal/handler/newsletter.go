@@ -1,39 +1,256 @@
 package handler
 
 import (
+	"bufio"
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
 
+	"github.com/gofiber/fiber/v2"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	jwtentity "github.com/luzmareto/go-grpc-ecommerce-be/internal/entity/jwt"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/logger"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/newsletter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type newsletterHandler struct {
 	newsletter.UnimplementedNewsletterServiceServer
 
-	newsletterService service.InewsLetterService
+	newsletterService      service.InewsLetterService
+	tokenRevocationService service.ITokenRevocationService
 }
 
 func (nh *newsletterHandler) SubcribeNewsletter(ctx context.Context, request *newsletter.SubcribeNewsletterRequest) (*newsletter.SubcribeNewsletterResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
+	res, err := nh.newsletterService.SubcribeNewsletter(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	if validationErrors != nil {
-		return &newsletter.SubcribeNewsletterResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) ConfirmNewsletter(ctx context.Context, request *newsletter.ConfirmNewsletterRequest) (*newsletter.ConfirmNewsletterResponse, error) {
+	res, err := nh.newsletterService.ConfirmNewsletter(ctx, request)
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := nh.newsletterService.SubcribeNewsletter(ctx, request)
+	return res, nil
+}
+
+func (nh *newsletterHandler) GetNewsletterSubscription(ctx context.Context, request *newsletter.GetNewsletterSubscriptionRequest) (*newsletter.GetNewsletterSubscriptionResponse, error) {
+	res, err := nh.newsletterService.GetNewsletterSubscription(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) UpdateNewsletterPreferences(ctx context.Context, request *newsletter.UpdateNewsletterPreferencesRequest) (*newsletter.UpdateNewsletterPreferencesResponse, error) {
+	res, err := nh.newsletterService.UpdateNewsletterPreferences(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) UnsubscribeNewsletter(ctx context.Context, request *newsletter.UnsubscribeNewsletterRequest) (*newsletter.UnsubscribeNewsletterResponse, error) {
+	res, err := nh.newsletterService.UnsubscribeNewsletter(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) ListSubscribers(ctx context.Context, request *newsletter.ListSubscribersRequest) (*newsletter.ListSubscribersResponse, error) {
+	res, err := nh.newsletterService.ListSubscribers(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *newsletterHandler) RemoveSubscriber(ctx context.Context, request *newsletter.RemoveSubscriberRequest) (*newsletter.RemoveSubscriberResponse, error) {
+	res, err := nh.newsletterService.RemoveSubscriber(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
 	return res, nil
 }
-func NewNewsletterHandler(newsletterSerive service.InewsLetterService) *newsletterHandler {
+
+// Confirm menangani link konfirmasi newsletter dari email
+func (nh *newsletterHandler) Confirm(c *fiber.Ctx) error {
+	res, err := nh.newsletterService.ConfirmNewsletter(c.UserContext(), &newsletter.ConfirmNewsletterRequest{
+		Token: c.Query("token"),
+	})
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "confirm newsletter failed", "error", err)
+		return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
+	}
+	if res.Base.IsError {
+		return c.Status(http.StatusBadRequest).SendString(res.Base.Message)
+	}
+
+	return c.SendString("Your newsletter subscription is confirmed")
+}
+
+// UnsubscribePage menampilkan halaman konfirmasi untuk link unsubscribe di email
+func (nh *newsletterHandler) UnsubscribePage(c *fiber.Ctx) error {
+	return sendUnsubscribeConfirmPage(c, "Stop receiving the newsletter?")
+}
+
+// Unsubscribe menangani form halaman konfirmasi dan one-click unsubscribe dari email client (List-Unsubscribe-Post)
+func (nh *newsletterHandler) Unsubscribe(c *fiber.Ctx) error {
+	err := nh.newsletterService.UnsubscribeByToken(c.UserContext(), c.Query("token"))
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return c.Status(http.StatusBadRequest).SendString("Invalid unsubscribe link")
+		}
+		slog.ErrorContext(c.UserContext(), "unsubscribe newsletter failed", "error", err)
+		return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
+	}
+
+	return c.SendString("You have been unsubscribed from the newsletter")
+}
+
+// ExportSubscribers mengirim csv subscriber sebagai download, baris ditulis bertahap dari database
+func (nh *newsletterHandler) ExportSubscribers(c *fiber.Ctx) error {
+	ctx, err := restAdminContext(c, nh.tokenRevocationService)
+	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return c.Status(http.StatusForbidden).SendString("Permission denied")
+		}
+		if status.Code(err) != codes.Unauthenticated {
+			slog.ErrorContext(c.UserContext(), "authenticate newsletter export failed", "error", err)
+			return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
+		}
+		return c.Status(http.StatusUnauthorized).SendString("Unauthenticated")
+	}
+
+	search := c.Query("search")
+	subscriberStatus := c.Query("status")
+	c.Set("Content-Type", "text/csv; charset=utf-8")
+	c.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"newsletter_subscribers_%s.csv\"", time.Now().Format("20060102150405")))
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		err := nh.newsletterService.ExportSubscribers(ctx, search, subscriberStatus, w)
+		if err != nil {
+			slog.ErrorContext(ctx, "export newsletter subscribers failed", "error", err)
+		}
+		w.Flush()
+	})
+
+	return nil
+}
+
+// ImportSubscribers menerima file csv dari form field "file"
+func (nh *newsletterHandler) ImportSubscribers(c *fiber.Ctx) error {
+	ctx, err := restAdminContext(c, nh.tokenRevocationService)
+	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return c.Status(http.StatusForbidden).JSON(fiber.Map{
+				"success": false,
+				"message": "permission denied",
+			})
+		}
+		if status.Code(err) != codes.Unauthenticated {
+			slog.ErrorContext(c.UserContext(), "authenticate newsletter import failed", "error", err)
+			return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "internal server error",
+			})
+		}
+		return c.Status(http.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "unauthenticated",
+		})
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "csv file not found",
+		})
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		slog.ErrorContext(ctx, "open newsletter import file failed", "error", err)
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "internal server error",
+		})
+	}
+	defer file.Close()
+
+	result, err := nh.newsletterService.ImportSubscribers(ctx, file)
+	if err != nil {
+		if errors.Is(err, service.ErrNewsletterImportHeader) {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+				"success": false,
+				"message": err.Error(),
+			})
+		}
+		slog.ErrorContext(ctx, "import newsletter subscribers failed", "error", err)
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "internal server error",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success":   true,
+		"message":   "import success",
+		"imported":  result.Imported,
+		"duplicate": result.Duplicate,
+		"invalid":   result.Invalid,
+		"errors":    result.Errors,
+	})
+}
+
+// restAdminContext memverifikasi jwt dari header Authorization untuk endpoint rest khusus admin,
+// token yang sudah logout ditolak sama seperti di auth middleware grpc
+func restAdminContext(c *fiber.Ctx, tokenRevocationService service.ITokenRevocationService) (context.Context, error) {
+	tokenStr, err := jwtentity.ParseTokenFromHeader(c.Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := tokenRevocationService.IsRevoked(c.UserContext(), tokenStr)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	claims, err := jwtentity.GetClaimsFromToken(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+	// user id ikut ditulis di log request
+	logger.SetUserId(c.UserContext(), claims.Subject)
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	return claims.SetToContext(c.UserContext()), nil
+}
+
+func NewNewsletterHandler(newsletterSerive service.InewsLetterService, tokenRevocationService service.ITokenRevocationService) *newsletterHandler {
 	return &newsletterHandler{
-		newsletterService: newsletterSerive,
+		newsletterService:      newsletterSerive,
+		tokenRevocationService: tokenRevocationService,
 	}
 }
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/newsletter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gif transparan 1x1 untuk tracking pixel
+var trackingPixelGif = []byte{
+	0x47, 0x49, 0x46, 0x38, 0x39, 0x61, 0x01, 0x00, 0x01, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xff, 0x21, 0xf9, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x00, 0x00, 0x00, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x00, 0x02, 0x02, 0x44, 0x01, 0x00, 0x3b,
+}
+
+type newsletterCampaignHandler struct {
+	newsletter.UnimplementedNewsletterCampaignServiceServer
+
+	newsletterCampaignService service.INewsletterCampaignService
+}
+
+func (nch *newsletterCampaignHandler) ListCampaignTemplates(ctx context.Context, request *newsletter.ListCampaignTemplatesRequest) (*newsletter.ListCampaignTemplatesResponse, error) {
+	res, err := nch.newsletterCampaignService.ListCampaignTemplates(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nch *newsletterCampaignHandler) CreateCampaign(ctx context.Context, request *newsletter.CreateCampaignRequest) (*newsletter.CreateCampaignResponse, error) {
+	res, err := nch.newsletterCampaignService.CreateCampaign(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nch *newsletterCampaignHandler) ListCampaigns(ctx context.Context, request *newsletter.ListCampaignsRequest) (*newsletter.ListCampaignsResponse, error) {
+	res, err := nch.newsletterCampaignService.ListCampaigns(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nch *newsletterCampaignHandler) DetailCampaign(ctx context.Context, request *newsletter.DetailCampaignRequest) (*newsletter.DetailCampaignResponse, error) {
+	res, err := nch.newsletterCampaignService.DetailCampaign(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nch *newsletterCampaignHandler) ScheduleCampaign(ctx context.Context, request *newsletter.ScheduleCampaignRequest) (*newsletter.ScheduleCampaignResponse, error) {
+	res, err := nch.newsletterCampaignService.ScheduleCampaign(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nch *newsletterCampaignHandler) CancelCampaign(ctx context.Context, request *newsletter.CancelCampaignRequest) (*newsletter.CancelCampaignResponse, error) {
+	res, err := nch.newsletterCampaignService.CancelCampaign(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// TrackOpen selalu mengembalikan gif agar tampilan email tidak rusak walaupun link tidak valid
+func (nch *newsletterCampaignHandler) TrackOpen(c *fiber.Ctx) error {
+	err := nch.newsletterCampaignService.TrackOpen(c.UserContext(), c.Query("r"), c.Query("sig"))
+	if err != nil && status.Code(err) != codes.Unauthenticated {
+		slog.ErrorContext(c.UserContext(), "track newsletter open failed", "error", err)
+	}
+
+	c.Set("Content-Type", "image/gif")
+	c.Set("Cache-Control", "no-store, no-cache, must-revalidate")
+	return c.Send(trackingPixelGif)
+}
+
+// TrackClick mencatat klik lalu redirect ke url tujuan campaign
+func (nch *newsletterCampaignHandler) TrackClick(c *fiber.Ctx) error {
+	targetUrl := c.Query("u")
+	err := nch.newsletterCampaignService.TrackClick(c.UserContext(), c.Query("r"), targetUrl, c.Query("sig"))
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return c.Status(http.StatusBadRequest).SendString("Invalid link")
+		}
+		// gagal mencatat klik tidak boleh menghalangi user membuka link
+		slog.ErrorContext(c.UserContext(), "track newsletter click failed", "error", err)
+	}
+
+	return c.Redirect(targetUrl, http.StatusFound)
+}
+
+func NewNewsletterCampaignHandler(newsletterCampaignService service.INewsletterCampaignService) *newsletterCampaignHandler {
+	return &newsletterCampaignHandler{
+		newsletterCampaignService: newsletterCampaignService,
+	}
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/notification"
+)
+
+type notificationHandler struct {
+	notification.UnimplementedNotificationServiceServer
+
+	notificationService service.INotificationService
+}
+
+func (nh *notificationHandler) ListNotifications(ctx context.Context, request *notification.ListNotificationsRequest) (*notification.ListNotificationsResponse, error) {
+	res, err := nh.notificationService.ListNotifications(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *notificationHandler) MarkRead(ctx context.Context, request *notification.MarkReadRequest) (*notification.MarkReadResponse, error) {
+	res, err := nh.notificationService.MarkRead(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (nh *notificationHandler) Subscribe(request *notification.SubscribeRequest, stream notification.NotificationService_SubscribeServer) error {
+	return nh.notificationService.Subscribe(request, stream)
+}
+
+func NewNotificationHandler(notificationService service.INotificationService) *notificationHandler {
+	return &notificationHandler{
+		notificationService: notificationService,
+	}
+}
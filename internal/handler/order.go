@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
-	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/order"
 )
 
@@ -15,17 +14,16 @@ type orderHandler struct {
 }
 
 func (oh *orderHandler) CreateOrder(ctx context.Context, request *order.CreateOrderRequest) (*order.CreateOrderResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
+	res, err := oh.orderService.CreateOrder(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	if validationErrors != nil {
-		return &order.CreateOrderResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
 
-	res, err := oh.orderService.CreateOrder(ctx, request)
+	return res, nil
+}
+
+func (oh *orderHandler) ListOrderAdmin(ctx context.Context, request *order.ListOrderAdminRequest) (*order.ListOrderAdminResponse, error) {
+	res, err := oh.orderService.ListOrderAdmin(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -33,18 +31,17 @@ func (oh *orderHandler) CreateOrder(ctx context.Context, request *order.CreateOr
 	return res, nil
 }
 
-func (oh *orderHandler) ListOrderAdmin(ctx context.Context, request *order.ListOrderAdminRequest) (*order.ListOrderAdminResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
+func (oh *orderHandler) ListOrder(ctx context.Context, request *order.ListOrderRequest) (*order.ListOrderResponse, error) {
+	res, err := oh.orderService.ListOrder(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	if validationErrors != nil {
-		return &order.ListOrderAdminResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
 
-	res, err := oh.orderService.ListOrderAdmin(ctx, request)
+	return res, nil
+}
+
+func (oh *orderHandler) DetailOrder(ctx context.Context, request *order.DetailOrderRequest) (*order.DetailOrderResponse, error) {
+	res, err := oh.orderService.DetailOrder(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -52,18 +49,17 @@ func (oh *orderHandler) ListOrderAdmin(ctx context.Context, request *order.ListO
 	return res, nil
 }
 
-func (oh *orderHandler) ListOrder(ctx context.Context, request *order.ListOrderRequest) (*order.ListOrderResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
+func (oh *orderHandler) UpdateOrderStatus(ctx context.Context, request *order.UpdateOrderStatusRequest) (*order.UpdateOrderStatusResponse, error) {
+	res, err := oh.orderService.UpdateOrderStatus(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	if validationErrors != nil {
-		return &order.ListOrderResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
 
-	res, err := oh.orderService.ListOrder(ctx, request)
+	return res, nil
+}
+
+func (oh *orderHandler) GetOrderPaymentLink(ctx context.Context, request *order.GetOrderPaymentLinkRequest) (*order.GetOrderPaymentLinkResponse, error) {
+	res, err := oh.orderService.GetOrderPaymentLink(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -71,18 +67,17 @@ func (oh *orderHandler) ListOrder(ctx context.Context, request *order.ListOrderR
 	return res, nil
 }
 
-func (oh *orderHandler) DetailOrder(ctx context.Context, request *order.DetailOrderRequest) (*order.DetailOrderResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
+func (oh *orderHandler) RequestReturn(ctx context.Context, request *order.RequestReturnRequest) (*order.RequestReturnResponse, error) {
+	res, err := oh.orderService.RequestReturn(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	if validationErrors != nil {
-		return &order.DetailOrderResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
 
-	res, err := oh.orderService.DetailOrder(ctx, request)
+	return res, nil
+}
+
+func (oh *orderHandler) ApproveRefund(ctx context.Context, request *order.ApproveRefundRequest) (*order.ApproveRefundResponse, error) {
+	res, err := oh.orderService.ApproveRefund(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -90,18 +85,17 @@ func (oh *orderHandler) DetailOrder(ctx context.Context, request *order.DetailOr
 	return res, nil
 }
 
-func (oh *orderHandler) UpdateOrderStatus(ctx context.Context, request *order.UpdateOrderStatusRequest) (*order.UpdateOrderStatusResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
+func (oh *orderHandler) RejectRefund(ctx context.Context, request *order.RejectRefundRequest) (*order.RejectRefundResponse, error) {
+	res, err := oh.orderService.RejectRefund(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	if validationErrors != nil {
-		return &order.UpdateOrderStatusResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
 
-	res, err := oh.orderService.UpdateOrderStatus(ctx, request)
+	return res, nil
+}
+
+func (oh *orderHandler) ApplyVoucher(ctx context.Context, request *order.ApplyVoucherRequest) (*order.ApplyVoucherResponse, error) {
+	res, err := oh.orderService.ApplyVoucher(ctx, request)
 	if err != nil {
 		return nil, err
 	}
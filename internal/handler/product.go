@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
-	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/product"
 )
 
@@ -15,16 +14,6 @@ type productHandler struct {
 }
 
 func (ph *productHandler) CreateProduct(ctx context.Context, request *product.CreateProductRequest) (*product.CreateProductResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-	if validationErrors != nil {
-		return &product.CreateProductResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	res, err := ph.productService.CreateProduct(ctx, request)
 	if err != nil {
 		return nil, err
@@ -35,16 +24,6 @@ func (ph *productHandler) CreateProduct(ctx context.Context, request *product.Cr
 }
 
 func (ph *productHandler) DetailProduct(ctx context.Context,request *product.DetailProductRequest) (*product.DetailProductResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-	if validationErrors != nil {
-		return &product.DetailProductResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	res, err := ph.productService.DetailProduct(ctx, request)
 	if err != nil {
 		return nil, err
@@ -55,16 +34,6 @@ func (ph *productHandler) DetailProduct(ctx context.Context,request *product.Det
 }
 
 func (ph *productHandler) EditProduct(ctx context.Context, request *product.EditProductRequest) (*product.EditProductResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-	if validationErrors != nil {
-		return &product.EditProductResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	res, err := ph.productService.EditProduct(ctx, request)
 	if err != nil {
 		return nil, err
@@ -75,17 +44,7 @@ func (ph *productHandler) EditProduct(ctx context.Context, request *product.Edit
 }
 
 func (ph *productHandler) DeleteProduct(ctx context.Context, request *product.DeleteProductRequest) (*product.DeleteProductResponse, error) {
-validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-	if validationErrors != nil {
-		return &product.DeleteProductResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
-	res, err := ph.productService.DeleteProduct(ctx, request)
+res, err := ph.productService.DeleteProduct(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -95,16 +54,6 @@ validationErrors, err := utils.CheckValidation(request)
 }
 
 func (ph *productHandler) ListProduct(ctx context.Context, request *product.ListProductRequest) (*product.ListProductResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-	if validationErrors != nil {
-		return &product.ListProductResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	res, err := ph.productService.ListProduct(ctx, request)
 	if err != nil {
 		return nil, err
@@ -114,16 +63,6 @@ func (ph *productHandler) ListProduct(ctx context.Context, request *product.List
 
 }
 func (ph *productHandler) ListProductAdmin(ctx context.Context, request *product.ListProductAdminRequest) (*product.ListProductAdminResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-	if validationErrors != nil {
-		return &product.ListProductAdminResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	res, err := ph.productService.ListProductAdmin(ctx, request)
 	if err != nil {
 		return nil, err
@@ -134,16 +73,6 @@ func (ph *productHandler) ListProductAdmin(ctx context.Context, request *product
 }
 
 func (ph *productHandler) HighlightProducts(ctx context.Context,request *product.HighlightProductsRequest) (*product.HighlightProductsResponse, error) {
-	validationErrors, err := utils.CheckValidation(request)
-	if err != nil {
-		return nil, err
-	}
-	if validationErrors != nil {
-		return &product.HighlightProductsResponse{
-			Base: utils.ValidationErrorResponse(validationErrors),
-		}, nil
-	}
-
 	res, err := ph.productService.HighlightProducts(ctx, request)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/product"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type productSubscriptionHandler struct {
+	product.UnimplementedProductSubscriptionServiceServer
+
+	productSubscriptionService service.IProductSubscriptionService
+}
+
+func (psh *productSubscriptionHandler) SubscribeProductEvent(ctx context.Context, request *product.SubscribeProductEventRequest) (*product.SubscribeProductEventResponse, error) {
+	res, err := psh.productSubscriptionService.SubscribeProductEvent(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (psh *productSubscriptionHandler) UnsubscribeProductEvent(ctx context.Context, request *product.UnsubscribeProductEventRequest) (*product.UnsubscribeProductEventResponse, error) {
+	res, err := psh.productSubscriptionService.UnsubscribeProductEvent(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// UnsubscribePage menampilkan halaman konfirmasi untuk link unsubscribe di email
+func (psh *productSubscriptionHandler) UnsubscribePage(c *fiber.Ctx) error {
+	return sendUnsubscribeConfirmPage(c, "Stop receiving back in stock and price drop emails for this product?")
+}
+
+// Unsubscribe menangani form halaman konfirmasi dan one-click unsubscribe dari email client (List-Unsubscribe-Post)
+func (psh *productSubscriptionHandler) Unsubscribe(c *fiber.Ctx) error {
+	err := psh.productSubscriptionService.UnsubscribeByToken(c.UserContext(), c.Query("token"))
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return c.Status(http.StatusBadRequest).SendString("Invalid unsubscribe link")
+		}
+		slog.ErrorContext(c.UserContext(), "unsubscribe product notification failed", "error", err)
+		return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
+	}
+
+	return c.SendString("You have been unsubscribed")
+}
+
+func NewProductSubscriptionHandler(productSubscriptionService service.IProductSubscriptionService) *productSubscriptionHandler {
+	return &productSubscriptionHandler{
+		productSubscriptionService: productSubscriptionService,
+	}
+}
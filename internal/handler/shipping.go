@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/shipping"
+)
+
+type shippingHandler struct {
+	shipping.UnimplementedShippingServiceServer
+
+	shippingService service.IShippingService
+}
+
+func (sh *shippingHandler) CreateAddress(ctx context.Context, request *shipping.CreateAddressRequest) (*shipping.CreateAddressResponse, error) {
+	res, err := sh.shippingService.CreateAddress(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (sh *shippingHandler) ListAddress(ctx context.Context, request *shipping.ListAddressRequest) (*shipping.ListAddressResponse, error) {
+	res, err := sh.shippingService.ListAddress(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (sh *shippingHandler) UpdateAddress(ctx context.Context, request *shipping.UpdateAddressRequest) (*shipping.UpdateAddressResponse, error) {
+	res, err := sh.shippingService.UpdateAddress(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (sh *shippingHandler) DeleteAddress(ctx context.Context, request *shipping.DeleteAddressRequest) (*shipping.DeleteAddressResponse, error) {
+	res, err := sh.shippingService.DeleteAddress(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (sh *shippingHandler) GetShippingRates(ctx context.Context, request *shipping.GetShippingRatesRequest) (*shipping.GetShippingRatesResponse, error) {
+	res, err := sh.shippingService.GetShippingRates(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewShippingHandler(shippingService service.IShippingService) *shippingHandler {
+	return &shippingHandler{
+		shippingService: shippingService,
+	}
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// sendUnsubscribeConfirmPage menampilkan tombol konfirmasi unsubscribe. GET tidak mengubah data karena link di email
+// sering dibuka otomatis oleh scanner email, unsubscribe baru dijalankan saat form di-POST ke url yang sama
+func sendUnsubscribeConfirmPage(c *fiber.Ctx, message string) error {
+	action := c.Path() + "?" + url.Values{"token": {c.Query("token")}}.Encode()
+
+	c.Set("Content-Type", "text/html; charset=utf-8")
+	return c.SendString(fmt.Sprintf(`<!DOCTYPE html>
+<html>
+<body>
+<h1>Unsubscribe</h1>
+<p>%s</p>
+<form method="POST" action="%s">
+<button type="submit">Unsubscribe</button>
+</form>
+</body>
+</html>`, html.EscapeString(message), html.EscapeString(action)))
+}
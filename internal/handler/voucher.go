@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/voucher"
+)
+
+type voucherHandler struct {
+	voucher.UnimplementedVoucherServiceServer
+
+	voucherService service.IVoucherService
+}
+
+func (vh *voucherHandler) CreateVoucher(ctx context.Context, request *voucher.CreateVoucherRequest) (*voucher.CreateVoucherResponse, error) {
+	res, err := vh.voucherService.CreateVoucher(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (vh *voucherHandler) EditVoucher(ctx context.Context, request *voucher.EditVoucherRequest) (*voucher.EditVoucherResponse, error) {
+	res, err := vh.voucherService.EditVoucher(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (vh *voucherHandler) DeleteVoucher(ctx context.Context, request *voucher.DeleteVoucherRequest) (*voucher.DeleteVoucherResponse, error) {
+	res, err := vh.voucherService.DeleteVoucher(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (vh *voucherHandler) ListVoucherAdmin(ctx context.Context, request *voucher.ListVoucherAdminRequest) (*voucher.ListVoucherAdminResponse, error) {
+	res, err := vh.voucherService.ListVoucherAdmin(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewVoucherHandler(voucherService service.IVoucherService) *voucherHandler {
+	return &voucherHandler{
+		voucherService: voucherService,
+	}
+}
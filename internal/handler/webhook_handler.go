@@ -1,37 +1,50 @@
 package handler
 
 import (
-	"log"
+	"errors"
+	"log/slog"
 	"net/http"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/luzmareto/go-grpc-ecommerce-be/internal/dto"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/payment"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
 )
 
 type webhookHandler struct {
 	webhookService service.IWebhookService
+	paymentGateway payment.IPaymentGateway
 }
 
 func (wh *webhookHandler) ReceiveInvoice(c *fiber.Ctx) error {
-	var request dto.XenditInvoiceRequest
-	err := c.BodyParser(&request)
+	header := http.Header{}
+	for key, values := range c.GetReqHeaders() {
+		for _, value := range values {
+			header.Add(key, value)
+		}
+	}
+
+	event, err := wh.paymentGateway.ParseWebhook(c.UserContext(), header, c.Body())
 	if err != nil {
-		log.Println(err)
+		if errors.Is(err, payment.ErrInvalidWebhookToken) {
+			slog.WarnContext(c.UserContext(), "webhook rejected", "provider", wh.paymentGateway.Name(), "ip", c.IP(), "error", err)
+			return c.SendStatus(http.StatusUnauthorized)
+		}
+		slog.WarnContext(c.UserContext(), "invalid webhook payload", "provider", wh.paymentGateway.Name(), "ip", c.IP(), "error", err)
 		return c.SendStatus(http.StatusBadRequest)
 	}
 
-	err = wh.webhookService.ReceiveInvoice(c.UserContext(), &request)
+	err = wh.webhookService.ReceiveInvoice(c.UserContext(), event)
 	if err != nil {
-		log.Println(err)
+		slog.ErrorContext(c.UserContext(), "receive webhook failed", "error", err)
 		return c.SendStatus(http.StatusInternalServerError)
 	}
 
 	return c.SendStatus(http.StatusOK)
 }
 
-func NewWebhookHandler(webhookService service.IWebhookService) *webhookHandler {
+func NewWebhookHandler(webhookService service.IWebhookService, paymentGateway payment.IPaymentGateway) *webhookHandler {
 	return &webhookHandler{
 		webhookService: webhookService,
+		paymentGateway: paymentGateway,
 	}
 }
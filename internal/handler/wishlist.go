@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/wishlist"
+)
+
+type wishlistHandler struct {
+	wishlist.UnimplementedWishlistServiceServer
+
+	wishlistService service.IWishlistService
+}
+
+func (wh *wishlistHandler) AddToWishlist(ctx context.Context, request *wishlist.AddToWishlistRequest) (*wishlist.AddToWishlistResponse, error) {
+	res, err := wh.wishlistService.AddToWishlist(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (wh *wishlistHandler) RemoveFromWishlist(ctx context.Context, request *wishlist.RemoveFromWishlistRequest) (*wishlist.RemoveFromWishlistResponse, error) {
+	res, err := wh.wishlistService.RemoveFromWishlist(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (wh *wishlistHandler) ListWishlist(ctx context.Context, request *wishlist.ListWishlistRequest) (*wishlist.ListWishlistResponse, error) {
+	res, err := wh.wishlistService.ListWishlist(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (wh *wishlistHandler) MoveWishlistToCart(ctx context.Context, request *wishlist.MoveWishlistToCartRequest) (*wishlist.MoveWishlistToCartResponse, error) {
+	res, err := wh.wishlistService.MoveWishlistToCart(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewWishlistHandler(wishlistService service.IWishlistService) *wishlistHandler {
+	return &wishlistHandler{
+		wishlistService: wishlistService,
+	}
+}
@@ -0,0 +1,84 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+const redactedValue = "[REDACTED]"
+
+// key log yang nilainya tidak boleh ditulis, dicocokkan tanpa memperhatikan huruf besar kecil
+var sensitiveKeyParts = []string{"password", "token", "secret", "authorization", "cookie", "signature"}
+
+// key pendek dicocokkan persis agar tidak menyembunyikan key lain, contoh sig di link tracking
+var sensitiveKeys = map[string]bool{
+	"sig": true,
+	"otp": true,
+	"pin": true,
+}
+
+// Setup memasang logger json sebagai default slog, output package log standar (contoh dari library) ikut ditulis dalam format json.
+// Level dibaca dari LOG_LEVEL (debug, info, warn, error), default info
+func Setup() {
+	level := slog.LevelInfo
+	if value := os.Getenv("LOG_LEVEL"); value != "" {
+		err := level.UnmarshalText([]byte(value))
+		if err != nil {
+			level = slog.LevelInfo
+		}
+	}
+
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: redactAttr,
+	})
+	slog.SetDefault(slog.New(&contextHandler{Handler: handler}))
+}
+
+func IsSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	if sensitiveKeys[key] {
+		return true
+	}
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func redactAttr(groups []string, attr slog.Attr) slog.Attr {
+	if IsSensitiveKey(attr.Key) {
+		return slog.String(attr.Key, redactedValue)
+	}
+
+	return attr
+}
+
+// contextHandler menambahkan request_id dan user_id dari context ke setiap log
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if info := requestInfoFromContext(ctx); info != nil {
+		record.AddAttrs(slog.String("request_id", info.requestId))
+		if userId := info.getUserId(); userId != "" {
+			record.AddAttrs(slog.String("user_id", userId))
+		}
+	}
+
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
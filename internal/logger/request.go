@@ -0,0 +1,86 @@
+package logger
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// RequestIdHeader dipakai di metadata grpc dan header http
+const RequestIdHeader = "x-request-id"
+
+// panjang maksimal request id dari client, request id yang lebih panjang diganti
+const maxRequestIdLength = 128
+
+type requestInfoContextKey string
+
+var requestInfoContextKeyValue requestInfoContextKey = "RequestInfo"
+
+// requestInfo disimpan sebagai pointer agar user id yang diisi middleware auth terlihat oleh middleware logging di luarnya
+type requestInfo struct {
+	requestId string
+
+	mu     sync.RWMutex
+	userId string
+}
+
+func (ri *requestInfo) getUserId() string {
+	ri.mu.RLock()
+	defer ri.mu.RUnlock()
+
+	return ri.userId
+}
+
+// NormalizeRequestId memakai request id dari client jika valid, selain itu membuat id baru
+func NormalizeRequestId(requestId string) string {
+	if requestId == "" || len(requestId) > maxRequestIdLength {
+		return uuid.NewString()
+	}
+	for _, r := range requestId {
+		// hanya karakter yang aman ditulis ke header dan log
+		if r < 0x21 || r > 0x7e {
+			return uuid.NewString()
+		}
+	}
+
+	return requestId
+}
+
+func WithRequestId(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, requestInfoContextKeyValue, &requestInfo{
+		requestId: requestId,
+	})
+}
+
+func RequestIdFromContext(ctx context.Context) string {
+	if info := requestInfoFromContext(ctx); info != nil {
+		return info.requestId
+	}
+
+	return ""
+}
+
+// SetUserId mencatat user yang sedang login untuk log request ini, tidak melakukan apa pun jika ctx tidak memiliki request id
+func SetUserId(ctx context.Context, userId string) {
+	info := requestInfoFromContext(ctx)
+	if info == nil {
+		return
+	}
+
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	info.userId = userId
+}
+
+func requestInfoFromContext(ctx context.Context) *requestInfo {
+	if ctx == nil {
+		return nil
+	}
+	info, ok := ctx.Value(requestInfoContextKeyValue).(*requestInfo)
+	if !ok {
+		return nil
+	}
+
+	return info
+}
@@ -0,0 +1,43 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// fileMailer menyimpan setiap email sebagai file .eml, dipakai sebagai pengganti provider asli saat testing
+type fileMailer struct {
+	dir  string
+	from string
+}
+
+func (fm *fileMailer) Name() string {
+	return ProviderFile
+}
+
+func (fm *fileMailer) Send(ctx context.Context, message *Message) error {
+	content, err := buildMIME(fm.from, message)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(fm.dir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	fileName := fmt.Sprintf("%s_%s.eml", time.Now().Format("20060102T150405.000000000"), uuid.NewString())
+	return os.WriteFile(filepath.Join(fm.dir, fileName), content, 0o644)
+}
+
+func NewFileMailer(dir string, from string) IMailer {
+	return &fileMailer{
+		dir:  dir,
+		from: from,
+	}
+}
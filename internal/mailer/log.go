@@ -0,0 +1,22 @@
+package mailer
+
+import (
+	"context"
+	"log/slog"
+)
+
+// logMailer hanya menulis email ke log, dipakai untuk development lokal
+type logMailer struct{}
+
+func (lm *logMailer) Name() string {
+	return ProviderLog
+}
+
+func (lm *logMailer) Send(ctx context.Context, message *Message) error {
+	slog.InfoContext(ctx, "mail sent to log", "to", message.To, "subject", message.Subject, "body", message.TextBody)
+	return nil
+}
+
+func NewLogMailer() IMailer {
+	return &logMailer{}
+}
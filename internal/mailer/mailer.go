@@ -0,0 +1,65 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	ProviderLog  = "log"
+	ProviderFile = "file"
+	ProviderSMTP = "smtp"
+)
+
+const (
+	defaultMailFrom    = "no-reply@localhost"
+	defaultMailFileDir = "storage/mail"
+	defaultSMTPPort    = "587"
+)
+
+// Message adalah email yang akan dikirim, Headers untuk header tambahan seperti List-Unsubscribe
+type Message struct {
+	To       string
+	ToName   string
+	Subject  string
+	TextBody string
+	HTMLBody string
+	Headers  map[string]string
+}
+
+// IMailer adalah pengirim email, provider email asli (SMTP, API) cukup memenuhi interface ini
+type IMailer interface {
+	Name() string
+	Send(ctx context.Context, message *Message) error
+}
+
+// NewMailerFromEnv membaca MAILER_PROVIDER (log, file atau smtp), default log jika kosong.
+// Provider yang tidak dikenal dianggap salah konfigurasi agar email tidak diam-diam hanya ditulis ke log
+func NewMailerFromEnv() (IMailer, error) {
+	from := os.Getenv("MAIL_FROM")
+	if from == "" {
+		from = defaultMailFrom
+	}
+
+	provider := os.Getenv("MAILER_PROVIDER")
+	switch strings.ToLower(provider) {
+	case "", ProviderLog:
+		return NewLogMailer(), nil
+	case ProviderFile:
+		dir := os.Getenv("MAILER_FILE_DIR")
+		if dir == "" {
+			dir = defaultMailFileDir
+		}
+		return NewFileMailer(dir, from), nil
+	case ProviderSMTP:
+		port := os.Getenv("SMTP_PORT")
+		if port == "" {
+			port = defaultSMTPPort
+		}
+		return NewSMTPMailer(os.Getenv("SMTP_HOST"), port, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), from), nil
+	default:
+		return nil, fmt.Errorf("unknown MAILER_PROVIDER %q", provider)
+	}
+}
@@ -0,0 +1,37 @@
+package mailer
+
+import "testing"
+
+func TestNewMailerFromEnv(t *testing.T) {
+	tests := []struct {
+		provider string
+		wantName string
+		wantErr  bool
+	}{
+		{provider: "", wantName: ProviderLog},
+		{provider: "log", wantName: ProviderLog},
+		{provider: "FILE", wantName: ProviderFile},
+		{provider: "smtp", wantName: ProviderSMTP},
+		{provider: "sendgrid", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.provider, func(t *testing.T) {
+			t.Setenv("MAILER_PROVIDER", tt.provider)
+
+			got, err := NewMailerFromEnv()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("NewMailerFromEnv() = %v, want error", got.Name())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewMailerFromEnv() error = %v", err)
+			}
+			if got.Name() != tt.wantName {
+				t.Errorf("Name() = %q, want %q", got.Name(), tt.wantName)
+			}
+		})
+	}
+}
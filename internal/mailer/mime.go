@@ -0,0 +1,95 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// buildMIME menyusun email multipart/alternative (teks dan html) yang dipakai file mailer dan smtp mailer
+func buildMIME(from string, message *Message) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+
+	to := (&mail.Address{Name: message.ToName, Address: message.To}).String()
+	headers := map[string]string{
+		"From":         from,
+		"To":           to,
+		"Subject":      mime.QEncoding.Encode("utf-8", message.Subject),
+		"Date":         time.Now().Format(time.RFC1123Z),
+		"Message-ID":   fmt.Sprintf("<%s@%s>", uuid.NewString(), domainOf(from)),
+		"MIME-Version": "1.0",
+		"Content-Type": fmt.Sprintf("multipart/alternative; boundary=%q", writer.Boundary()),
+	}
+	for key, value := range message.Headers {
+		headers[key] = value
+	}
+
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var result bytes.Buffer
+	for _, key := range keys {
+		fmt.Fprintf(&result, "%s: %s\r\n", key, headers[key])
+	}
+	result.WriteString("\r\n")
+
+	parts := []struct {
+		contentType string
+		body        string
+	}{
+		{contentType: "text/plain; charset=utf-8", body: message.TextBody},
+		{contentType: "text/html; charset=utf-8", body: message.HTMLBody},
+	}
+	for _, part := range parts {
+		if part.body == "" {
+			continue
+		}
+
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qpWriter := quotedprintable.NewWriter(partWriter)
+		_, err = qpWriter.Write([]byte(part.body))
+		if err != nil {
+			return nil, err
+		}
+		err = qpWriter.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := writer.Close()
+	if err != nil {
+		return nil, err
+	}
+	result.Write(buffer.Bytes())
+
+	return result.Bytes(), nil
+}
+
+func domainOf(address string) string {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return "localhost"
+	}
+
+	return parsed.Address[strings.LastIndex(parsed.Address, "@")+1:]
+}
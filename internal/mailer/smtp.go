@@ -0,0 +1,49 @@
+package mailer
+
+import (
+	"context"
+	"net"
+	"net/mail"
+	"net/smtp"
+)
+
+type smtpMailer struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+}
+
+func (sm *smtpMailer) Name() string {
+	return ProviderSMTP
+}
+
+func (sm *smtpMailer) Send(ctx context.Context, message *Message) error {
+	content, err := buildMIME(sm.from, message)
+	if err != nil {
+		return err
+	}
+
+	fromAddress, err := mail.ParseAddress(sm.from)
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if sm.username != "" {
+		auth = smtp.PlainAuth("", sm.username, sm.password, sm.host)
+	}
+
+	return smtp.SendMail(net.JoinHostPort(sm.host, sm.port), auth, fromAddress.Address, []string{message.To}, content)
+}
+
+func NewSMTPMailer(host string, port string, username string, password string, from string) IMailer {
+	return &smtpMailer{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
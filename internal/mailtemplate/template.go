@@ -0,0 +1,162 @@
+package mailtemplate
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+var ErrTemplateNotFound = errors.New("mail template not found")
+
+// Template adalah template email yang bisa dipilih admin
+type Template struct {
+	Code string
+	Name string
+}
+
+// template campaign newsletter, file ada di templates/newsletter/<code>.html dan <code>.txt
+var NewsletterTemplates = []Template{
+	{Code: "announcement", Name: "Announcement"},
+	{Code: "promotion", Name: "Promotion"},
+	{Code: "new_arrivals", Name: "New Arrivals"},
+}
+
+// bahasa template email transaksional, folder templates/<dir>/<locale>
+var SupportedLocales = []string{"en", "id"}
+
+const fallbackLocale = "en"
+
+// Rendered adalah isi email dalam versi html dan teks, Subject diisi jika template punya file .subject
+type Rendered struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+func IsNewsletterTemplate(code string) bool {
+	for _, t := range NewsletterTemplates {
+		if t.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DefaultLocale membaca MAIL_DEFAULT_LOCALE, dipakai jika bahasa user tidak diketahui
+func DefaultLocale() string {
+	locale := MatchLocale(os.Getenv("MAIL_DEFAULT_LOCALE"))
+	if locale == "" {
+		return fallbackLocale
+	}
+
+	return locale
+}
+
+// MatchLocale memilih bahasa yang didukung dari header Accept-Language, contoh "id-ID,id;q=0.9,en;q=0.8".
+// Mengembalikan string kosong jika tidak ada yang cocok
+func MatchLocale(acceptLanguage string) string {
+	for _, language := range strings.Split(acceptLanguage, ",") {
+		language, _, _ = strings.Cut(language, ";")
+		language, _, _ = strings.Cut(strings.TrimSpace(language), "-")
+		language = strings.ToLower(language)
+		for _, locale := range SupportedLocales {
+			if language == locale {
+				return locale
+			}
+		}
+	}
+
+	return ""
+}
+
+// RenderLocalized membaca templates/<dir>/<locale>/<name>, jika bahasa tidak tersedia memakai DefaultLocale
+func RenderLocalized(dir string, name string, locale string, data any) (*Rendered, error) {
+	if MatchLocale(locale) == "" {
+		locale = DefaultLocale()
+	}
+
+	rendered, err := Render(dir+"/"+locale+"/"+name, data)
+	if errors.Is(err, ErrTemplateNotFound) && locale != DefaultLocale() {
+		return Render(dir+"/"+DefaultLocale()+"/"+name, data)
+	}
+
+	return rendered, err
+}
+
+// Render membaca templates/<name>.html dan templates/<name>.txt, html di-escape otomatis oleh html/template.
+// templates/<name>.subject opsional dan dirender sebagai teks
+func Render(name string, data any) (*Rendered, error) {
+	htmlContent, err := templateFS.ReadFile("templates/" + name + ".html")
+	if err != nil {
+		return nil, ErrTemplateNotFound
+	}
+	textContent, err := templateFS.ReadFile("templates/" + name + ".txt")
+	if err != nil {
+		return nil, ErrTemplateNotFound
+	}
+
+	htmlTemplate, err := htmltemplate.New(name).Funcs(htmltemplate.FuncMap{"paragraphs": paragraphs}).Parse(string(htmlContent))
+	if err != nil {
+		return nil, err
+	}
+	textTemplate, err := texttemplate.New(name).Parse(string(textContent))
+	if err != nil {
+		return nil, err
+	}
+
+	var htmlBuffer, textBuffer bytes.Buffer
+	err = htmlTemplate.Execute(&htmlBuffer, data)
+	if err != nil {
+		return nil, err
+	}
+	err = textTemplate.Execute(&textBuffer, data)
+	if err != nil {
+		return nil, err
+	}
+
+	subject := ""
+	subjectContent, err := templateFS.ReadFile("templates/" + name + ".subject")
+	if err == nil {
+		subjectTemplate, err := texttemplate.New(name + ".subject").Parse(string(subjectContent))
+		if err != nil {
+			return nil, err
+		}
+
+		var subjectBuffer bytes.Buffer
+		err = subjectTemplate.Execute(&subjectBuffer, data)
+		if err != nil {
+			return nil, err
+		}
+		subject = strings.TrimSpace(subjectBuffer.String())
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	return &Rendered{
+		Subject: subject,
+		HTML:    htmlBuffer.String(),
+		Text:    textBuffer.String(),
+	}, nil
+}
+
+// paragraphs memecah teks per baris kosong agar body campaign bisa ditulis sebagai teks biasa
+func paragraphs(text string) []string {
+	result := make([]string, 0)
+	for _, paragraph := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph != "" {
+			result = append(result, paragraph)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "ecommerce"
+
+var (
+	grpcRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_server_requests_total",
+		Help:      "Jumlah request grpc per method dan status code.",
+	}, []string{"method", "code"})
+	grpcRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_server_request_duration_seconds",
+		Help:      "Latency request grpc per method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	httpRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_server_requests_total",
+		Help:      "Jumlah request http per route dan status code.",
+	}, []string{"method", "route", "status"})
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_server_request_duration_seconds",
+		Help:      "Latency request http per route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	ordersCreatedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "orders_created_total",
+		Help:      "Jumlah order yang berhasil dibuat.",
+	})
+	webhookPaymentsReceivedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "webhook_payments_received_total",
+		Help:      "Jumlah pembayaran dari webhook yang mengubah status order, per provider dan status order.",
+	}, []string{"provider", "order_status_code"})
+	cartsAbandonedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "carts_abandoned_total",
+		Help:      "Jumlah cart yang ditinggalkan tanpa checkout.",
+	})
+)
+
+// ObserveGrpcRequest mencatat satu request grpc, code adalah nama status code grpc
+func ObserveGrpcRequest(method string, code string, durationSeconds float64) {
+	grpcRequestsTotal.WithLabelValues(method, code).Inc()
+	grpcRequestDuration.WithLabelValues(method).Observe(durationSeconds)
+}
+
+// ObserveHttpRequest mencatat satu request http, route adalah pola route fiber bukan path asli
+// agar jumlah label tidak bertambah untuk setiap parameter
+func ObserveHttpRequest(method string, route string, status string, durationSeconds float64) {
+	httpRequestsTotal.WithLabelValues(method, route, status).Inc()
+	httpRequestDuration.WithLabelValues(method, route).Observe(durationSeconds)
+}
+
+func IncOrdersCreated() {
+	ordersCreatedTotal.Inc()
+}
+
+func IncWebhookPaymentsReceived(provider string, orderStatusCode string) {
+	webhookPaymentsReceivedTotal.WithLabelValues(provider, orderStatusCode).Inc()
+}
+
+func AddCartsAbandoned(count int) {
+	cartsAbandonedTotal.Add(float64(count))
+}
+
+// RegisterDBStats mendaftarkan statistik pool koneksi database/sql dengan label db_name.
+// Pool dengan nama yang sudah terdaftar diabaikan
+func RegisterDBStats(db *sql.DB, dbName string) error {
+	err := prometheus.Register(collectors.NewDBStatsCollector(db, dbName))
+	if err != nil {
+		var alreadyRegistered prometheus.AlreadyRegisteredError
+		if errors.As(err, &alreadyRegistered) {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Handler mengembalikan handler http untuk endpoint /metrics
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Serve menjalankan endpoint /metrics di addr yang terpisah dari port publik, dipanggil dengan go
+func Serve(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	slog.Info("Metrics server is running", "addr", addr)
+	err := http.ListenAndServe(addr, mux)
+	if err != nil {
+		slog.Error("Metrics server is error", "error", err)
+	}
+}
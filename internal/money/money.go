@@ -0,0 +1,161 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+const (
+	CurrencyIDR = "IDR"
+
+	defaultExponent = 2
+)
+
+// jumlah digit minor unit per mata uang. IDR memakai 0 karena payment provider
+// menolak nominal rupiah pecahan, sehingga 1 minor unit IDR = 1 rupiah
+var exponents = map[string]int{
+	CurrencyIDR: 0,
+	"SGD":       2,
+	"MYR":       2,
+	"USD":       2,
+}
+
+// Money adalah nominal dalam minor unit (contoh: sen untuk SGD) beserta kode mata uangnya
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+func New(amount int64, currency string) Money {
+	return Money{
+		Amount:   amount,
+		Currency: NormalizeCurrency(currency),
+	}
+}
+
+// FromMajor mengubah nominal desimal (contoh: 12.5 SGD) menjadi minor unit dengan pembulatan Round
+func FromMajor(value float64, currency string) Money {
+	currency = NormalizeCurrency(currency)
+	return Money{
+		Amount:   int64(math.Round(value * math.Pow10(Exponent(currency)))),
+		Currency: currency,
+	}
+}
+
+// Major mengubah minor unit menjadi nominal desimal, hanya untuk field double lama dan API payment provider
+func (m Money) Major() float64 {
+	return float64(m.Amount) / math.Pow10(Exponent(m.Currency))
+}
+
+// String menampilkan nominal untuk dibaca manusia, contoh "IDR 150000" atau "SGD 12.30"
+func (m Money) String() string {
+	return fmt.Sprintf("%s %.*f", m.Currency, Exponent(m.Currency), m.Major())
+}
+
+func (m Money) Mul(quantity int64) Money {
+	return Money{
+		Amount:   m.Amount * quantity,
+		Currency: m.Currency,
+	}
+}
+
+func Exponent(currency string) int {
+	exponent, ok := exponents[NormalizeCurrency(currency)]
+	if !ok {
+		return defaultExponent
+	}
+
+	return exponent
+}
+
+func NormalizeCurrency(currency string) string {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return CurrencyIDR
+	}
+
+	return currency
+}
+
+// Round adalah satu-satunya aturan pembulatan: half away from zero ke minor unit terdekat.
+// Semua perhitungan persen (diskon, pajak) wajib memakai fungsi di package ini
+func Round(value float64) int64 {
+	return int64(math.Round(value))
+}
+
+// MulRatio menghitung amount * numerator / denominator dengan pembulatan Round memakai aritmatika integer
+func MulRatio(amount int64, numerator int64, denominator int64) int64 {
+	product := amount * numerator
+	quotient := product / denominator
+	remainder := product % denominator
+	if remainder < 0 {
+		remainder = -remainder
+	}
+	if remainder*2 >= abs(denominator) {
+		if (product < 0) != (denominator < 0) {
+			quotient--
+		} else {
+			quotient++
+		}
+	}
+
+	return quotient
+}
+
+// Percent menghitung percent% dari amount, percent dibatasi dua digit desimal (basis point)
+func Percent(amount int64, percent float64) int64 {
+	return MulRatio(amount, BasisPoint(percent), 10000)
+}
+
+// IncludedPercent menghitung bagian percent% yang sudah termasuk di amount (contoh: PPN inclusive)
+func IncludedPercent(amount int64, percent float64) int64 {
+	basisPoint := BasisPoint(percent)
+	return MulRatio(amount, basisPoint, 10000+basisPoint)
+}
+
+// Allocate membagi amount sesuai proporsi weights tanpa selisih pembulatan: setiap bagian dibulatkan ke bawah
+// lalu sisa minor unit diberikan ke bagian dengan sisa pembagian terbesar. Jumlah hasil selalu sama dengan amount
+func Allocate(amount int64, weights []int64) []int64 {
+	shares := make([]int64, len(weights))
+	var totalWeight int64
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+	if totalWeight <= 0 {
+		return shares
+	}
+
+	remainders := make([]int64, len(weights))
+	allocated := int64(0)
+	for i, weight := range weights {
+		shares[i] = amount * weight / totalWeight
+		remainders[i] = amount * weight % totalWeight
+		allocated += shares[i]
+	}
+
+	for leftover := amount - allocated; leftover > 0; leftover-- {
+		largest := 0
+		for i := range remainders {
+			if remainders[i] > remainders[largest] {
+				largest = i
+			}
+		}
+		shares[largest]++
+		remainders[largest] = -1
+	}
+
+	return shares
+}
+
+func BasisPoint(percent float64) int64 {
+	return Round(percent * 100)
+}
+
+func abs(value int64) int64 {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
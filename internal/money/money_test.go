@@ -0,0 +1,105 @@
+package money
+
+import "testing"
+
+func TestMulRatio(t *testing.T) {
+	tests := []struct {
+		name        string
+		amount      int64
+		numerator   int64
+		denominator int64
+		want        int64
+	}{
+		{name: "exact", amount: 1000, numerator: 3, denominator: 4, want: 750},
+		{name: "round down", amount: 100, numerator: 1, denominator: 3, want: 33},
+		{name: "round up", amount: 200, numerator: 1, denominator: 3, want: 67},
+		{name: "half rounds away from zero", amount: 5, numerator: 1, denominator: 2, want: 3},
+		{name: "negative half rounds away from zero", amount: -5, numerator: 1, denominator: 2, want: -3},
+		{name: "negative denominator", amount: 7, numerator: 3, denominator: -2, want: -11},
+		{name: "zero amount", amount: 0, numerator: 5, denominator: 7, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MulRatio(tt.amount, tt.numerator, tt.denominator)
+			if got != tt.want {
+				t.Errorf("MulRatio(%d, %d, %d) = %d, want %d", tt.amount, tt.numerator, tt.denominator, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int64
+		percent float64
+		want    int64
+	}{
+		{name: "whole percent", amount: 100000, percent: 11, want: 11000},
+		{name: "half minor unit rounds up", amount: 12345, percent: 10, want: 1235},
+		{name: "decimal percent", amount: 999, percent: 12.5, want: 125},
+		{name: "percent is limited to basis point", amount: 10000, percent: 0.015, want: 2},
+		{name: "zero percent", amount: 50000, percent: 0, want: 0},
+		{name: "full amount", amount: 50000, percent: 100, want: 50000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Percent(tt.amount, tt.percent)
+			if got != tt.want {
+				t.Errorf("Percent(%d, %v) = %d, want %d", tt.amount, tt.percent, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIncludedPercent(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int64
+		percent float64
+		want    int64
+	}{
+		{name: "exact included tax", amount: 111000, percent: 11, want: 11000},
+		{name: "rounded included tax", amount: 100000, percent: 11, want: 9910},
+		{name: "twenty percent", amount: 120, percent: 20, want: 20},
+		{name: "zero percent", amount: 5000, percent: 0, want: 0},
+		{name: "zero amount", amount: 0, percent: 11, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IncludedPercent(tt.amount, tt.percent)
+			if got != tt.want {
+				t.Errorf("IncludedPercent(%d, %v) = %d, want %d", tt.amount, tt.percent, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromMajor(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		currency string
+		want     Money
+	}{
+		{name: "two digit currency", value: 12.5, currency: "SGD", want: Money{Amount: 1250, Currency: "SGD"}},
+		{name: "float error is rounded", value: 19.99, currency: "USD", want: Money{Amount: 1999, Currency: "USD"}},
+		{name: "rupiah has no minor unit", value: 150000, currency: "idr", want: Money{Amount: 150000, Currency: "IDR"}},
+		{name: "half rupiah rounds up", value: 1234.5, currency: "IDR", want: Money{Amount: 1235, Currency: "IDR"}},
+		{name: "negative value", value: -2.5, currency: "SGD", want: Money{Amount: -250, Currency: "SGD"}},
+		{name: "unknown currency uses default exponent", value: 1.239, currency: "EUR", want: Money{Amount: 124, Currency: "EUR"}},
+		{name: "empty currency is rupiah", value: 5, currency: "", want: Money{Amount: 5, Currency: "IDR"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromMajor(tt.value, tt.currency)
+			if got != tt.want {
+				t.Errorf("FromMajor(%v, %q) = %+v, want %+v", tt.value, tt.currency, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	fakePaymentDefaultBaseUrl       = "http://localhost:3000"
+	fakePaymentDefaultCallbackToken = "fake-callback-token"
+)
+
+// IFakePaymentGateway adalah provider palsu untuk development lokal dan testing,
+// tidak ada request keluar dan pembayaran disimulasikan lewat endpoint /payment/fake
+type IFakePaymentGateway interface {
+	IPaymentGateway
+	SimulateCallback(invoiceId string, externalId string, amount int64, currency string, status string) (http.Header, []byte, error)
+}
+
+type fakePaymentGateway struct {
+	baseUrl       string
+	callbackToken string
+}
+
+type fakeWebhookRequest struct {
+	Id         string     `json:"id"`
+	InvoiceId  string     `json:"invoice_id"`
+	ExternalId string     `json:"external_id"`
+	Status     string     `json:"status"`
+	Amount     int64      `json:"amount"`
+	PaidAmount int64      `json:"paid_amount"`
+	Currency   string     `json:"currency"`
+	PaidAt     *time.Time `json:"paid_at"`
+}
+
+func (fg *fakePaymentGateway) Name() string {
+	return ProviderFake
+}
+
+func (fg *fakePaymentGateway) CreateInvoice(ctx context.Context, params *CreateInvoiceParams) (*Invoice, error) {
+	invoiceId := fmt.Sprintf("fake_inv_%s", uuid.NewString())
+
+	// data invoice disimpan di query string karena server grpc dan rest tidak berbagi memori
+	query := url.Values{}
+	query.Set("external_id", params.ExternalId)
+	query.Set("amount", strconv.FormatInt(params.Amount, 10))
+	query.Set("currency", params.Currency)
+
+	return &Invoice{
+		Id:  invoiceId,
+		Url: fmt.Sprintf("%s/payment/fake/invoices/%s?%s", fg.baseUrl, invoiceId, query.Encode()),
+	}, nil
+}
+
+// GetInvoiceByExternalId selalu nil karena provider palsu tidak menyimpan invoice
+func (fg *fakePaymentGateway) GetInvoiceByExternalId(ctx context.Context, externalId string) (*Invoice, error) {
+	return nil, nil
+}
+
+func (fg *fakePaymentGateway) ExpireInvoice(ctx context.Context, invoiceId string) error {
+	return nil
+}
+
+func (fg *fakePaymentGateway) Refund(ctx context.Context, params *RefundParams) (*Refund, error) {
+	return &Refund{
+		Id:     fmt.Sprintf("fake_rfd_%s", uuid.NewString()),
+		Status: RefundStatusSucceeded,
+	}, nil
+}
+
+func (fg *fakePaymentGateway) GetRefund(ctx context.Context, refundId string) (*Refund, error) {
+	return &Refund{
+		Id:     refundId,
+		Status: RefundStatusSucceeded,
+	}, nil
+}
+
+func (fg *fakePaymentGateway) ParseWebhook(ctx context.Context, header http.Header, body []byte) (*WebhookEvent, error) {
+	err := verifyCallbackToken(header, fg.callbackToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var request fakeWebhookRequest
+	err = json.Unmarshal(body, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebhookEvent{
+		Provider:       ProviderFake,
+		Id:             request.Id,
+		InvoiceId:      request.InvoiceId,
+		ExternalId:     request.ExternalId,
+		Status:         request.Status,
+		Amount:         request.Amount,
+		PaidAmount:     request.PaidAmount,
+		Currency:       request.Currency,
+		PaidAt:         request.PaidAt,
+		PaymentMethod:  "FAKE",
+		PaymentChannel: "FAKE",
+	}, nil
+}
+
+// SimulateCallback membuat header dan body callback seperti yang akan dikirim provider asli
+func (fg *fakePaymentGateway) SimulateCallback(invoiceId string, externalId string, amount int64, currency string, status string) (http.Header, []byte, error) {
+	request := fakeWebhookRequest{
+		Id:         uuid.NewString(),
+		InvoiceId:  invoiceId,
+		ExternalId: externalId,
+		Status:     status,
+		Amount:     amount,
+		Currency:   currency,
+	}
+	if status == InvoiceStatusPaid || status == InvoiceStatusSettled {
+		now := time.Now()
+		request.PaidAmount = amount
+		request.PaidAt = &now
+	}
+
+	body, err := json.Marshal(&request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set(callbackTokenHeader, fg.callbackToken)
+
+	return header, body, nil
+}
+
+func NewFakePaymentGateway(baseUrl string, callbackToken string) IFakePaymentGateway {
+	if baseUrl == "" {
+		baseUrl = fakePaymentDefaultBaseUrl
+	}
+	if callbackToken == "" {
+		callbackToken = fakePaymentDefaultCallbackToken
+	}
+
+	return &fakePaymentGateway{
+		baseUrl:       baseUrl,
+		callbackToken: callbackToken,
+	}
+}
@@ -0,0 +1,113 @@
+package payment
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"os"
+	"time"
+)
+
+const (
+	ProviderXendit = "xendit"
+	ProviderFake   = "fake"
+)
+
+const (
+	InvoiceStatusPending = "PENDING"
+	InvoiceStatusPaid    = "PAID"
+	InvoiceStatusSettled = "SETTLED"
+	InvoiceStatusExpired = "EXPIRED"
+)
+
+const (
+	RefundStatusPending   = "PENDING"
+	RefundStatusSucceeded = "SUCCEEDED"
+	RefundStatusFailed    = "FAILED"
+)
+
+var ErrInvalidWebhookToken = errors.New("invalid webhook callback token")
+
+const callbackTokenHeader = "x-callback-token"
+
+type IPaymentGateway interface {
+	Name() string
+	CreateInvoice(ctx context.Context, params *CreateInvoiceParams) (*Invoice, error)
+	// GetInvoiceByExternalId mengambil invoice yang belum expired untuk external id, nil jika belum ada
+	GetInvoiceByExternalId(ctx context.Context, externalId string) (*Invoice, error)
+	ExpireInvoice(ctx context.Context, invoiceId string) error
+	Refund(ctx context.Context, params *RefundParams) (*Refund, error)
+	// GetRefund mengambil status terbaru refund yang masih diproses provider
+	GetRefund(ctx context.Context, refundId string) (*Refund, error)
+	// ParseWebhook memverifikasi dan mengubah callback provider menjadi WebhookEvent
+	ParseWebhook(ctx context.Context, header http.Header, body []byte) (*WebhookEvent, error)
+}
+
+// semua nominal dalam minor unit sesuai Currency, konversi ke format provider dilakukan di masing-masing gateway
+type InvoiceItem struct {
+	Name     string
+	Price    int64
+	Quantity int64
+}
+
+type CreateInvoiceParams struct {
+	ExternalId         string
+	Amount             int64
+	Currency           string
+	CustomerName       string
+	SuccessRedirectUrl string
+	Items              []InvoiceItem
+}
+
+type Invoice struct {
+	Id  string
+	Url string
+}
+
+type RefundParams struct {
+	InvoiceId   string
+	ReferenceId string
+	Amount      int64
+	Currency    string
+	Reason      string
+}
+
+type Refund struct {
+	Id     string
+	Status string
+}
+
+type WebhookEvent struct {
+	Provider       string
+	Id             string
+	InvoiceId      string
+	ExternalId     string
+	Status         string
+	Amount         int64
+	PaidAmount     int64
+	Currency       string
+	PaidAt         *time.Time
+	PaymentMethod  string
+	PaymentChannel string
+}
+
+// verifyCallbackToken membandingkan token dengan constant time agar token tidak bisa ditebak lewat timing
+func verifyCallbackToken(header http.Header, expectedToken string) error {
+	token := header.Get(callbackTokenHeader)
+	if token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(expectedToken)) != 1 {
+		return ErrInvalidWebhookToken
+	}
+
+	return nil
+}
+
+// NewPaymentGatewayFromEnv memilih provider berdasarkan PAYMENT_PROVIDER, default xendit
+func NewPaymentGatewayFromEnv() IPaymentGateway {
+	switch os.Getenv("PAYMENT_PROVIDER") {
+	case ProviderFake:
+		return NewFakePaymentGateway(os.Getenv("FAKE_PAYMENT_BASE_URL"), os.Getenv("FAKE_PAYMENT_CALLBACK_TOKEN"))
+	default:
+		return NewXenditPaymentGateway(os.Getenv("XENDIT_SECRET"), os.Getenv("XENDIT_CALLBACK_TOKEN"))
+	}
+}
@@ -0,0 +1,239 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/dto"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
+	"github.com/xendit/xendit-go"
+	"github.com/xendit/xendit-go/invoice"
+)
+
+type xenditPaymentGateway struct {
+	opt           *xendit.Option
+	invoiceClient *invoice.Client
+	callbackToken string
+}
+
+// reason refund xendit berupa enum, alasan dari customer dikirim di metadata
+const xenditRefundReasonRequestedByCustomer = "REQUESTED_BY_CUSTOMER"
+
+type xenditRefundRequest struct {
+	InvoiceId   string            `json:"invoice_id"`
+	ReferenceId string            `json:"reference_id"`
+	Amount      float64           `json:"amount"`
+	Currency    string            `json:"currency,omitempty"`
+	Reason      string            `json:"reason"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+type xenditRefundResponse struct {
+	Id     string `json:"id"`
+	Status string `json:"status"`
+}
+
+func (xg *xenditPaymentGateway) Name() string {
+	return ProviderXendit
+}
+
+func (xg *xenditPaymentGateway) CreateInvoice(ctx context.Context, params *CreateInvoiceParams) (*Invoice, error) {
+	items := make([]xendit.InvoiceItem, 0)
+	for _, item := range params.Items {
+		items = append(items, xendit.InvoiceItem{
+			Name:     item.Name,
+			Price:    money.New(item.Price, params.Currency).Major(),
+			Quantity: int(item.Quantity),
+		})
+	}
+
+	xenditInvoice, xenditErr := xg.invoiceClient.CreateWithContext(ctx, &invoice.CreateParams{
+		ExternalID: params.ExternalId,
+		Amount:     money.New(params.Amount, params.Currency).Major(),
+		Customer: xendit.InvoiceCustomer{
+			GivenNames: params.CustomerName,
+		},
+		Currency:           params.Currency,
+		SuccessRedirectURL: params.SuccessRedirectUrl,
+		Items:              items,
+	})
+	if xenditErr != nil {
+		return nil, xenditErr
+	}
+
+	return &Invoice{
+		Id:  xenditInvoice.ID,
+		Url: xenditInvoice.InvoiceURL,
+	}, nil
+}
+
+// GetInvoiceByExternalId mencari invoice lewat endpoint list invoice, invoice expired diabaikan
+func (xg *xenditPaymentGateway) GetInvoiceByExternalId(ctx context.Context, externalId string) (*Invoice, error) {
+	var response []xendit.Invoice
+	xenditErr := xendit.GetAPIRequester().Call(
+		ctx,
+		http.MethodGet,
+		xg.opt.XenditURL+"/v2/invoices?external_id="+url.QueryEscape(externalId),
+		xg.opt.SecretKey,
+		nil,
+		nil,
+		&response,
+	)
+	if xenditErr != nil {
+		return nil, xenditErr
+	}
+
+	for _, xenditInvoice := range response {
+		if xenditInvoice.Status == InvoiceStatusExpired {
+			continue
+		}
+
+		return &Invoice{
+			Id:  xenditInvoice.ID,
+			Url: xenditInvoice.InvoiceURL,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func (xg *xenditPaymentGateway) ExpireInvoice(ctx context.Context, invoiceId string) error {
+	_, xenditErr := xg.invoiceClient.ExpireWithContext(ctx, &invoice.ExpireParams{
+		ID: invoiceId,
+	})
+	if xenditErr != nil {
+		return xenditErr
+	}
+
+	return nil
+}
+
+// Refund memanggil refund API xendit, xendit-go v1 belum menyediakan helper untuk endpoint ini
+func (xg *xenditPaymentGateway) Refund(ctx context.Context, params *RefundParams) (*Refund, error) {
+	var response xenditRefundResponse
+	header := http.Header{}
+	header.Set("Idempotency-Key", params.ReferenceId)
+
+	xenditErr := xendit.GetAPIRequester().Call(
+		ctx,
+		http.MethodPost,
+		xg.opt.XenditURL+"/refunds",
+		xg.opt.SecretKey,
+		header,
+		newXenditRefundRequest(params),
+		&response,
+	)
+	if xenditErr != nil {
+		return nil, xenditErr
+	}
+
+	return &Refund{
+		Id:     response.Id,
+		Status: response.Status,
+	}, nil
+}
+
+func newXenditRefundRequest(params *RefundParams) *xenditRefundRequest {
+	request := &xenditRefundRequest{
+		InvoiceId:   params.InvoiceId,
+		ReferenceId: params.ReferenceId,
+		Amount:      money.New(params.Amount, params.Currency).Major(),
+		Currency:    params.Currency,
+		Reason:      xenditRefundReasonRequestedByCustomer,
+	}
+	if params.Reason != "" {
+		request.Metadata = map[string]string{
+			"reason": params.Reason,
+		}
+	}
+
+	return request
+}
+
+func (xg *xenditPaymentGateway) GetRefund(ctx context.Context, refundId string) (*Refund, error) {
+	var response xenditRefundResponse
+	xenditErr := xendit.GetAPIRequester().Call(
+		ctx,
+		http.MethodGet,
+		xg.opt.XenditURL+"/refunds/"+url.PathEscape(refundId),
+		xg.opt.SecretKey,
+		nil,
+		nil,
+		&response,
+	)
+	if xenditErr != nil {
+		return nil, xenditErr
+	}
+
+	return &Refund{
+		Id:     response.Id,
+		Status: response.Status,
+	}, nil
+}
+
+func (xg *xenditPaymentGateway) ParseWebhook(ctx context.Context, header http.Header, body []byte) (*WebhookEvent, error) {
+	// token wajib dikonfigurasi, tanpa token semua callback ditolak
+	if xg.callbackToken == "" {
+		return nil, ErrInvalidWebhookToken
+	}
+	err := verifyCallbackToken(header, xg.callbackToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var request dto.XenditInvoiceRequest
+	err = json.Unmarshal(body, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	var paidAt *time.Time
+	if !request.PaidAt.IsZero() {
+		paidAt = &request.PaidAt
+	}
+
+	// webhook-id tetap sama saat xendit melakukan retry, fallback ke invoice + status jika header tidak dikirim
+	eventId := header.Get("webhook-id")
+	if eventId == "" {
+		eventId = fmt.Sprintf("%s:%s", request.ID, request.Status)
+	}
+
+	return &WebhookEvent{
+		Provider:       ProviderXendit,
+		Id:             eventId,
+		InvoiceId:      request.ID,
+		ExternalId:     request.ExternalID,
+		Status:         request.Status,
+		Amount:         money.FromMajor(request.Amount, request.Currency).Amount,
+		PaidAmount:     money.FromMajor(request.PaidAmount, request.Currency).Amount,
+		Currency:       request.Currency,
+		PaidAt:         paidAt,
+		PaymentMethod:  request.PaymentMethod,
+		PaymentChannel: request.PaymentChannel,
+	}, nil
+}
+
+func NewXenditPaymentGateway(secretKey string, callbackToken string) IPaymentGateway {
+	if callbackToken == "" {
+		slog.Warn("XENDIT_CALLBACK_TOKEN is not set, all xendit webhooks will be rejected")
+	}
+
+	opt := &xendit.Option{
+		SecretKey: secretKey,
+		XenditURL: xendit.Opt.XenditURL,
+	}
+
+	return &xenditPaymentGateway{
+		opt: opt,
+		invoiceClient: &invoice.Client{
+			Opt:          opt,
+			APIRequester: xendit.GetAPIRequester(),
+		},
+		callbackToken: callbackToken,
+	}
+}
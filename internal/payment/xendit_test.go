@@ -0,0 +1,102 @@
+package payment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestXenditParseWebhookAmount(t *testing.T) {
+	gateway := &xenditPaymentGateway{callbackToken: "callback-token"}
+
+	tests := []struct {
+		name           string
+		body           string
+		wantAmount     int64
+		wantPaidAmount int64
+	}{
+		{
+			name:           "idr whole amount",
+			body:           `{"id":"inv-1","external_id":"order-1","status":"PAID","amount":150000,"paid_amount":150000,"currency":"IDR"}`,
+			wantAmount:     150000,
+			wantPaidAmount: 150000,
+		},
+		{
+			name:           "sgd fractional amount",
+			body:           `{"id":"inv-2","external_id":"order-2","status":"PAID","amount":12.5,"paid_amount":12.5,"currency":"SGD"}`,
+			wantAmount:     1250,
+			wantPaidAmount: 1250,
+		},
+		{
+			name:           "usd amount with two decimals",
+			body:           `{"id":"inv-3","external_id":"order-3","status":"PAID","amount":19.99,"paid_amount":19.99,"fees_paid_amount":0.5,"adjusted_received_amount":19.49,"currency":"USD"}`,
+			wantAmount:     1999,
+			wantPaidAmount: 1999,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set(callbackTokenHeader, "callback-token")
+
+			event, err := gateway.ParseWebhook(context.Background(), header, []byte(tt.body))
+			if err != nil {
+				t.Fatalf("ParseWebhook() error = %v", err)
+			}
+			if event.Amount != tt.wantAmount {
+				t.Errorf("Amount = %d, want %d", event.Amount, tt.wantAmount)
+			}
+			if event.PaidAmount != tt.wantPaidAmount {
+				t.Errorf("PaidAmount = %d, want %d", event.PaidAmount, tt.wantPaidAmount)
+			}
+		})
+	}
+}
+
+func TestXenditParseWebhookInvalidToken(t *testing.T) {
+	gateway := &xenditPaymentGateway{callbackToken: "callback-token"}
+
+	header := http.Header{}
+	header.Set(callbackTokenHeader, "wrong-token")
+	_, err := gateway.ParseWebhook(context.Background(), header, []byte(`{"amount":12.5}`))
+	if err != ErrInvalidWebhookToken {
+		t.Fatalf("ParseWebhook() error = %v, want %v", err, ErrInvalidWebhookToken)
+	}
+}
+
+func TestNewXenditRefundRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		params       *RefundParams
+		wantAmount   float64
+		wantMetadata map[string]string
+	}{
+		{
+			name:         "customer reason goes to metadata",
+			params:       &RefundParams{InvoiceId: "inv-1", ReferenceId: "refund-1", Amount: 150000, Currency: "IDR", Reason: "barang rusak"},
+			wantAmount:   150000,
+			wantMetadata: map[string]string{"reason": "barang rusak"},
+		},
+		{
+			name:       "empty reason has no metadata",
+			params:     &RefundParams{InvoiceId: "inv-2", ReferenceId: "refund-2", Amount: 1250, Currency: "SGD"},
+			wantAmount: 12.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := newXenditRefundRequest(tt.params)
+			if request.Reason != xenditRefundReasonRequestedByCustomer {
+				t.Errorf("Reason = %q, want %q", request.Reason, xenditRefundReasonRequestedByCustomer)
+			}
+			if request.Amount != tt.wantAmount {
+				t.Errorf("Amount = %v, want %v", request.Amount, tt.wantAmount)
+			}
+			if len(request.Metadata) != len(tt.wantMetadata) || request.Metadata["reason"] != tt.wantMetadata["reason"] {
+				t.Errorf("Metadata = %v, want %v", request.Metadata, tt.wantMetadata)
+			}
+		})
+	}
+}
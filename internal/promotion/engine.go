@@ -0,0 +1,130 @@
+package promotion
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
+)
+
+var (
+	ErrVoucherInactive      = errors.New("voucher is not active")
+	ErrVoucherNotStarted    = errors.New("voucher is not valid yet")
+	ErrVoucherExpired       = errors.New("voucher is expired")
+	ErrMinSpendNotMet       = errors.New("minimum spend for voucher is not met")
+	ErrVoucherNotApplicable = errors.New("voucher is not applicable to the products")
+	ErrUsageLimitReached    = errors.New("voucher usage limit is reached")
+)
+
+type Line struct {
+	ProductId string
+	Category  string
+	Price     int64
+	Quantity  int64
+}
+
+// Result berisi nominal dalam minor unit
+type Result struct {
+	EligibleSubtotal int64
+	ProductDiscount  int64
+	ShippingDiscount int64
+}
+
+func (r *Result) TotalDiscount() int64 {
+	return r.ProductDiscount + r.ShippingDiscount
+}
+
+// CheckUsage memastikan batas pemakaian global dan per user belum tercapai
+func CheckUsage(voucher *entity.Voucher, globalUsage int64, userUsage int64) error {
+	if voucher.UsageLimit != nil && globalUsage >= *voucher.UsageLimit {
+		return ErrUsageLimitReached
+	}
+	if voucher.PerUserUsageLimit != nil && userUsage >= *voucher.PerUserUsageLimit {
+		return ErrUsageLimitReached
+	}
+
+	return nil
+}
+
+// Calculate menghitung diskon voucher untuk item order dan ongkir dalam minor unit currency,
+// diskon persen dibulatkan dengan money.Percent dan tidak pernah melebihi nilai yang didiskon
+func Calculate(voucher *entity.Voucher, lines []*Line, shippingCost int64, currency string, now time.Time) (*Result, error) {
+	if !voucher.IsActive {
+		return nil, ErrVoucherInactive
+	}
+	if now.Before(voucher.StartsAt) {
+		return nil, ErrVoucherNotStarted
+	}
+	if !now.Before(voucher.EndsAt) {
+		return nil, ErrVoucherExpired
+	}
+
+	// nominal voucher hanya berlaku untuk mata uang yang sama
+	if money.NormalizeCurrency(voucher.Currency) != money.NormalizeCurrency(currency) {
+		return nil, ErrVoucherNotApplicable
+	}
+
+	var eligibleSubtotal int64
+	for _, line := range lines {
+		if inScope(voucher, line) {
+			eligibleSubtotal += line.Price * line.Quantity
+		}
+	}
+
+	if eligibleSubtotal <= 0 {
+		return nil, ErrVoucherNotApplicable
+	}
+	// minimal belanja dihitung dari produk yang masuk scope voucher
+	if eligibleSubtotal < voucher.MinSpend {
+		return nil, ErrMinSpendNotMet
+	}
+
+	result := Result{
+		EligibleSubtotal: eligibleSubtotal,
+	}
+	switch voucher.DiscountType {
+	case entity.VoucherTypePercentage:
+		result.ProductDiscount = capDiscount(money.Percent(eligibleSubtotal, voucher.DiscountValue), voucher.MaxDiscount, eligibleSubtotal)
+	case entity.VoucherTypeFixedAmount:
+		result.ProductDiscount = capDiscount(money.Round(voucher.DiscountValue), voucher.MaxDiscount, eligibleSubtotal)
+	case entity.VoucherTypeFreeShipping:
+		result.ShippingDiscount = capDiscount(shippingCost, voucher.MaxDiscount, shippingCost)
+	default:
+		return nil, ErrVoucherNotApplicable
+	}
+
+	return &result, nil
+}
+
+func capDiscount(discount int64, maxDiscount *int64, limit int64) int64 {
+	if maxDiscount != nil && discount > *maxDiscount {
+		discount = *maxDiscount
+	}
+	if discount > limit {
+		discount = limit
+	}
+
+	return discount
+}
+
+// inScope true jika voucher tidak dibatasi atau produk masuk daftar produk / kategori voucher
+func inScope(voucher *entity.Voucher, line *Line) bool {
+	if len(voucher.ProductIds) == 0 && len(voucher.Categories) == 0 {
+		return true
+	}
+
+	for _, productId := range voucher.ProductIds {
+		if productId == line.ProductId {
+			return true
+		}
+	}
+	for _, category := range voucher.Categories {
+		if line.Category != "" && strings.EqualFold(category, line.Category) {
+			return true
+		}
+	}
+
+	return false
+}
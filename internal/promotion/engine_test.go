@@ -0,0 +1,220 @@
+package promotion
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+)
+
+func TestCalculate(t *testing.T) {
+	now := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	int64Of := func(value int64) *int64 {
+		return &value
+	}
+	lines := []*Line{
+		{ProductId: "product-1", Category: "Shoes", Price: 50000, Quantity: 2},
+		{ProductId: "product-2", Category: "Bags", Price: 30000, Quantity: 1},
+	}
+	const shippingCost = 20000
+
+	tests := []struct {
+		name     string
+		voucher  func(v *entity.Voucher)
+		currency string
+		want     *Result
+		wantErr  error
+	}{
+		{
+			name: "percentage",
+			voucher: func(v *entity.Voucher) {
+				v.DiscountValue = 10
+			},
+			want: &Result{EligibleSubtotal: 130000, ProductDiscount: 13000},
+		},
+		{
+			name: "percentage capped by max discount",
+			voucher: func(v *entity.Voucher) {
+				v.DiscountValue = 10
+				v.MaxDiscount = int64Of(5000)
+			},
+			want: &Result{EligibleSubtotal: 130000, ProductDiscount: 5000},
+		},
+		{
+			name: "percentage for category ignores case",
+			voucher: func(v *entity.Voucher) {
+				v.DiscountValue = 12.5
+				v.Categories = []string{"shoes"}
+			},
+			want: &Result{EligibleSubtotal: 100000, ProductDiscount: 12500},
+		},
+		{
+			name: "fixed amount",
+			voucher: func(v *entity.Voucher) {
+				v.DiscountType = entity.VoucherTypeFixedAmount
+				v.DiscountValue = 20000
+			},
+			want: &Result{EligibleSubtotal: 130000, ProductDiscount: 20000},
+		},
+		{
+			name: "fixed amount capped by eligible product",
+			voucher: func(v *entity.Voucher) {
+				v.DiscountType = entity.VoucherTypeFixedAmount
+				v.DiscountValue = 50000
+				v.ProductIds = []string{"product-2"}
+			},
+			want: &Result{EligibleSubtotal: 30000, ProductDiscount: 30000},
+		},
+		{
+			name: "free shipping",
+			voucher: func(v *entity.Voucher) {
+				v.DiscountType = entity.VoucherTypeFreeShipping
+			},
+			want: &Result{EligibleSubtotal: 130000, ShippingDiscount: 20000},
+		},
+		{
+			name: "free shipping capped by max discount",
+			voucher: func(v *entity.Voucher) {
+				v.DiscountType = entity.VoucherTypeFreeShipping
+				v.MaxDiscount = int64Of(15000)
+			},
+			want: &Result{EligibleSubtotal: 130000, ShippingDiscount: 15000},
+		},
+		{
+			name: "currency is normalized",
+			voucher: func(v *entity.Voucher) {
+				v.DiscountValue = 10
+				v.Currency = "idr"
+			},
+			currency: " IDR ",
+			want:     &Result{EligibleSubtotal: 130000, ProductDiscount: 13000},
+		},
+		{
+			name: "min spend not met",
+			voucher: func(v *entity.Voucher) {
+				v.DiscountValue = 10
+				v.MinSpend = 200000
+			},
+			wantErr: ErrMinSpendNotMet,
+		},
+		{
+			name: "min spend uses eligible subtotal",
+			voucher: func(v *entity.Voucher) {
+				v.DiscountValue = 10
+				v.MinSpend = 50000
+				v.Categories = []string{"Bags"}
+			},
+			wantErr: ErrMinSpendNotMet,
+		},
+		{
+			name: "inactive",
+			voucher: func(v *entity.Voucher) {
+				v.IsActive = false
+			},
+			wantErr: ErrVoucherInactive,
+		},
+		{
+			name: "not started",
+			voucher: func(v *entity.Voucher) {
+				v.StartsAt = now.Add(time.Minute)
+			},
+			wantErr: ErrVoucherNotStarted,
+		},
+		{
+			name: "expired at ends at",
+			voucher: func(v *entity.Voucher) {
+				v.EndsAt = now
+			},
+			wantErr: ErrVoucherExpired,
+		},
+		{
+			name: "different currency",
+			voucher: func(v *entity.Voucher) {
+				v.Currency = "SGD"
+			},
+			wantErr: ErrVoucherNotApplicable,
+		},
+		{
+			name: "no product in scope",
+			voucher: func(v *entity.Voucher) {
+				v.ProductIds = []string{"product-3"}
+			},
+			wantErr: ErrVoucherNotApplicable,
+		},
+		{
+			name: "unknown discount type",
+			voucher: func(v *entity.Voucher) {
+				v.DiscountType = "buy_one_get_one"
+			},
+			wantErr: ErrVoucherNotApplicable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			voucher := &entity.Voucher{
+				Code:         "HEMAT",
+				DiscountType: entity.VoucherTypePercentage,
+				Currency:     "IDR",
+				StartsAt:     now.Add(-time.Hour),
+				EndsAt:       now.Add(time.Hour),
+				IsActive:     true,
+			}
+			tt.voucher(voucher)
+			currency := tt.currency
+			if currency == "" {
+				currency = "IDR"
+			}
+
+			got, err := Calculate(voucher, lines, shippingCost, currency, now)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Calculate() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Calculate() error = %v", err)
+			}
+			if *got != *tt.want {
+				t.Errorf("Calculate() = %+v, want %+v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckUsage(t *testing.T) {
+	int64Of := func(value int64) *int64 {
+		return &value
+	}
+
+	tests := []struct {
+		name              string
+		usageLimit        *int64
+		perUserUsageLimit *int64
+		globalUsage       int64
+		userUsage         int64
+		wantErr           error
+	}{
+		{name: "no limit", globalUsage: 1000, userUsage: 100},
+		{name: "below global limit", usageLimit: int64Of(10), globalUsage: 9},
+		{name: "global limit reached", usageLimit: int64Of(10), globalUsage: 10, wantErr: ErrUsageLimitReached},
+		{name: "below per user limit", perUserUsageLimit: int64Of(2), userUsage: 1},
+		{name: "per user limit reached", perUserUsageLimit: int64Of(1), userUsage: 1, wantErr: ErrUsageLimitReached},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			voucher := &entity.Voucher{
+				UsageLimit:        tt.usageLimit,
+				PerUserUsageLimit: tt.perUserUsageLimit,
+			}
+
+			err := CheckUsage(voucher, tt.globalUsage, tt.userUsage)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckUsage() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
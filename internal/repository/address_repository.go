@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
+)
+
+type IAddressRepository interface {
+	WithTrancastion(tx *sql.Tx) IAddressRepository
+	CreateAddress(ctx context.Context, address *entity.UserAddress) error
+	GetAddressById(ctx context.Context, addressId string) (*entity.UserAddress, error)
+	GetAddressesByUserId(ctx context.Context, userId string) ([]*entity.UserAddress, error)
+	UpdateAddress(ctx context.Context, address *entity.UserAddress) error
+	UnsetDefaultAddress(ctx context.Context, userId string) error
+	DeleteAddress(ctx context.Context, addressId string, deletedAt time.Time, deletedBy string) error
+}
+
+type addressRepository struct {
+	db database.DatabaseQuery
+}
+
+func (ar *addressRepository) WithTrancastion(tx *sql.Tx) IAddressRepository {
+	return &addressRepository{
+		db: tx,
+	}
+}
+
+func (ar *addressRepository) CreateAddress(ctx context.Context, address *entity.UserAddress) error {
+	_, err := ar.db.ExecContext(
+		ctx,
+		"INSERT INTO user_address (id, user_id, label, recipient_name, phone_number, address, city, province, postal_code, is_default, created_at, created_by, is_deleted) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, false)",
+		address.Id,
+		address.UserId,
+		address.Label,
+		address.RecipientName,
+		address.PhoneNumber,
+		address.Address,
+		address.City,
+		address.Province,
+		address.PostalCode,
+		address.IsDefault,
+		address.CreatedAt,
+		address.CreatedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ar *addressRepository) GetAddressById(ctx context.Context, addressId string) (*entity.UserAddress, error) {
+	row := ar.db.QueryRowContext(
+		ctx,
+		"SELECT id, user_id, label, recipient_name, phone_number, address, city, province, postal_code, is_default FROM user_address WHERE id = $1 AND is_deleted = false",
+		UUIDOrNil(addressId),
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var address entity.UserAddress
+	err := row.Scan(
+		&address.Id,
+		&address.UserId,
+		&address.Label,
+		&address.RecipientName,
+		&address.PhoneNumber,
+		&address.Address,
+		&address.City,
+		&address.Province,
+		&address.PostalCode,
+		&address.IsDefault,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &address, nil
+}
+
+func (ar *addressRepository) GetAddressesByUserId(ctx context.Context, userId string) ([]*entity.UserAddress, error) {
+	rows, err := ar.db.QueryContext(
+		ctx,
+		"SELECT id, user_id, label, recipient_name, phone_number, address, city, province, postal_code, is_default FROM user_address WHERE user_id = $1 AND is_deleted = false ORDER BY is_default DESC, created_at DESC",
+		userId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]*entity.UserAddress, 0)
+	for rows.Next() {
+		var address entity.UserAddress
+		err = rows.Scan(
+			&address.Id,
+			&address.UserId,
+			&address.Label,
+			&address.RecipientName,
+			&address.PhoneNumber,
+			&address.Address,
+			&address.City,
+			&address.Province,
+			&address.PostalCode,
+			&address.IsDefault,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, &address)
+	}
+
+	return addresses, nil
+}
+
+func (ar *addressRepository) UpdateAddress(ctx context.Context, address *entity.UserAddress) error {
+	_, err := ar.db.ExecContext(
+		ctx,
+		"UPDATE user_address SET label = $1, recipient_name = $2, phone_number = $3, address = $4, city = $5, province = $6, postal_code = $7, is_default = $8, updated_at = $9, updated_by = $10 WHERE id = $11",
+		address.Label,
+		address.RecipientName,
+		address.PhoneNumber,
+		address.Address,
+		address.City,
+		address.Province,
+		address.PostalCode,
+		address.IsDefault,
+		address.UpdatedAt,
+		address.UpdatedBy,
+		address.Id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ar *addressRepository) UnsetDefaultAddress(ctx context.Context, userId string) error {
+	_, err := ar.db.ExecContext(
+		ctx,
+		"UPDATE user_address SET is_default = false WHERE user_id = $1 AND is_default = true",
+		userId,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ar *addressRepository) DeleteAddress(ctx context.Context, addressId string, deletedAt time.Time, deletedBy string) error {
+	_, err := ar.db.ExecContext(
+		ctx,
+		"UPDATE user_address SET deleted_at = $1, deleted_by = $2, is_deleted = true, is_default = false WHERE id = $3",
+		deletedAt,
+		deletedBy,
+		addressId,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewAddressRepository(db database.DatabaseQuery) IAddressRepository {
+	return &addressRepository{
+		db: db,
+	}
+}
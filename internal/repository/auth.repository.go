@@ -4,7 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"log"
+	"log/slog"
 	"time"
 
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
@@ -12,6 +12,7 @@ import (
 
 type IAuthRepository interface {
 	GetUserByEmail(ctx context.Context, email string) (*entity.User, error)
+	GetUserById(ctx context.Context, id string) (*entity.User, error)
 	InsertUser(ctx context.Context, user *entity.User) error
 	UpdateUserPassword(ctx context.Context, userID string, hashedNewPasswrod string, updateBy string) error
 }
@@ -45,6 +46,30 @@ func (ar *authRepository) GetUserByEmail(ctx context.Context, email string) (*en
 	return &user, nil
 }
 
+func (ar *authRepository) GetUserById(ctx context.Context, id string) (*entity.User, error) {
+	row := ar.db.QueryRowContext(ctx, "SELECT id, email, full_name, role_code, created_at FROM \"user\" WHERE id = $1 AND is_deleted IS false", UUIDOrNil(id))
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var user entity.User
+	err := row.Scan(
+		&user.Id,
+		&user.Email,
+		&user.FullName,
+		&user.RoleCode,
+		&user.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
+
 func (ar *authRepository) InsertUser(ctx context.Context, user *entity.User) error {
 	_, err := ar.db.ExecContext(
 		ctx,
@@ -63,7 +88,7 @@ func (ar *authRepository) InsertUser(ctx context.Context, user *entity.User) err
 		user.IsDeleted,
 	)
 	if err != nil {
-		log.Printf("[ERROR][InsertUser] gagal insert user %s: %v", user.Email, err)
+		slog.ErrorContext(ctx, "insert user failed", "email", user.Email, "error", err)
 		return err
 	}
 	return nil
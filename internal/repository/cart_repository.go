@@ -4,81 +4,95 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
 )
 
 type ICartRepository interface {
-	GetCartByProductAndUserId(ctx context.Context, ProductId, userID string) (*entity.UserCart,error)
-	CreateNewCart(ctx context.Context, cart *entity.UserCart) error
+	WithTrancastion(tx *sql.Tx) ICartRepository
+	UpsertCart(ctx context.Context, cart *entity.UserCart, addQuantity bool) error
 	UpdateCart(ctx context.Context, cart *entity.UserCart) error
-	GetlistCart (ctx context.Context, userId string) ([]*entity.UserCart, error)
+	GetlistCart (ctx context.Context, owner *entity.CartOwner) ([]*entity.UserCart, error)
 	GetCartById (ctx context.Context, cartId string) (*entity.UserCart, error)
 	DeleteCart (ctx context.Context, cartId string) error
+	DeleteCartByOwner(ctx context.Context, owner *entity.CartOwner, exceptProductIds []string) error
+	DeleteCartByProductIds(ctx context.Context, owner *entity.CartOwner, productIds []string) error
+	MergeGuestCart(ctx context.Context, guestCartId string, userId string, mergedAt time.Time, mergedBy string) error
+	MarkAbandonedCarts(ctx context.Context, idleBefore time.Time, abandonedAt time.Time) (int, error)
+	DeleteExpiredGuestCarts(ctx context.Context, idleBefore time.Time) (int64, error)
 }
 
 type cartRepository struct {
-	db *sql.DB
+	db database.DatabaseQuery
 }
 
-func (cr *cartRepository) GetCartByProductAndUserId(ctx context.Context, ProductId, userId string) (*entity.UserCart,error) {
-	row := cr.db.QueryRowContext(
-		ctx,
-		"SELECT id, product_id, user_id, quantity, created_at, created_by, updated_at, updated_by FROM user_cart WHERE product_id = $1 AND user_id = $2",
-		ProductId,
-		userId,
-	)
-	if row.Err() != nil {
-		return  nil, row.Err()
+func (cr *cartRepository) WithTrancastion(tx *sql.Tx) ICartRepository {
+	return &cartRepository{
+		db: tx,
 	}
+}
 
-	var cartEntity entity.UserCart
-	err :=  row.Scan(
-		&cartEntity.Id,
-		&cartEntity.ProductId,
-		&cartEntity.UserId,
-		&cartEntity.Quantity,
-		&cartEntity.CreatedAt,
-		&cartEntity.CreatedBy,
-		&cartEntity.UpdatedAt,
-		&cartEntity.UpdatedBy,
-	)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows){
-			return nil, nil
-		}
-		return nil, err
+// cartOwnerFilter mengembalikan kondisi where untuk cart user atau cart guest
+func cartOwnerFilter(owner *entity.CartOwner, column string) (string, any) {
+	if owner.IsGuest() {
+		return fmt.Sprintf("%sguest_cart_id = $%%d", column), UUIDOrNil(owner.GuestCartId)
 	}
 
-	return  &cartEntity, nil
+	return fmt.Sprintf("%suser_id = $%%d", column), UUIDOrNil(owner.UserId)
 }
 
-func (cs *cartRepository) CreateNewCart(ctx context.Context, cart *entity.UserCart) error {
-	_, err :=cs.db.ExecContext(
+// UpsertCart menyimpan produk ke cart dalam satu query sehingga penambahan bersamaan tidak membuat baris ganda.
+// addQuantity menambahkan quantity ke baris yang sudah ada, jika false quantity diganti. Keduanya dibatasi entity.MaxCartQuantity.
+// Id dan Quantity cart diisi ulang dengan nilai yang tersimpan
+func (cr *cartRepository) UpsertCart(ctx context.Context, cart *entity.UserCart, addQuantity bool) error {
+	conflictTarget := "(user_id, product_id) WHERE user_id IS NOT NULL"
+	if cart.UserId == "" {
+		conflictTarget = "(guest_cart_id, product_id) WHERE guest_cart_id IS NOT NULL"
+	}
+
+	// harga saat ditambahkan diperbarui setiap produk ditambahkan lagi, penggantian quantity tidak mengubahnya
+	quantityUpdate := "quantity = LEAST(EXCLUDED.quantity, $10), price_at_add = COALESCE(user_cart.price_at_add, EXCLUDED.price_at_add), currency_at_add = COALESCE(user_cart.currency_at_add, EXCLUDED.currency_at_add)"
+	if addQuantity {
+		quantityUpdate = "quantity = LEAST(user_cart.quantity + EXCLUDED.quantity, $10), price_at_add = EXCLUDED.price_at_add, currency_at_add = EXCLUDED.currency_at_add"
+	}
+
+	row := cr.db.QueryRowContext(
 		ctx,
-		"INSERT INTO user_cart (id, product_id, user_id, quantity, created_at, created_by, updated_at, updated_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		"INSERT INTO user_cart (id, product_id, user_id, guest_cart_id, quantity, price_at_add, currency_at_add, created_at, created_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) "+
+			"ON CONFLICT "+conflictTarget+" DO UPDATE SET "+quantityUpdate+", updated_at = EXCLUDED.created_at, updated_by = EXCLUDED.created_by, abandoned_at = NULL "+
+			"RETURNING id, quantity",
 		cart.Id,
 		cart.ProductId,
-		cart.UserId,
+		UUIDOrNil(cart.UserId),
+		cart.GuestCartId,
 		cart.Quantity,
+		cart.PriceAtAdd,
+		cart.CurrencyAtAdd,
 		cart.CreatedAt,
 		cart.CreatedBy,
-		cart.UpdatedAt,
-		cart.UpdatedBy,
+		entity.MaxCartQuantity,
 	)
-	if err != nil {
-		return err
+	if row.Err() != nil {
+		return row.Err()
 	}
-	return nil
+
+	return row.Scan(&cart.Id, &cart.Quantity)
 }
 
 func (cs *cartRepository) UpdateCart(ctx context.Context, cart *entity.UserCart) error{
 	_, err :=cs.db.ExecContext(
 		ctx,
-		"UPDATE user_cart SET product_id = $1, user_id= $2, quantity = $3, updated_at = $4, updated_by = $5 WHERE id = $6",
+		"UPDATE user_cart SET product_id = $1, user_id= $2, guest_cart_id = $3, quantity = $4, price_at_add = $5, currency_at_add = $6, updated_at = $7, updated_by = $8, abandoned_at = NULL WHERE id = $9",
 		cart.ProductId,
-		cart.UserId,
+		UUIDOrNil(cart.UserId),
+		cart.GuestCartId,
 		cart.Quantity,
+		cart.PriceAtAdd,
+		cart.CurrencyAtAdd,
 		cart.UpdatedAt,
 		cart.UpdatedBy,
 		cart.Id,
@@ -89,11 +103,13 @@ func (cs *cartRepository) UpdateCart(ctx context.Context, cart *entity.UserCart)
 	return nil
 }
 
-func (cr *cartRepository) GetlistCart (ctx context.Context, userId string) ([]*entity.UserCart, error){
+// GetlistCart ikut mengembalikan item yang produknya sudah dihapus, Product nil jika produk tidak ditemukan
+func (cr *cartRepository) GetlistCart (ctx context.Context, owner *entity.CartOwner) ([]*entity.UserCart, error){
+	ownerFilter, ownerArg := cartOwnerFilter(owner, "uc.")
 	 rows, err := cr.db.QueryContext(
 		ctx,
-		"SELECT uc.id, uc.product_id, uc.user_id, uc.quantity , uc.created_at, uc.created_by, uc.updated_at, uc.updated_by, p.id, p.name, p.image_file_name, p.price FROM user_cart uc JOIN product p ON uc.product_id = p.id WHERE uc.user_id = $1 AND p.is_deleted = false",
-		userId,
+		"SELECT uc.id, uc.product_id, COALESCE(uc.user_id::text, ''), uc.guest_cart_id, uc.quantity, uc.price_at_add, uc.currency_at_add, uc.created_at, uc.created_by, uc.updated_at, uc.updated_by, p.id, p.name, p.image_file_name, p.price, p.currency, p.is_deleted FROM user_cart uc LEFT JOIN product p ON uc.product_id = p.id WHERE "+fmt.Sprintf(ownerFilter, 1)+" ORDER BY uc.created_at",
+		ownerArg,
 	)
 	if err != nil {
 		return nil, err
@@ -102,26 +118,44 @@ func (cr *cartRepository) GetlistCart (ctx context.Context, userId string) ([]*e
 	var carts []*entity.UserCart = make([]*entity.UserCart, 0)
 	for rows.Next(){
 		var cart entity.UserCart
-		cart.Product = &entity.Product{}
+		var productId, productName, productImageFileName, productCurrency sql.NullString
+		var productPrice sql.NullInt64
+		var productIsDeleted sql.NullBool
 
 		err = rows.Scan(
 			&cart.Id,
 			&cart.ProductId,
 			&cart.UserId,
+			&cart.GuestCartId,
 			&cart.Quantity,
+			&cart.PriceAtAdd,
+			&cart.CurrencyAtAdd,
 			&cart.CreatedAt,
 			&cart.CreatedBy,
 			&cart.UpdatedAt,
 			&cart.UpdatedBy,
-			&cart.Product.Id,
-			&cart.Product.Name,
-			&cart.Product.ImageFileName,
-			&cart.Product.Price,
+			&productId,
+			&productName,
+			&productImageFileName,
+			&productPrice,
+			&productCurrency,
+			&productIsDeleted,
 		)
 		if err != nil {
 			return nil, err
 		}
 
+		if productId.Valid {
+			cart.Product = &entity.Product{
+				Id:            productId.String,
+				Name:          productName.String,
+				ImageFileName: productImageFileName.String,
+				Price:         productPrice.Int64,
+				Currency:      productCurrency.String,
+				IsDeleted:     productIsDeleted.Bool,
+			}
+		}
+
 		carts = append(carts, &cart)
 	}
 
@@ -131,8 +165,8 @@ func (cr *cartRepository) GetlistCart (ctx context.Context, userId string) ([]*e
 func (cr *cartRepository) GetCartById (ctx context.Context, cartId string) (*entity.UserCart, error){
 	row := cr.db.QueryRowContext(
 		ctx,
-		"SELECT id, product_id, user_id, quantity, created_at, created_by, updated_at, updated_by FROM user_cart WHERE id = $1",
-		cartId, 
+		"SELECT id, product_id, COALESCE(user_id::text, ''), guest_cart_id, quantity, price_at_add, currency_at_add, created_at, created_by, updated_at, updated_by FROM user_cart WHERE id = $1",
+		UUIDOrNil(cartId),
 	)
 	if row.Err() != nil {
 		return  nil, row.Err()
@@ -143,7 +177,10 @@ func (cr *cartRepository) GetCartById (ctx context.Context, cartId string) (*ent
 		&cart.Id,
 		&cart.ProductId,
 		&cart.UserId,
+		&cart.GuestCartId,
 		&cart.Quantity,
+		&cart.PriceAtAdd,
+		&cart.CurrencyAtAdd,
 		&cart.CreatedAt,
 		&cart.CreatedBy,
 		&cart.UpdatedAt,
@@ -158,10 +195,13 @@ func (cr *cartRepository) GetCartById (ctx context.Context, cartId string) (*ent
 
 	return  &cart, nil
 }
+// DeleteCart menghapus satu item cart, item lain milik pemilik yang sama ditandai ada aktivitas
 func (cr *cartRepository) DeleteCart (ctx context.Context, cartId string) error{
 	_, err := cr.db.ExecContext(
 		ctx,
-		"DELETE FROM user_cart WHERE id = $1",
+		"WITH deleted AS (DELETE FROM user_cart WHERE id = $1 RETURNING id, user_id, guest_cart_id) "+
+			"UPDATE user_cart uc SET owner_activity_at = now(), abandoned_at = NULL FROM deleted d "+
+			"WHERE uc.id <> d.id AND (uc.user_id = d.user_id OR uc.guest_cart_id = d.guest_cart_id)",
 		cartId, 
 	)
 	if err != nil {
@@ -171,8 +211,140 @@ func (cr *cartRepository) DeleteCart (ctx context.Context, cartId string) error{
 	return  nil
 }
 
+// DeleteCartByOwner menghapus seluruh cart milik owner kecuali produk di exceptProductIds
+func (cr *cartRepository) DeleteCartByOwner(ctx context.Context, owner *entity.CartOwner, exceptProductIds []string) error {
+	// array nil dikirim sebagai NULL sehingga tidak ada baris yang terhapus
+	if exceptProductIds == nil {
+		exceptProductIds = []string{}
+	}
+
+	ownerFilter, ownerArg := cartOwnerFilter(owner, "")
+	_, err := cr.db.ExecContext(
+		ctx,
+		"WITH deleted AS (DELETE FROM user_cart WHERE "+fmt.Sprintf(ownerFilter, 1)+" AND NOT (product_id::text = ANY($2)) RETURNING id) "+
+			"UPDATE user_cart SET owner_activity_at = now(), abandoned_at = NULL WHERE "+fmt.Sprintf(ownerFilter, 1)+" AND product_id::text = ANY($2) AND EXISTS (SELECT 1 FROM deleted)",
+		ownerArg,
+		pq.Array(exceptProductIds),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteCartByProductIds menghapus produk dari cart owner, item yang tersisa ditandai ada aktivitas
+func (cr *cartRepository) DeleteCartByProductIds(ctx context.Context, owner *entity.CartOwner, productIds []string) error {
+	ownerFilter, ownerArg := cartOwnerFilter(owner, "")
+	_, err := cr.db.ExecContext(
+		ctx,
+		"WITH deleted AS (DELETE FROM user_cart WHERE "+fmt.Sprintf(ownerFilter, 1)+" AND product_id::text = ANY($2) RETURNING id) "+
+			"UPDATE user_cart SET owner_activity_at = now(), abandoned_at = NULL WHERE "+fmt.Sprintf(ownerFilter, 1)+" AND NOT (product_id::text = ANY($2)) AND EXISTS (SELECT 1 FROM deleted)",
+		ownerArg,
+		pq.Array(productIds),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MergeGuestCart memindahkan cart guest ke user, quantity produk yang sudah ada di cart user dijumlahkan sampai entity.MaxCartQuantity.
+// Dipanggil di dalam transaksi agar cart tidak setengah tergabung
+func (cr *cartRepository) MergeGuestCart(ctx context.Context, guestCartId string, userId string, mergedAt time.Time, mergedBy string) error {
+	_, err := cr.db.ExecContext(
+		ctx,
+		"UPDATE user_cart uc SET quantity = LEAST(uc.quantity + g.quantity, $5), updated_at = $3, updated_by = $4, abandoned_at = NULL FROM user_cart g WHERE g.guest_cart_id = $1 AND uc.user_id = $2 AND uc.product_id = g.product_id",
+		guestCartId,
+		userId,
+		mergedAt,
+		mergedBy,
+		entity.MaxCartQuantity,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = cr.db.ExecContext(
+		ctx,
+		"DELETE FROM user_cart g WHERE g.guest_cart_id = $1 AND EXISTS (SELECT 1 FROM user_cart uc WHERE uc.user_id = $2 AND uc.product_id = g.product_id)",
+		guestCartId,
+		userId,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = cr.db.ExecContext(
+		ctx,
+		"UPDATE user_cart SET user_id = $2, guest_cart_id = NULL, updated_at = $3, updated_by = $4, abandoned_at = NULL WHERE guest_cart_id = $1",
+		guestCartId,
+		userId,
+		mergedAt,
+		mergedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarkAbandonedCarts menandai cart yang tidak diubah sejak idleBefore dan mengembalikan jumlah cart (per pemilik) yang baru ditandai.
+// Cart yang sudah ditandai tidak dihitung lagi sampai ada item yang diubah atau dihapus.
+// Baris diklaim dengan SKIP LOCKED dan pemilik hanya ditandai jika semua barisnya terklaim,
+// sehingga replica yang berjalan bersamaan tidak menghitung cart yang sama dua kali
+func (cr *cartRepository) MarkAbandonedCarts(ctx context.Context, idleBefore time.Time, abandonedAt time.Time) (int, error) {
+	row := cr.db.QueryRowContext(
+		ctx,
+		"WITH idle AS ("+
+			"SELECT COALESCE(user_id, guest_cart_id) AS owner_id, COUNT(*) FILTER (WHERE abandoned_at IS NULL) AS pending_count FROM user_cart "+
+			"GROUP BY COALESCE(user_id, guest_cart_id) "+
+			"HAVING MAX(GREATEST(COALESCE(updated_at, created_at), COALESCE(owner_activity_at, created_at))) < $1 AND BOOL_OR(abandoned_at IS NULL)"+
+			"), claimed AS ("+
+			"SELECT uc.id, COALESCE(uc.user_id, uc.guest_cart_id) AS owner_id FROM user_cart uc "+
+			"WHERE uc.abandoned_at IS NULL AND COALESCE(uc.updated_at, uc.created_at) < $1 AND COALESCE(uc.owner_activity_at, uc.created_at) < $1 "+
+			"AND COALESCE(uc.user_id, uc.guest_cart_id) IN (SELECT owner_id FROM idle) FOR UPDATE SKIP LOCKED"+
+			"), complete AS ("+
+			"SELECT c.owner_id FROM claimed c JOIN idle i ON i.owner_id = c.owner_id GROUP BY c.owner_id, i.pending_count HAVING COUNT(*) = i.pending_count"+
+			"), marked AS ("+
+			"UPDATE user_cart uc SET abandoned_at = $2 FROM claimed c JOIN complete o ON o.owner_id = c.owner_id WHERE uc.id = c.id RETURNING c.owner_id"+
+			") SELECT COUNT(DISTINCT owner_id) FROM marked",
+		idleBefore,
+		abandonedAt,
+	)
+	if row.Err() != nil {
+		return 0, row.Err()
+	}
+
+	var count int
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// DeleteExpiredGuestCarts menghapus seluruh cart guest yang tidak ada aktivitas sejak idleBefore dan mengembalikan jumlah baris yang terhapus
+func (cr *cartRepository) DeleteExpiredGuestCarts(ctx context.Context, idleBefore time.Time) (int64, error) {
+	result, err := cr.db.ExecContext(
+		ctx,
+		"DELETE FROM user_cart WHERE guest_cart_id IN ("+
+			"SELECT guest_cart_id FROM user_cart WHERE guest_cart_id IS NOT NULL GROUP BY guest_cart_id "+
+			"HAVING MAX(GREATEST(COALESCE(updated_at, created_at), COALESCE(owner_activity_at, created_at))) < $1"+
+			")",
+		idleBefore,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
 
-func NewCartRepository(db *sql.DB) ICartRepository  {
+func NewCartRepository(db database.DatabaseQuery) ICartRepository  {
 	return  &cartRepository{
 		db: db,
 	}
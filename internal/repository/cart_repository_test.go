@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+)
+
+// placeholderRegexp dipakai fake driver untuk menghitung parameter seperti postgres
+var placeholderRegexp = regexp.MustCompile(`\$(\d+)`)
+
+// fakeDriver mencatat query terakhir dan menolak jumlah argumen yang tidak sama dengan placeholder,
+// sama seperti lib/pq. Query mengembalikan satu baris berisi argumen pertama dan kelima (id, quantity)
+type fakeDriver struct {
+	lastQuery string
+}
+
+func (fd *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{driver: fd}, nil
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+}
+
+func (fc *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	fc.driver.lastQuery = query
+	return &fakeStmt{query: query}, nil
+}
+
+func (fc *fakeConn) Close() error {
+	return nil
+}
+
+func (fc *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeStmt struct {
+	query string
+}
+
+func (fs *fakeStmt) Close() error {
+	return nil
+}
+
+func (fs *fakeStmt) NumInput() int {
+	max := 0
+	for _, match := range placeholderRegexp.FindAllStringSubmatch(fs.query, -1) {
+		n, _ := strconv.Atoi(match[1])
+		if n > max {
+			max = n
+		}
+	}
+
+	return max
+}
+
+func (fs *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(1), nil
+}
+
+func (fs *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{values: [][]driver.Value{{args[0], args[4]}}}, nil
+}
+
+type fakeRows struct {
+	values [][]driver.Value
+}
+
+func (fr *fakeRows) Columns() []string {
+	return []string{"id", "quantity"}
+}
+
+func (fr *fakeRows) Close() error {
+	return nil
+}
+
+func (fr *fakeRows) Next(dest []driver.Value) error {
+	if len(fr.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, fr.values[0])
+	fr.values = fr.values[1:]
+	return nil
+}
+
+var cartTestDriver = &fakeDriver{}
+
+func init() {
+	sql.Register("cart_repository_test", cartTestDriver)
+}
+
+func TestUpsertCart(t *testing.T) {
+	db, err := sql.Open("cart_repository_test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	tests := []struct {
+		name         string
+		addQuantity  bool
+		guest        bool
+		wantQuantity string
+	}{
+		{name: "add quantity", addQuantity: true, wantQuantity: "quantity = LEAST(user_cart.quantity + EXCLUDED.quantity, $10)"},
+		{name: "replace quantity", addQuantity: false, wantQuantity: "quantity = LEAST(EXCLUDED.quantity, $10)"},
+		{name: "replace guest quantity", addQuantity: false, guest: true, wantQuantity: "quantity = LEAST(EXCLUDED.quantity, $10)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cart := &entity.UserCart{
+				Id:        "5f0c6f5e-7d3b-4a47-9a0e-1b2c3d4e5f60",
+				ProductId: "product-1",
+				UserId:    "user-1",
+				Quantity:  3,
+				CreatedAt: time.Now(),
+				CreatedBy: "Budi",
+			}
+			if tt.guest {
+				guestCartId := "guest-1"
+				cart.UserId = ""
+				cart.GuestCartId = &guestCartId
+			}
+
+			err := NewCartRepository(db).UpsertCart(context.Background(), cart, tt.addQuantity)
+			if err != nil {
+				t.Fatalf("UpsertCart() error = %v", err)
+			}
+			if !strings.Contains(cartTestDriver.lastQuery, tt.wantQuantity) {
+				t.Errorf("query = %q, want contains %q", cartTestDriver.lastQuery, tt.wantQuantity)
+			}
+			if cart.Quantity != 3 {
+				t.Errorf("Quantity = %d, want 3", cart.Quantity)
+			}
+		})
+	}
+}
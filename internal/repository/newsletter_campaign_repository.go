@@ -0,0 +1,527 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
+)
+
+type INewsletterCampaignRepository interface {
+	WithTrancastion(tx *sql.Tx) INewsletterCampaignRepository
+	CreateCampaign(ctx context.Context, campaign *entity.NewsletterCampaign) error
+	GetCampaignById(ctx context.Context, id string) (*entity.NewsletterCampaign, error)
+	GetCampaignsPagination(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.NewsletterCampaign, *common.PaginationResponse, error)
+	GetCampaignStats(ctx context.Context, id string) (*entity.NewsletterCampaignStats, error)
+	ScheduleCampaign(ctx context.Context, id string, scheduledAt time.Time, updatedAt time.Time, updatedBy string) (bool, error)
+	CancelCampaign(ctx context.Context, id string, updatedAt time.Time, updatedBy string) (bool, error)
+	ClaimDueCampaigns(ctx context.Context, now time.Time, limit int) ([]*entity.NewsletterCampaign, error)
+	CreateCampaignRecipients(ctx context.Context, campaign *entity.NewsletterCampaign, now time.Time) (int64, error)
+	CompleteSendingCampaigns(ctx context.Context, now time.Time) error
+	ClaimPendingRecipients(ctx context.Context, limit int, leaseUntil time.Time) ([]*entity.NewsletterCampaignRecipient, error)
+	MarkRecipientSent(ctx context.Context, id string, sentAt time.Time) error
+	MarkRecipientRetry(ctx context.Context, id string, nextAttemptAt time.Time, lastError string) error
+	MarkRecipientFailed(ctx context.Context, id string, lastError string) error
+	MarkRecipientOpened(ctx context.Context, id string, openedAt time.Time) error
+	MarkRecipientClicked(ctx context.Context, id string, clickedAt time.Time) error
+}
+
+type newsletterCampaignRepository struct {
+	db database.DatabaseQuery
+}
+
+func (nr *newsletterCampaignRepository) WithTrancastion(tx *sql.Tx) INewsletterCampaignRepository {
+	return &newsletterCampaignRepository{
+		db: tx,
+	}
+}
+
+func (nr *newsletterCampaignRepository) CreateCampaign(ctx context.Context, campaign *entity.NewsletterCampaign) error {
+	_, err := nr.db.ExecContext(
+		ctx,
+		"INSERT INTO newsletter_campaign (id, name, template_code, subject, title, body, cta_text, cta_url, segment, topic, status, scheduled_at, total_recipients, created_at, created_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)",
+		campaign.Id,
+		campaign.Name,
+		campaign.TemplateCode,
+		campaign.Subject,
+		campaign.Title,
+		campaign.Body,
+		campaign.CtaText,
+		campaign.CtaUrl,
+		campaign.Segment,
+		campaign.Topic,
+		campaign.Status,
+		campaign.ScheduledAt,
+		campaign.TotalRecipients,
+		campaign.CreatedAt,
+		campaign.CreatedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (nr *newsletterCampaignRepository) GetCampaignById(ctx context.Context, id string) (*entity.NewsletterCampaign, error) {
+	row := nr.db.QueryRowContext(
+		ctx,
+		"SELECT id, name, template_code, subject, title, body, cta_text, cta_url, segment, topic, status, scheduled_at, started_at, completed_at, total_recipients, created_at, created_by FROM newsletter_campaign WHERE id = $1 AND is_deleted = false",
+		UUIDOrNil(id),
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var campaign entity.NewsletterCampaign
+	err := row.Scan(
+		&campaign.Id,
+		&campaign.Name,
+		&campaign.TemplateCode,
+		&campaign.Subject,
+		&campaign.Title,
+		&campaign.Body,
+		&campaign.CtaText,
+		&campaign.CtaUrl,
+		&campaign.Segment,
+		&campaign.Topic,
+		&campaign.Status,
+		&campaign.ScheduledAt,
+		&campaign.StartedAt,
+		&campaign.CompletedAt,
+		&campaign.TotalRecipients,
+		&campaign.CreatedAt,
+		&campaign.CreatedBy,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &campaign, nil
+}
+
+func (nr *newsletterCampaignRepository) GetCampaignsPagination(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.NewsletterCampaign, *common.PaginationResponse, error) {
+	row := nr.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM newsletter_campaign WHERE is_deleted = false")
+	if row.Err() != nil {
+		return nil, nil, row.Err()
+	}
+
+	var totalCount int
+	err := row.Scan(&totalCount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	offset := (pagination.CurrentPage - 1) * pagination.ItemPerPage
+	totalPages := (totalCount + int(pagination.ItemPerPage) - 1) / int(pagination.ItemPerPage)
+
+	allowedSorts := map[string]string{
+		"name":         "name",
+		"status":       "status",
+		"scheduled_at": "scheduled_at",
+		"created_at":   "created_at",
+	}
+	sort := "ORDER BY created_at DESC"
+	if pagination.Sort != nil {
+		direction := "ASC"
+		sortField, ok := allowedSorts[pagination.Sort.Field]
+		if ok {
+			if pagination.Sort.Direction == "desc" {
+				direction = "DESC"
+			}
+			sort = fmt.Sprintf("ORDER BY %s %s", sortField, direction)
+		}
+	}
+
+	rows, err := nr.db.QueryContext(
+		ctx,
+		fmt.Sprintf("SELECT id, name, template_code, subject, segment, topic, status, scheduled_at, started_at, completed_at, total_recipients, created_at, created_by FROM newsletter_campaign WHERE is_deleted = false %s LIMIT $1 OFFSET $2", sort),
+		pagination.ItemPerPage,
+		offset,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	campaigns := make([]*entity.NewsletterCampaign, 0)
+	for rows.Next() {
+		var campaign entity.NewsletterCampaign
+		err = rows.Scan(
+			&campaign.Id,
+			&campaign.Name,
+			&campaign.TemplateCode,
+			&campaign.Subject,
+			&campaign.Segment,
+			&campaign.Topic,
+			&campaign.Status,
+			&campaign.ScheduledAt,
+			&campaign.StartedAt,
+			&campaign.CompletedAt,
+			&campaign.TotalRecipients,
+			&campaign.CreatedAt,
+			&campaign.CreatedBy,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		campaigns = append(campaigns, &campaign)
+	}
+
+	paginationResponse := &common.PaginationResponse{
+		CurrentPage:    pagination.CurrentPage,
+		ItemPerPage:    pagination.ItemPerPage,
+		TotalItemCount: int32(totalCount),
+		TotalPageCount: int32(totalPages),
+	}
+	return campaigns, paginationResponse, nil
+}
+
+func (nr *newsletterCampaignRepository) GetCampaignStats(ctx context.Context, id string) (*entity.NewsletterCampaignStats, error) {
+	row := nr.db.QueryRowContext(
+		ctx,
+		`SELECT
+    COUNT(*) FILTER (WHERE status = $2),
+    COUNT(*) FILTER (WHERE status = $3),
+    COUNT(*) FILTER (WHERE status = $4),
+    COUNT(*) FILTER (WHERE status = $5),
+    COUNT(opened_at),
+    COUNT(clicked_at)
+FROM newsletter_campaign_recipient
+WHERE campaign_id = $1`,
+		UUIDOrNil(id),
+		entity.NewsletterRecipientStatusPending,
+		entity.NewsletterRecipientStatusSent,
+		entity.NewsletterRecipientStatusFailed,
+		entity.NewsletterRecipientStatusSkipped,
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var stats entity.NewsletterCampaignStats
+	err := row.Scan(
+		&stats.PendingCount,
+		&stats.SentCount,
+		&stats.FailedCount,
+		&stats.SkippedCount,
+		&stats.OpenedCount,
+		&stats.ClickedCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// ScheduleCampaign hanya berlaku untuk campaign draft atau yang belum mulai dikirim
+func (nr *newsletterCampaignRepository) ScheduleCampaign(ctx context.Context, id string, scheduledAt time.Time, updatedAt time.Time, updatedBy string) (bool, error) {
+	result, err := nr.db.ExecContext(
+		ctx,
+		"UPDATE newsletter_campaign SET status = $1, scheduled_at = $2, updated_at = $3, updated_by = $4 WHERE id = $5 AND status = ANY($6) AND is_deleted = false",
+		entity.NewsletterCampaignStatusScheduled,
+		scheduledAt,
+		updatedAt,
+		updatedBy,
+		UUIDOrNil(id),
+		pq.Array([]string{entity.NewsletterCampaignStatusDraft, entity.NewsletterCampaignStatusScheduled}),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// CancelCampaign menghentikan campaign, penerima yang belum terkirim tidak akan diproses worker
+func (nr *newsletterCampaignRepository) CancelCampaign(ctx context.Context, id string, updatedAt time.Time, updatedBy string) (bool, error) {
+	result, err := nr.db.ExecContext(
+		ctx,
+		"UPDATE newsletter_campaign SET status = $1, completed_at = $2, updated_at = $2, updated_by = $3 WHERE id = $4 AND status = ANY($5) AND is_deleted = false",
+		entity.NewsletterCampaignStatusCanceled,
+		updatedAt,
+		updatedBy,
+		UUIDOrNil(id),
+		pq.Array([]string{entity.NewsletterCampaignStatusDraft, entity.NewsletterCampaignStatusScheduled, entity.NewsletterCampaignStatusSending}),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// ClaimDueCampaigns memindahkan campaign terjadwal yang sudah waktunya ke status sending.
+// Dipanggil di dalam transaksi yang sama dengan CreateCampaignRecipients
+func (nr *newsletterCampaignRepository) ClaimDueCampaigns(ctx context.Context, now time.Time, limit int) ([]*entity.NewsletterCampaign, error) {
+	rows, err := nr.db.QueryContext(
+		ctx,
+		`UPDATE newsletter_campaign SET status = $1, started_at = $2, updated_at = $2, updated_by = 'System'
+WHERE id IN (
+    SELECT id FROM newsletter_campaign
+    WHERE status = $3 AND scheduled_at <= $2 AND is_deleted = false
+    ORDER BY scheduled_at
+    LIMIT $4
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, segment, topic`,
+		entity.NewsletterCampaignStatusSending,
+		now,
+		entity.NewsletterCampaignStatusScheduled,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	campaigns := make([]*entity.NewsletterCampaign, 0)
+	for rows.Next() {
+		var campaign entity.NewsletterCampaign
+		err = rows.Scan(
+			&campaign.Id,
+			&campaign.Segment,
+			&campaign.Topic,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		campaigns = append(campaigns, &campaign)
+	}
+
+	return campaigns, nil
+}
+
+// CreateCampaignRecipients mengisi daftar penerima sesuai segmen campaign dan mengembalikan jumlah penerima
+func (nr *newsletterCampaignRepository) CreateCampaignRecipients(ctx context.Context, campaign *entity.NewsletterCampaign, now time.Time) (int64, error) {
+	segmentFilter := ""
+	args := []any{
+		campaign.Id,
+		entity.NewsletterRecipientStatusPending,
+		now,
+		entity.NewsletterStatusConfirmed,
+	}
+	switch campaign.Segment {
+	case entity.NewsletterSegmentCustomers:
+		segmentFilter = "AND EXISTS (SELECT 1 FROM \"user\" u JOIN \"order\" o ON o.user_id = u.id WHERE u.email = n.email AND u.is_deleted = false AND o.is_deleted = false)"
+	case entity.NewsletterSegmentTopic:
+		topic := ""
+		if campaign.Topic != nil {
+			topic = *campaign.Topic
+		}
+		segmentFilter = "AND $5 = ANY(n.topics)"
+		args = append(args, topic)
+	}
+
+	result, err := nr.db.ExecContext(
+		ctx,
+		fmt.Sprintf(`INSERT INTO newsletter_campaign_recipient (id, campaign_id, newsletter_id, email, full_name, status, attempt_count, next_attempt_at, created_at)
+SELECT gen_random_uuid(), $1, n.id, n.email, n.full_name, $2, 0, $3, $3
+FROM newsletter n
+WHERE n.status = $4 AND n.is_deleted = false %s
+ON CONFLICT (campaign_id, newsletter_id) DO NOTHING`, segmentFilter),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	total, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = nr.db.ExecContext(
+		ctx,
+		"UPDATE newsletter_campaign SET total_recipients = $1 WHERE id = $2",
+		total,
+		campaign.Id,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// CompleteSendingCampaigns menandai campaign selesai jika tidak ada penerima yang masih pending
+func (nr *newsletterCampaignRepository) CompleteSendingCampaigns(ctx context.Context, now time.Time) error {
+	_, err := nr.db.ExecContext(
+		ctx,
+		`UPDATE newsletter_campaign c SET status = $1, completed_at = $2, updated_at = $2, updated_by = 'System'
+WHERE c.status = $3 AND c.is_deleted = false AND NOT EXISTS (
+    SELECT 1 FROM newsletter_campaign_recipient r WHERE r.campaign_id = c.id AND r.status = $4
+)`,
+		entity.NewsletterCampaignStatusSent,
+		now,
+		entity.NewsletterCampaignStatusSending,
+		entity.NewsletterRecipientStatusPending,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ClaimPendingRecipients sama seperti ClaimPendingOutbox, penerima dari campaign yang dibatalkan tidak diambil.
+// Status subscriber dicek ulang saat claim: penerima yang sudah unsubscribe, dihapus atau belum konfirmasi ditandai skipped
+func (nr *newsletterCampaignRepository) ClaimPendingRecipients(ctx context.Context, limit int, leaseUntil time.Time) ([]*entity.NewsletterCampaignRecipient, error) {
+	rows, err := nr.db.QueryContext(
+		ctx,
+		`WITH skipped AS (
+    UPDATE newsletter_campaign_recipient r SET status = $5, last_error = 'subscriber is no longer active'
+    WHERE r.status = $2 AND NOT EXISTS (
+        SELECT 1 FROM newsletter n WHERE n.id = r.newsletter_id AND n.is_deleted = false AND n.status = $6
+    )
+)
+UPDATE newsletter_campaign_recipient SET next_attempt_at = $1, attempt_count = attempt_count + 1
+WHERE id IN (
+    SELECT r.id FROM newsletter_campaign_recipient r
+    JOIN newsletter_campaign c ON c.id = r.campaign_id
+    JOIN newsletter n ON n.id = r.newsletter_id
+    WHERE r.status = $2 AND r.next_attempt_at <= now() AND c.status = $3 AND n.is_deleted = false AND n.status = $6
+    ORDER BY r.next_attempt_at
+    LIMIT $4
+    FOR UPDATE OF r SKIP LOCKED
+)
+RETURNING id, campaign_id, newsletter_id, email, full_name, status, attempt_count, next_attempt_at, created_at`,
+		leaseUntil,
+		entity.NewsletterRecipientStatusPending,
+		entity.NewsletterCampaignStatusSending,
+		limit,
+		entity.NewsletterRecipientStatusSkipped,
+		entity.NewsletterStatusConfirmed,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	recipients := make([]*entity.NewsletterCampaignRecipient, 0)
+	for rows.Next() {
+		var recipient entity.NewsletterCampaignRecipient
+		err = rows.Scan(
+			&recipient.Id,
+			&recipient.CampaignId,
+			&recipient.NewsletterId,
+			&recipient.Email,
+			&recipient.FullName,
+			&recipient.Status,
+			&recipient.AttemptCount,
+			&recipient.NextAttemptAt,
+			&recipient.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		recipients = append(recipients, &recipient)
+	}
+
+	return recipients, nil
+}
+
+func (nr *newsletterCampaignRepository) MarkRecipientSent(ctx context.Context, id string, sentAt time.Time) error {
+	_, err := nr.db.ExecContext(
+		ctx,
+		"UPDATE newsletter_campaign_recipient SET status = $1, sent_at = $2, last_error = NULL WHERE id = $3",
+		entity.NewsletterRecipientStatusSent,
+		sentAt,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (nr *newsletterCampaignRepository) MarkRecipientRetry(ctx context.Context, id string, nextAttemptAt time.Time, lastError string) error {
+	_, err := nr.db.ExecContext(
+		ctx,
+		"UPDATE newsletter_campaign_recipient SET next_attempt_at = $1, last_error = $2 WHERE id = $3",
+		nextAttemptAt,
+		lastError,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (nr *newsletterCampaignRepository) MarkRecipientFailed(ctx context.Context, id string, lastError string) error {
+	_, err := nr.db.ExecContext(
+		ctx,
+		"UPDATE newsletter_campaign_recipient SET status = $1, last_error = $2 WHERE id = $3",
+		entity.NewsletterRecipientStatusFailed,
+		lastError,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarkRecipientOpened hanya mencatat waktu buka pertama
+func (nr *newsletterCampaignRepository) MarkRecipientOpened(ctx context.Context, id string, openedAt time.Time) error {
+	_, err := nr.db.ExecContext(
+		ctx,
+		"UPDATE newsletter_campaign_recipient SET opened_at = COALESCE(opened_at, $1) WHERE id = $2",
+		openedAt,
+		UUIDOrNil(id),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarkRecipientClicked juga mengisi opened_at karena email client bisa memblokir tracking pixel
+func (nr *newsletterCampaignRepository) MarkRecipientClicked(ctx context.Context, id string, clickedAt time.Time) error {
+	_, err := nr.db.ExecContext(
+		ctx,
+		"UPDATE newsletter_campaign_recipient SET clicked_at = COALESCE(clicked_at, $1), opened_at = COALESCE(opened_at, $1) WHERE id = $2",
+		clickedAt,
+		UUIDOrNil(id),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewNewsletterCampaignRepository(db database.DatabaseQuery) INewsletterCampaignRepository {
+	return &newsletterCampaignRepository{
+		db: db,
+	}
+}
@@ -4,23 +4,45 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
 )
 
 type InewsLetterRepository interface {
+	WithTrancastion(tx *sql.Tx) InewsLetterRepository
 	GetNewsLetterByEmail(ctx context.Context, email string) (*entity.Newsletter, error)
+	GetNewsletterById(ctx context.Context, id string) (*entity.Newsletter, error)
 	CreateNewNewsletter(ctx context.Context, newsletter *entity.Newsletter) error
+	RequestNewsletterConfirmation(ctx context.Context, id string, requestedAt time.Time, resendBefore time.Time) (bool, error)
+	UpdateNewsletterConfirmToken(ctx context.Context, newsletter *entity.Newsletter) error
+	ConfirmNewsletter(ctx context.Context, confirmTokenHash string, confirmedAt time.Time) (bool, error)
+	UpdateNewsletterTopics(ctx context.Context, id string, topics []string, updatedAt time.Time, updatedBy string) error
+	DeleteNewsletter(ctx context.Context, id string, deletedAt time.Time, deletedBy string) (bool, error)
+	GetNewslettersPagination(ctx context.Context, pagination *common.PaginationRequest, search string, status string) ([]*entity.Newsletter, *common.PaginationResponse, error)
+	StreamNewsletters(ctx context.Context, search string, status string, fn func(newsletter *entity.Newsletter) error) error
+	ImportNewsletter(ctx context.Context, newsletter *entity.Newsletter) (bool, error)
 }
 
 type newsLetterRepository struct {
-	db *sql.DB
+	db database.DatabaseQuery
+}
+
+func (nr *newsLetterRepository) WithTrancastion(tx *sql.Tx) InewsLetterRepository {
+	return &newsLetterRepository{
+		db: tx,
+	}
 }
 
 func (nr *newsLetterRepository) GetNewsLetterByEmail(ctx context.Context, email string) (*entity.Newsletter, error) {
 	row := nr.db.QueryRowContext(
 		ctx,
-		"SELECT id FROM newsletter WHERE email = $1 AND is_deleted = false",
+		"SELECT id, full_name, email, status, topics, confirm_token_expires_at, confirmed_at, created_at, created_by FROM newsletter WHERE email = $1 AND is_deleted = false",
 		email,
 	)
 	if row.Err() != nil {
@@ -30,6 +52,14 @@ func (nr *newsLetterRepository) GetNewsLetterByEmail(ctx context.Context, email
 	var newsletter entity.Newsletter
 	err := row.Scan(
 		&newsletter.Id,
+		&newsletter.Fullname,
+		&newsletter.Email,
+		&newsletter.Status,
+		pq.Array(&newsletter.Topics),
+		&newsletter.ConfirmTokenExpiresAt,
+		&newsletter.ConfirmedAt,
+		&newsletter.CreatedAt,
+		&newsletter.CreatedBy,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -41,13 +71,52 @@ func (nr *newsLetterRepository) GetNewsLetterByEmail(ctx context.Context, email
 
 	return &newsletter, nil
 }
+
+func (nr *newsLetterRepository) GetNewsletterById(ctx context.Context, id string) (*entity.Newsletter, error) {
+	row := nr.db.QueryRowContext(
+		ctx,
+		"SELECT id, full_name, email, status, topics, confirm_token_expires_at, confirmed_at, created_at, created_by FROM newsletter WHERE id = $1 AND is_deleted = false",
+		id,
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var newsletter entity.Newsletter
+	err := row.Scan(
+		&newsletter.Id,
+		&newsletter.Fullname,
+		&newsletter.Email,
+		&newsletter.Status,
+		pq.Array(&newsletter.Topics),
+		&newsletter.ConfirmTokenExpiresAt,
+		&newsletter.ConfirmedAt,
+		&newsletter.CreatedAt,
+		&newsletter.CreatedBy,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &newsletter, nil
+}
+
 func (nr *newsLetterRepository) CreateNewNewsletter(ctx context.Context, newsletter *entity.Newsletter) error {
 	_, err := nr.db.ExecContext(
 		ctx,
-		"INSERT INTO newsletter (id, full_name, email, created_at, created_by)VALUES ($1, $2, $3, $4, $5)",
+		"INSERT INTO newsletter (id, full_name, email, status, topics, confirm_token_hash, confirm_token_expires_at, confirmed_at, created_at, created_by)VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
 		newsletter.Id,
 		newsletter.Fullname,
 		newsletter.Email,
+		newsletter.Status,
+		pq.Array(newsletter.Topics),
+		newsletter.ConfirmTokenHash,
+		newsletter.ConfirmTokenExpiresAt,
+		newsletter.ConfirmedAt,
 		newsletter.CreatedAt,
 		newsletter.CreatedBy,
 	)
@@ -57,7 +126,269 @@ func (nr *newsLetterRepository) CreateNewNewsletter(ctx context.Context, newslet
 	return nil
 }
 
-func NewNewsLetterRespository(db *sql.DB) InewsLetterRepository {
+// RequestNewsletterConfirmation mencatat permintaan email konfirmasi untuk subscriber pending.
+// Return false jika permintaan terakhir setelah resendBefore sehingga email konfirmasi tidak dikirim ulang
+func (nr *newsLetterRepository) RequestNewsletterConfirmation(ctx context.Context, id string, requestedAt time.Time, resendBefore time.Time) (bool, error) {
+	result, err := nr.db.ExecContext(
+		ctx,
+		"UPDATE newsletter SET confirm_requested_at = $1 WHERE id = $2 AND status = $3 AND is_deleted = false AND (confirm_requested_at IS NULL OR confirm_requested_at < $4)",
+		requestedAt,
+		id,
+		entity.NewsletterStatusPending,
+		resendBefore,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// UpdateNewsletterConfirmToken mengganti token konfirmasi subscriber yang masih pending, token lama tidak berlaku lagi
+func (nr *newsLetterRepository) UpdateNewsletterConfirmToken(ctx context.Context, newsletter *entity.Newsletter) error {
+	_, err := nr.db.ExecContext(
+		ctx,
+		"UPDATE newsletter SET confirm_token_hash = $1, confirm_token_expires_at = $2, updated_at = $3, updated_by = $4 WHERE id = $5 AND status = $6 AND is_deleted = false",
+		newsletter.ConfirmTokenHash,
+		newsletter.ConfirmTokenExpiresAt,
+		newsletter.UpdatedAt,
+		newsletter.UpdatedBy,
+		newsletter.Id,
+		entity.NewsletterStatusPending,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConfirmNewsletter mengembalikan false jika token tidak ditemukan atau sudah kedaluwarsa
+func (nr *newsLetterRepository) ConfirmNewsletter(ctx context.Context, confirmTokenHash string, confirmedAt time.Time) (bool, error) {
+	result, err := nr.db.ExecContext(
+		ctx,
+		"UPDATE newsletter SET status = $1, confirmed_at = $2, confirm_token_hash = NULL, confirm_token_expires_at = NULL, updated_at = $2, updated_by = 'Public' WHERE confirm_token_hash = $3 AND confirm_token_expires_at > $2 AND status = $4 AND is_deleted = false",
+		entity.NewsletterStatusConfirmed,
+		confirmedAt,
+		confirmTokenHash,
+		entity.NewsletterStatusPending,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (nr *newsLetterRepository) UpdateNewsletterTopics(ctx context.Context, id string, topics []string, updatedAt time.Time, updatedBy string) error {
+	_, err := nr.db.ExecContext(
+		ctx,
+		"UPDATE newsletter SET topics = $1, updated_at = $2, updated_by = $3 WHERE id = $4 AND is_deleted = false",
+		pq.Array(topics),
+		updatedAt,
+		updatedBy,
+		UUIDOrNil(id),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteNewsletter adalah unsubscribe, mengembalikan false jika subscriber sudah tidak aktif
+func (nr *newsLetterRepository) DeleteNewsletter(ctx context.Context, id string, deletedAt time.Time, deletedBy string) (bool, error) {
+	result, err := nr.db.ExecContext(
+		ctx,
+		"UPDATE newsletter SET is_deleted = true, deleted_at = $1, deleted_by = $2, confirm_token_hash = NULL WHERE id = $3 AND is_deleted = false",
+		deletedAt,
+		deletedBy,
+		UUIDOrNil(id),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// newsletterFilter membuat kondisi WHERE untuk pencarian admin, search dicocokkan ke email dan nama
+func newsletterFilter(search string, status string) (string, []any) {
+	where := "WHERE is_deleted = false"
+	args := make([]any, 0)
+	if search != "" {
+		args = append(args, "%"+escapeLike(search)+"%")
+		where += fmt.Sprintf(" AND (email ILIKE $%d OR full_name ILIKE $%d)", len(args), len(args))
+	}
+	if status != "" {
+		args = append(args, status)
+		where += fmt.Sprintf(" AND status = $%d", len(args))
+	}
+
+	return where, args
+}
+
+// escapeLike agar karakter % dan _ dari input user tidak dianggap wildcard
+func escapeLike(value string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(value)
+}
+
+func (nr *newsLetterRepository) GetNewslettersPagination(ctx context.Context, pagination *common.PaginationRequest, search string, status string) ([]*entity.Newsletter, *common.PaginationResponse, error) {
+	where, args := newsletterFilter(search, status)
+	row := nr.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM newsletter "+where, args...)
+	if row.Err() != nil {
+		return nil, nil, row.Err()
+	}
+
+	var totalCount int
+	err := row.Scan(&totalCount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	offset := (pagination.CurrentPage - 1) * pagination.ItemPerPage
+	totalPages := (totalCount + int(pagination.ItemPerPage) - 1) / int(pagination.ItemPerPage)
+
+	allowedSorts := map[string]string{
+		"email":        "email",
+		"full_name":    "full_name",
+		"status":       "status",
+		"confirmed_at": "confirmed_at",
+		"created_at":   "created_at",
+	}
+	sort := "ORDER BY created_at DESC"
+	if pagination.Sort != nil {
+		direction := "ASC"
+		sortField, ok := allowedSorts[pagination.Sort.Field]
+		if ok {
+			if pagination.Sort.Direction == "desc" {
+				direction = "DESC"
+			}
+			sort = fmt.Sprintf("ORDER BY %s %s", sortField, direction)
+		}
+	}
+
+	args = append(args, pagination.ItemPerPage, offset)
+	rows, err := nr.db.QueryContext(
+		ctx,
+		fmt.Sprintf("SELECT id, full_name, email, status, topics, confirmed_at, created_at, created_by FROM newsletter %s %s LIMIT $%d OFFSET $%d", where, sort, len(args)-1, len(args)),
+		args...,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	newsletters := make([]*entity.Newsletter, 0)
+	for rows.Next() {
+		newsletter, err := scanNewsletterRow(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		newsletters = append(newsletters, newsletter)
+	}
+
+	paginationResponse := &common.PaginationResponse{
+		CurrentPage:    pagination.CurrentPage,
+		ItemPerPage:    pagination.ItemPerPage,
+		TotalItemCount: int32(totalCount),
+		TotalPageCount: int32(totalPages),
+	}
+	return newsletters, paginationResponse, nil
+}
+
+// StreamNewsletters memanggil fn per baris tanpa menampung semua subscriber di memori, dipakai untuk export
+func (nr *newsLetterRepository) StreamNewsletters(ctx context.Context, search string, status string, fn func(newsletter *entity.Newsletter) error) error {
+	where, args := newsletterFilter(search, status)
+	rows, err := nr.db.QueryContext(
+		ctx,
+		fmt.Sprintf("SELECT id, full_name, email, status, topics, confirmed_at, created_at, created_by FROM newsletter %s ORDER BY created_at", where),
+		args...,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		newsletter, err := scanNewsletterRow(rows)
+		if err != nil {
+			return err
+		}
+
+		err = fn(newsletter)
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func scanNewsletterRow(rows *sql.Rows) (*entity.Newsletter, error) {
+	var newsletter entity.Newsletter
+	err := rows.Scan(
+		&newsletter.Id,
+		&newsletter.Fullname,
+		&newsletter.Email,
+		&newsletter.Status,
+		pq.Array(&newsletter.Topics),
+		&newsletter.ConfirmedAt,
+		&newsletter.CreatedAt,
+		&newsletter.CreatedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newsletter, nil
+}
+
+// ImportNewsletter menyimpan subscriber hasil import, mengembalikan false jika email sudah terdaftar
+func (nr *newsLetterRepository) ImportNewsletter(ctx context.Context, newsletter *entity.Newsletter) (bool, error) {
+	result, err := nr.db.ExecContext(
+		ctx,
+		`INSERT INTO newsletter (id, full_name, email, status, topics, confirmed_at, created_at, created_by)
+SELECT $1::uuid, $2, $3, $4, $5::text[], $6::timestamptz, $7::timestamptz, $8
+WHERE NOT EXISTS (SELECT 1 FROM newsletter WHERE lower(email) = lower($3) AND is_deleted = false)`,
+		newsletter.Id,
+		newsletter.Fullname,
+		newsletter.Email,
+		newsletter.Status,
+		pq.Array(newsletter.Topics),
+		newsletter.ConfirmedAt,
+		newsletter.CreatedAt,
+		newsletter.CreatedBy,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func NewNewsLetterRespository(db database.DatabaseQuery) InewsLetterRepository {
 	return &newsLetterRepository{
 		db: db,
 	}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
+)
+
+type INotificationRepository interface {
+	WithTrancastion(tx *sql.Tx) INotificationRepository
+	CreateNotification(ctx context.Context, notification *entity.UserNotification) (bool, error)
+	GetNotificationsPagination(ctx context.Context, pagination *common.PaginationRequest, userId string, unreadOnly bool) ([]*entity.UserNotification, *common.PaginationResponse, error)
+	CountUnreadNotifications(ctx context.Context, userId string) (int64, error)
+	MarkNotificationsRead(ctx context.Context, userId string, ids []string, readAt time.Time) error
+	MarkAllNotificationsRead(ctx context.Context, userId string, readAt time.Time) error
+}
+
+type notificationRepository struct {
+	db database.DatabaseQuery
+}
+
+func (nr *notificationRepository) WithTrancastion(tx *sql.Tx) INotificationRepository {
+	return &notificationRepository{
+		db: tx,
+	}
+}
+
+// CreateNotification mengembalikan false jika notifikasi untuk outbox yang sama sudah pernah dibuat
+func (nr *notificationRepository) CreateNotification(ctx context.Context, notification *entity.UserNotification) (bool, error) {
+	result, err := nr.db.ExecContext(
+		ctx,
+		"INSERT INTO user_notification (id, user_id, type, event, title, body, order_id, order_status_code, outbox_id, read_at, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT (outbox_id) WHERE outbox_id IS NOT NULL DO NOTHING",
+		notification.Id,
+		notification.UserId,
+		notification.Type,
+		notification.Event,
+		notification.Title,
+		notification.Body,
+		notification.OrderId,
+		notification.OrderStatusCode,
+		notification.OutboxId,
+		notification.ReadAt,
+		notification.CreatedAt,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (nr *notificationRepository) GetNotificationsPagination(ctx context.Context, pagination *common.PaginationRequest, userId string, unreadOnly bool) ([]*entity.UserNotification, *common.PaginationResponse, error) {
+	row := nr.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM user_notification WHERE user_id = $1 AND (NOT $2 OR read_at IS NULL)",
+		UUIDOrNil(userId),
+		unreadOnly,
+	)
+	if row.Err() != nil {
+		return nil, nil, row.Err()
+	}
+
+	var totalCount int
+	err := row.Scan(&totalCount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	offset := (pagination.CurrentPage - 1) * pagination.ItemPerPage
+	totalPages := (totalCount + int(pagination.ItemPerPage) - 1) / int(pagination.ItemPerPage)
+
+	rows, err := nr.db.QueryContext(
+		ctx,
+		"SELECT id, user_id, type, event, title, body, order_id, order_status_code, outbox_id, read_at, created_at FROM user_notification WHERE user_id = $1 AND (NOT $2 OR read_at IS NULL) ORDER BY created_at DESC, id DESC LIMIT $3 OFFSET $4",
+		UUIDOrNil(userId),
+		unreadOnly,
+		pagination.ItemPerPage,
+		offset,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	notifications := make([]*entity.UserNotification, 0)
+	for rows.Next() {
+		var notification entity.UserNotification
+		err = rows.Scan(
+			&notification.Id,
+			&notification.UserId,
+			&notification.Type,
+			&notification.Event,
+			&notification.Title,
+			&notification.Body,
+			&notification.OrderId,
+			&notification.OrderStatusCode,
+			&notification.OutboxId,
+			&notification.ReadAt,
+			&notification.CreatedAt,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		notifications = append(notifications, &notification)
+	}
+
+	paginationResponse := &common.PaginationResponse{
+		CurrentPage:    pagination.CurrentPage,
+		TotalPageCount: int32(totalPages),
+		ItemPerPage:    pagination.ItemPerPage,
+		TotalItemCount: int32(totalCount),
+	}
+	return notifications, paginationResponse, rows.Err()
+}
+
+func (nr *notificationRepository) CountUnreadNotifications(ctx context.Context, userId string) (int64, error) {
+	row := nr.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM user_notification WHERE user_id = $1 AND read_at IS NULL",
+		UUIDOrNil(userId),
+	)
+	if row.Err() != nil {
+		return 0, row.Err()
+	}
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// MarkNotificationsRead hanya mengubah notifikasi milik user, id milik user lain diabaikan
+func (nr *notificationRepository) MarkNotificationsRead(ctx context.Context, userId string, ids []string, readAt time.Time) error {
+	_, err := nr.db.ExecContext(
+		ctx,
+		"UPDATE user_notification SET read_at = $1 WHERE user_id = $2 AND id = ANY($3::uuid[]) AND read_at IS NULL",
+		readAt,
+		UUIDOrNil(userId),
+		pq.Array(ids),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (nr *notificationRepository) MarkAllNotificationsRead(ctx context.Context, userId string, readAt time.Time) error {
+	_, err := nr.db.ExecContext(
+		ctx,
+		"UPDATE user_notification SET read_at = $1 WHERE user_id = $2 AND read_at IS NULL",
+		readAt,
+		UUIDOrNil(userId),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewNotificationRepository(db database.DatabaseQuery) INotificationRepository {
+	return &notificationRepository{
+		db: db,
+	}
+}
@@ -19,7 +19,11 @@ type IOrderRepository interface {
 	UpdateNumbering(ctx context.Context, numbering *entity.Numbering) error
 	CreateOrderItem(ctx context.Context, orderItem *entity.OrderItem) error
 	GetOrderById(ctx context.Context, orderId string) (*entity.Order, error)
+	GetOrderByIdForUpdate(ctx context.Context, orderId string) (*entity.Order, error)
 	UpdateOrder(ctx context.Context, order *entity.Order) error
+	UpdateOrderPaymentLink(ctx context.Context, order *entity.Order) (bool, error)
+	UpdateOrderStatusFrom(ctx context.Context, order *entity.Order, fromStatusCode string) (bool, error)
+	ReleaseOrderStock(ctx context.Context, orderId string) error
 	GetListOrderAdminPagination(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.Order, *common.PaginationResponse, error)
 	GetListOrderPagination(ctx context.Context, pagination *common.PaginationRequest, userId string) ([]*entity.Order, *common.PaginationResponse, error)
 }
@@ -62,7 +66,7 @@ func (os *orderRepository) GetNumbering(ctx context.Context, module string) (*en
 func (or *orderRepository) CreateOrder(ctx context.Context, order *entity.Order) error {
 	_, err := or.db.ExecContext(
 		ctx,
-		"INSERT INTO \"order\" (id, number, user_id, order_status_code, user_full_name, address, phone_number, notes, total, expired_at, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted, xendit_invoice_id, xendit_invoice_url) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)",
+		"INSERT INTO \"order\" (id, number, user_id, order_status_code, user_full_name, address, phone_number, notes, total, expired_at, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted, xendit_invoice_id, xendit_invoice_url, shipping_address_id, shipping_courier, shipping_service, shipping_cost, shipping_weight_gram, voucher_id, voucher_code, discount_amount, subtotal, tax_name, tax_rate, tax_inclusive, tax_amount, currency, base_currency, exchange_rate, locale, product_discount_amount, stock_reserved) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37, $38)",
 		order.Id,
 		order.Number,
 		order.UserId,
@@ -82,6 +86,45 @@ func (or *orderRepository) CreateOrder(ctx context.Context, order *entity.Order)
 		order.IsDeleted,
 		order.XenditInvoiceId,
 		order.XenditInvoiceUrl,
+		order.ShippingAddressId,
+		order.ShippingCourier,
+		order.ShippingService,
+		order.ShippingCost,
+		order.ShippingWeightGram,
+		order.VoucherId,
+		order.VoucherCode,
+		order.DiscountAmount,
+		order.Subtotal,
+		order.TaxName,
+		order.TaxRate,
+		order.TaxInclusive,
+		order.TaxAmount,
+		order.Currency,
+		order.BaseCurrency,
+		order.ExchangeRate,
+		order.Locale,
+		order.ProductDiscountAmount,
+		order.StockReserved,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReleaseOrderStock mengembalikan stok produk dari item order yang dibatalkan atau expired.
+// stock_reserved direset di query yang sama sehingga stok tidak dikembalikan dua kali
+func (or *orderRepository) ReleaseOrderStock(ctx context.Context, orderId string) error {
+	_, err := or.db.ExecContext(
+		ctx,
+		"WITH released AS ("+
+			"UPDATE \"order\" SET stock_reserved = false WHERE id = $1 AND stock_reserved = true RETURNING id"+
+			") "+
+			"UPDATE product p SET stock = p.stock + oi.quantity "+
+			"FROM (SELECT product_id, SUM(quantity) AS quantity FROM order_item WHERE order_id IN (SELECT id FROM released) AND is_deleted = false GROUP BY product_id) oi "+
+			"WHERE p.id = oi.product_id AND p.stock IS NOT NULL",
+		orderId,
 	)
 	if err != nil {
 		return err
@@ -131,9 +174,24 @@ func (or *orderRepository) CreateOrderItem(ctx context.Context, orderItem *entit
 }
 
 func (or *orderRepository) GetOrderById(ctx context.Context, orderId string) (*entity.Order, error) {
+	return or.getOrderById(ctx, orderId, false)
+}
+
+// GetOrderByIdForUpdate mengunci baris order sampai transaksi selesai,
+// dipakai sebelum mengubah status agar perubahan status yang bersamaan tidak saling menimpa
+func (or *orderRepository) GetOrderByIdForUpdate(ctx context.Context, orderId string) (*entity.Order, error) {
+	return or.getOrderById(ctx, orderId, true)
+}
+
+func (or *orderRepository) getOrderById(ctx context.Context, orderId string, forUpdate bool) (*entity.Order, error) {
+	lockClause := ""
+	if forUpdate {
+		lockClause = " FOR UPDATE"
+	}
+
 	row := or.db.QueryRowContext(
 		ctx,
-		"SELECT id, number, user_full_name, address, phone_number, notes, order_status_code, total, created_at, xendit_invoice_url, user_id, expired_at, xendit_paid_at, xendit_payment_channel, xendit_payment_method FROM \"order\" WHERE id = $1 AND is_deleted = false",
+		"SELECT id, number, user_full_name, address, phone_number, notes, order_status_code, total, currency, created_at, xendit_invoice_id, xendit_invoice_url, user_id, expired_at, xendit_paid_at, xendit_paid_amount, xendit_payment_channel, xendit_payment_method, payment_review_reason, shipping_courier, shipping_service, shipping_cost, shipping_weight_gram, tracking_courier, tracking_number, shipped_at, voucher_code, discount_amount, subtotal, tax_name, tax_rate, tax_inclusive, tax_amount, base_currency, exchange_rate, locale, product_discount_amount FROM \"order\" WHERE id = $1 AND is_deleted = false"+lockClause,
 		orderId,
 	)
 	if row.Err() != nil {
@@ -150,13 +208,35 @@ func (or *orderRepository) GetOrderById(ctx context.Context, orderId string) (*e
 		&order.Notes,
 		&order.OrderStatusCode,
 		&order.Total,
+		&order.Currency,
 		&order.CreatedAt,
+		&order.XenditInvoiceId,
 		&order.XenditInvoiceUrl,
 		&order.UserId,
 		&order.ExpiredAt,
 		&order.XenditPaidAt,
+		&order.XenditPaidAmount,
 		&order.XenditPaymentChannel,
 		&order.XenditPaymentMethod,
+		&order.PaymentReviewReason,
+		&order.ShippingCourier,
+		&order.ShippingService,
+		&order.ShippingCost,
+		&order.ShippingWeightGram,
+		&order.TrackingCourier,
+		&order.TrackingNumber,
+		&order.ShippedAt,
+		&order.VoucherCode,
+		&order.DiscountAmount,
+		&order.Subtotal,
+		&order.TaxName,
+		&order.TaxRate,
+		&order.TaxInclusive,
+		&order.TaxAmount,
+		&order.BaseCurrency,
+		&order.ExchangeRate,
+		&order.Locale,
+		&order.ProductDiscountAmount,
 	)
 	if err != nil { //logic jika order tidak ditemukan
 		if errors.Is(err, sql.ErrNoRows) {
@@ -168,7 +248,7 @@ func (or *orderRepository) GetOrderById(ctx context.Context, orderId string) (*e
 
 	rows, err := or.db.QueryContext(
 		ctx,
-		"SELECT product_id, product_name, product_price, quantity FROM order_item WHERE order_id =$1 AND is_deleted = false",
+		"SELECT id, product_id, product_name, product_price, quantity FROM order_item WHERE order_id =$1 AND is_deleted = false",
 		order.Id,
 	)
 	if err != nil {
@@ -180,6 +260,7 @@ func (or *orderRepository) GetOrderById(ctx context.Context, orderId string) (*e
 		var item entity.OrderItem
 
 		err = rows.Scan(
+			&item.Id,
 			&item.ProductId,
 			&item.ProductName,
 			&item.ProductPrice,
@@ -200,13 +281,18 @@ func (or *orderRepository) GetOrderById(ctx context.Context, orderId string) (*e
 func (or *orderRepository) UpdateOrder(ctx context.Context, order *entity.Order) error {
 	_, err := or.db.ExecContext(
 		ctx,
-		"UPDATE \"order\" SET updated_at = $1, updated_by = $2, xendit_paid_at = $3, xendit_payment_channel = $4, xendit_payment_method = $5, order_status_code = $6 WHERE id = $7",
+		"UPDATE \"order\" SET updated_at = $1, updated_by = $2, xendit_paid_at = $3, xendit_payment_channel = $4, xendit_payment_method = $5, order_status_code = $6, xendit_paid_amount = $7, payment_review_reason = $8, tracking_courier = $9, tracking_number = $10, shipped_at = $11 WHERE id = $12",
 		order.UpdatedAt,
 		order.UpdatedBy,
 		order.XenditPaidAt,
 		order.XenditPaymentChannel,
 		order.XenditPaymentMethod,
 		order.OrderStatusCode,
+		order.XenditPaidAmount,
+		order.PaymentReviewReason,
+		order.TrackingCourier,
+		order.TrackingNumber,
+		order.ShippedAt,
 		order.Id,
 	)
 	if err != nil {
@@ -217,6 +303,56 @@ func (or *orderRepository) UpdateOrder(ctx context.Context, order *entity.Order)
 
 }
 
+// UpdateOrderPaymentLink hanya mengupdate order yang masih menunggu payment link,
+// return false jika status order sudah berubah (contoh: dibatalkan user)
+func (or *orderRepository) UpdateOrderPaymentLink(ctx context.Context, order *entity.Order) (bool, error) {
+	res, err := or.db.ExecContext(
+		ctx,
+		"UPDATE \"order\" SET updated_at = $1, updated_by = $2, xendit_invoice_id = $3, xendit_invoice_url = $4, order_status_code = $5 WHERE id = $6 AND order_status_code = $7",
+		order.UpdatedAt,
+		order.UpdatedBy,
+		order.XenditInvoiceId,
+		order.XenditInvoiceUrl,
+		order.OrderStatusCode,
+		order.Id,
+		entity.OrderStatusCodePendingPaymentLink,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// UpdateOrderStatusFrom hanya mengupdate status order yang masih fromStatusCode,
+// return false jika status order sudah diubah oleh request lain
+func (or *orderRepository) UpdateOrderStatusFrom(ctx context.Context, order *entity.Order, fromStatusCode string) (bool, error) {
+	res, err := or.db.ExecContext(
+		ctx,
+		"UPDATE \"order\" SET updated_at = $1, updated_by = $2, order_status_code = $3 WHERE id = $4 AND order_status_code = $5",
+		order.UpdatedAt,
+		order.UpdatedBy,
+		order.OrderStatusCode,
+		order.Id,
+		fromStatusCode,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
 func (or *orderRepository) GetListOrderAdminPagination(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.Order, *common.PaginationResponse, error) {
 	row := or.db.QueryRowContext(
 		ctx,
@@ -253,7 +389,7 @@ func (or *orderRepository) GetListOrderAdminPagination(ctx context.Context, pagi
 		}
 	}
 
-	baseQuery := fmt.Sprintf("SELECT id, number, order_status_code, total, user_full_name, created_at, expired_at FROM \"order\" WHERE is_deleted = false %s LIMIT $1 OFFSET $2", sort)
+	baseQuery := fmt.Sprintf("SELECT id, number, order_status_code, total, currency, user_full_name, created_at, expired_at, payment_review_reason, subtotal, discount_amount, tax_amount, shipping_cost FROM \"order\" WHERE is_deleted = false %s LIMIT $1 OFFSET $2", sort)
 	rows, err := or.db.QueryContext(
 		ctx,
 		baseQuery,
@@ -274,9 +410,15 @@ func (or *orderRepository) GetListOrderAdminPagination(ctx context.Context, pagi
 			&orderEntity.Number,
 			&orderEntity.OrderStatusCode,
 			&orderEntity.Total,
+			&orderEntity.Currency,
 			&orderEntity.UserFullName,
 			&orderEntity.CreatedAt,
 			&orderEntity.ExpiredAt,
+			&orderEntity.PaymentReviewReason,
+			&orderEntity.Subtotal,
+			&orderEntity.DiscountAmount,
+			&orderEntity.TaxAmount,
+			&orderEntity.ShippingCost,
 		)
 		if err != nil {
 			return nil, nil, err
@@ -365,7 +507,7 @@ func (or *orderRepository) GetListOrderPagination(ctx context.Context, paginatio
 		}
 	}
 
-	baseQuery := fmt.Sprintf("SELECT id, number, order_status_code, total, user_full_name, created_at, expired_at, xendit_invoice_url FROM \"order\" WHERE is_deleted = false AND user_id = $1 %s LIMIT $2 OFFSET $3", sort)
+	baseQuery := fmt.Sprintf("SELECT id, number, order_status_code, total, currency, user_full_name, created_at, expired_at, xendit_invoice_url FROM \"order\" WHERE is_deleted = false AND user_id = $1 %s LIMIT $2 OFFSET $3", sort)
 	rows, err := or.db.QueryContext(
 		ctx,
 		baseQuery,
@@ -387,6 +529,7 @@ func (or *orderRepository) GetListOrderPagination(ctx context.Context, paginatio
 			&orderEntity.Number,
 			&orderEntity.OrderStatusCode,
 			&orderEntity.Total,
+			&orderEntity.Currency,
 			&orderEntity.UserFullName,
 			&orderEntity.CreatedAt,
 			&orderEntity.ExpiredAt,
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
+)
+
+type IOutboxRepository interface {
+	WithTrancastion(tx *sql.Tx) IOutboxRepository
+	CreateOutbox(ctx context.Context, outbox *entity.Outbox) error
+	ClaimPendingOutbox(ctx context.Context, eventType string, limit int, leaseUntil time.Time) ([]*entity.Outbox, error)
+	MarkOutboxDone(ctx context.Context, id string) error
+	MarkOutboxRetry(ctx context.Context, id string, nextAttemptAt time.Time, lastError string) error
+	MarkOutboxFailed(ctx context.Context, id string, lastError string) error
+}
+
+type outboxRepository struct {
+	db database.DatabaseQuery
+}
+
+func (or *outboxRepository) WithTrancastion(tx *sql.Tx) IOutboxRepository {
+	return &outboxRepository{
+		db: tx,
+	}
+}
+
+func (or *outboxRepository) CreateOutbox(ctx context.Context, outbox *entity.Outbox) error {
+	_, err := or.db.ExecContext(
+		ctx,
+		"INSERT INTO outbox (id, event_type, aggregate_id, payload, status, attempt_count, next_attempt_at, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		outbox.Id,
+		outbox.EventType,
+		outbox.AggregateId,
+		outbox.Payload,
+		outbox.Status,
+		outbox.AttemptCount,
+		outbox.NextAttemptAt,
+		outbox.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ClaimPendingOutbox mengambil outbox yang sudah waktunya diproses dan menggeser next_attempt_at ke leaseUntil,
+// sehingga dispatcher lain tidak mengambil row yang sama. Jika dispatcher mati, row akan diambil ulang setelah lease habis.
+func (or *outboxRepository) ClaimPendingOutbox(ctx context.Context, eventType string, limit int, leaseUntil time.Time) ([]*entity.Outbox, error) {
+	rows, err := or.db.QueryContext(
+		ctx,
+		`UPDATE outbox SET next_attempt_at = $1, attempt_count = attempt_count + 1, updated_at = now()
+WHERE id IN (
+    SELECT id FROM outbox
+    WHERE status = $2 AND event_type = $3 AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT $4
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, event_type, aggregate_id, payload, status, attempt_count, next_attempt_at, last_error, created_at, updated_at`,
+		leaseUntil,
+		entity.OutboxStatusPending,
+		eventType,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	outboxes := make([]*entity.Outbox, 0)
+	for rows.Next() {
+		var outbox entity.Outbox
+		err = rows.Scan(
+			&outbox.Id,
+			&outbox.EventType,
+			&outbox.AggregateId,
+			&outbox.Payload,
+			&outbox.Status,
+			&outbox.AttemptCount,
+			&outbox.NextAttemptAt,
+			&outbox.LastError,
+			&outbox.CreatedAt,
+			&outbox.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		outboxes = append(outboxes, &outbox)
+	}
+
+	return outboxes, rows.Err()
+}
+
+func (or *outboxRepository) MarkOutboxDone(ctx context.Context, id string) error {
+	_, err := or.db.ExecContext(
+		ctx,
+		"UPDATE outbox SET status = $1, updated_at = now() WHERE id = $2",
+		entity.OutboxStatusDone,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (or *outboxRepository) MarkOutboxRetry(ctx context.Context, id string, nextAttemptAt time.Time, lastError string) error {
+	_, err := or.db.ExecContext(
+		ctx,
+		"UPDATE outbox SET next_attempt_at = $1, last_error = $2, updated_at = now() WHERE id = $3",
+		nextAttemptAt,
+		lastError,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (or *outboxRepository) MarkOutboxFailed(ctx context.Context, id string, lastError string) error {
+	_, err := or.db.ExecContext(
+		ctx,
+		"UPDATE outbox SET status = $1, last_error = $2, updated_at = now() WHERE id = $3",
+		entity.OutboxStatusFailed,
+		lastError,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewOutboxRepository(db database.DatabaseQuery) IOutboxRepository {
+	return &outboxRepository{
+		db: db,
+	}
+}
@@ -21,6 +21,7 @@ type IProductRepository interface {
 	GetProductById(ctx context.Context, id string) (*entity.Product, error)
 	GetProductsByIds(ctx context.Context, ids []string) ([]*entity.Product, error)
 	UpdateProduct(ctx context.Context, product *entity.Product) error
+	DecreaseProductStock(ctx context.Context, id string, quantity int64) (bool, error)
 	DeleteProduct(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error
 	GetProductsPagination(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.Product, *common.PaginationResponse, error)
 	GetProductsPaginationAdmin(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.Product, *common.PaginationResponse, error)
@@ -39,7 +40,7 @@ func (repo *productRepository) WithTrancastion(tx *sql.Tx) IProductRepository {
 func (repo *productRepository) CreateNewProduct(ctx context.Context, product *entity.Product) error {
 	_, err := repo.db.ExecContext(
 		ctx,
-		"INSERT INTO product (id, name, description, price, image_file_name, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)",
+		"INSERT INTO product (id, name, description, price, image_file_name, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted, weight_gram, category, currency, stock) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)",
 		product.Id,
 		product.Name,
 		product.Description,
@@ -52,6 +53,10 @@ func (repo *productRepository) CreateNewProduct(ctx context.Context, product *en
 		product.DeletedAt,
 		product.DeletedBy,
 		product.IsDeleted,
+		product.WeightGram,
+		product.Category,
+		product.Currency,
+		product.Stock,
 	)
 
 	if err != nil {
@@ -72,7 +77,7 @@ func (repo *productRepository) GetProductById(ctx context.Context, id string) (*
 	var productEntity entity.Product
 	row := repo.db.QueryRowContext(
 		ctx,
-		"SELECT id, name, description, price, image_file_name FROM product WHERE id = $1 AND is_deleted = false",
+		"SELECT id, name, description, price, currency, image_file_name, weight_gram, category, stock FROM product WHERE id = $1 AND is_deleted = false",
 		idParam,
 	)
 	if row.Err() != nil {
@@ -84,7 +89,11 @@ func (repo *productRepository) GetProductById(ctx context.Context, id string) (*
 		&productEntity.Name,
 		&productEntity.Description,
 		&productEntity.Price,
+		&productEntity.Currency,
 		&productEntity.ImageFileName,
+		&productEntity.WeightGram,
+		&productEntity.Category,
+		&productEntity.Stock,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -102,7 +111,7 @@ func (repo *productRepository) GetProductsByIds(ctx context.Context, ids []strin
 	}
 	rows, err := repo.db.QueryContext(
 		ctx,
-		fmt.Sprintf("SELECT id, name, price, image_file_name FROM product WHERE id IN (%s) AND is_deleted =false", strings.Join(queryIds, ", ")),
+		fmt.Sprintf("SELECT id, name, price, currency, image_file_name, weight_gram, category, stock FROM product WHERE id IN (%s) AND is_deleted =false", strings.Join(queryIds, ", ")),
 	)
 	if err != nil {
 		return nil, err
@@ -115,7 +124,11 @@ func (repo *productRepository) GetProductsByIds(ctx context.Context, ids []strin
 			&productEntity.Id,
 			&productEntity.Name,
 			&productEntity.Price,
+			&productEntity.Currency,
 			&productEntity.ImageFileName,
+			&productEntity.WeightGram,
+			&productEntity.Category,
+			&productEntity.Stock,
 		)
 		if err != nil {
 			return nil, err
@@ -130,13 +143,17 @@ func (repo *productRepository) GetProductsByIds(ctx context.Context, ids []strin
 func (repo *productRepository) UpdateProduct(ctx context.Context, product *entity.Product) error {
 	_, err := repo.db.ExecContext(
 		ctx,
-		"UPDATE product SET name=$1, description=$2, price=$3, image_file_name=$4, updated_at=$5, updated_by=$6 WHERE id =$7",
+		"UPDATE product SET name=$1, description=$2, price=$3, image_file_name=$4, updated_at=$5, updated_by=$6, weight_gram=$7, category=$8, currency=$9, stock=$10 WHERE id =$11",
 		product.Name,
 		product.Description,
 		product.Price,
 		product.ImageFileName,
 		product.UpdatedAt,
 		product.UpdatedBy,
+		product.WeightGram,
+		product.Category,
+		product.Currency,
+		product.Stock,
 		product.Id,
 	)
 
@@ -146,6 +163,27 @@ func (repo *productRepository) UpdateProduct(ctx context.Context, product *entit
 	return nil
 }
 
+// DecreaseProductStock mengurangi stok hanya jika stok masih cukup, return false jika stok tidak cukup.
+// Produk tanpa stok tercatat (stock NULL) selalu berhasil
+func (repo *productRepository) DecreaseProductStock(ctx context.Context, id string, quantity int64) (bool, error) {
+	res, err := repo.db.ExecContext(
+		ctx,
+		"UPDATE product SET stock = stock - $1 WHERE id = $2 AND is_deleted = false AND (stock IS NULL OR stock >= $1)",
+		quantity,
+		id,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
 func (repo *productRepository) DeleteProduct(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
 	_, err := repo.db.ExecContext(
 		ctx,
@@ -178,7 +216,7 @@ func (repo *productRepository) GetProductsPagination(ctx context.Context, pagina
 
 	rows, err := repo.db.QueryContext(
 		ctx,
-		"SELECT id, name, description, price, image_file_name FROM product WHERE is_deleted = false ORDER BY created_at DESC LIMIT $1 OFFSET $2",
+		"SELECT id, name, description, price, currency, image_file_name FROM product WHERE is_deleted = false ORDER BY created_at DESC LIMIT $1 OFFSET $2",
 		pagination.ItemPerPage,
 		offset,
 	)
@@ -196,6 +234,7 @@ func (repo *productRepository) GetProductsPagination(ctx context.Context, pagina
 			&product.Name,
 			&product.Description,
 			&product.Price,
+			&product.Currency,
 			&product.ImageFileName,
 		)
 		if err != nil {
@@ -244,7 +283,7 @@ func (repo *productRepository) GetProductsPaginationAdmin(ctx context.Context, p
 		orderQuery = fmt.Sprintf("ORDER BY %s %s", pagination.Sort.Field, direction)
 	}
 
-	baseQuery := fmt.Sprintf("SELECT id, name, description, price, image_file_name FROM product WHERE is_deleted = false %s LIMIT $1 OFFSET $2", orderQuery)
+	baseQuery := fmt.Sprintf("SELECT id, name, description, price, currency, image_file_name FROM product WHERE is_deleted = false %s LIMIT $1 OFFSET $2", orderQuery)
 	rows, err := repo.db.QueryContext(
 		ctx,
 		baseQuery,
@@ -265,6 +304,7 @@ func (repo *productRepository) GetProductsPaginationAdmin(ctx context.Context, p
 			&product.Name,
 			&product.Description,
 			&product.Price,
+			&product.Currency,
 			&product.ImageFileName,
 		)
 		if err != nil {
@@ -292,6 +332,7 @@ func (repo *productRepository) GetProductHighlight(ctx context.Context) ([]*enti
     name,
     description,
     price,
+    currency,
     image_file_name
 FROM
     product
@@ -320,6 +361,7 @@ WHERE
 			&productEntity.Name,
 			&productEntity.Description,
 			&productEntity.Price,
+			&productEntity.Currency,
 			&productEntity.ImageFileName,
 		)
 		if err != nil {
@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
+)
+
+type IProductSubscriptionRepository interface {
+	WithTrancastion(tx *sql.Tx) IProductSubscriptionRepository
+	UpsertSubscription(ctx context.Context, subscription *entity.ProductSubscription) error
+	DeleteSubscription(ctx context.Context, userId string, productId string, eventType string, deletedAt time.Time, deletedBy string) (bool, error)
+	DeleteSubscriptionById(ctx context.Context, id string, deletedAt time.Time, deletedBy string) (bool, error)
+	GetActiveSubscriptions(ctx context.Context, productId string, eventType string) ([]*entity.ProductSubscription, error)
+	GetNotifiedSubscriptionIds(ctx context.Context, outboxId string) (map[string]bool, error)
+	CountSentNotificationsSince(ctx context.Context, userId string, since time.Time) (int, error)
+	CreateNotification(ctx context.Context, notification *entity.ProductNotification) error
+}
+
+type productSubscriptionRepository struct {
+	db database.DatabaseQuery
+}
+
+func (psr *productSubscriptionRepository) WithTrancastion(tx *sql.Tx) IProductSubscriptionRepository {
+	return &productSubscriptionRepository{
+		db: tx,
+	}
+}
+
+// UpsertSubscription tidak membuat langganan ganda, Id diisi dengan id langganan yang aktif
+func (psr *productSubscriptionRepository) UpsertSubscription(ctx context.Context, subscription *entity.ProductSubscription) error {
+	row := psr.db.QueryRowContext(
+		ctx,
+		"INSERT INTO product_subscription (id, user_id, product_id, event_type, created_at, created_by, is_deleted) VALUES ($1, $2, $3, $4, $5, $6, false) "+
+			"ON CONFLICT (user_id, product_id, event_type) WHERE is_deleted = false DO UPDATE SET updated_at = EXCLUDED.created_at, updated_by = EXCLUDED.created_by "+
+			"RETURNING id",
+		subscription.Id,
+		subscription.UserId,
+		subscription.ProductId,
+		subscription.EventType,
+		subscription.CreatedAt,
+		subscription.CreatedBy,
+	)
+	if row.Err() != nil {
+		return row.Err()
+	}
+
+	return row.Scan(&subscription.Id)
+}
+
+func (psr *productSubscriptionRepository) DeleteSubscription(ctx context.Context, userId string, productId string, eventType string, deletedAt time.Time, deletedBy string) (bool, error) {
+	result, err := psr.db.ExecContext(
+		ctx,
+		"UPDATE product_subscription SET is_deleted = true, deleted_at = $1, deleted_by = $2 WHERE user_id = $3 AND product_id = $4 AND event_type = $5 AND is_deleted = false",
+		deletedAt,
+		deletedBy,
+		UUIDOrNil(userId),
+		UUIDOrNil(productId),
+		eventType,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (psr *productSubscriptionRepository) DeleteSubscriptionById(ctx context.Context, id string, deletedAt time.Time, deletedBy string) (bool, error) {
+	result, err := psr.db.ExecContext(
+		ctx,
+		"UPDATE product_subscription SET is_deleted = true, deleted_at = $1, deleted_by = $2 WHERE id = $3 AND is_deleted = false",
+		deletedAt,
+		deletedBy,
+		UUIDOrNil(id),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// GetActiveSubscriptions mengambil langganan aktif beserta email user tujuan notifikasi
+func (psr *productSubscriptionRepository) GetActiveSubscriptions(ctx context.Context, productId string, eventType string) ([]*entity.ProductSubscription, error) {
+	rows, err := psr.db.QueryContext(
+		ctx,
+		"SELECT ps.id, ps.user_id, ps.product_id, ps.event_type, ps.created_at, ps.created_by, u.email, u.full_name FROM product_subscription ps JOIN \"user\" u ON ps.user_id = u.id WHERE ps.product_id = $1 AND ps.event_type = $2 AND ps.is_deleted = false AND u.is_deleted = false ORDER BY ps.created_at",
+		UUIDOrNil(productId),
+		eventType,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subscriptions := make([]*entity.ProductSubscription, 0)
+	for rows.Next() {
+		var subscription entity.ProductSubscription
+		err = rows.Scan(
+			&subscription.Id,
+			&subscription.UserId,
+			&subscription.ProductId,
+			&subscription.EventType,
+			&subscription.CreatedAt,
+			&subscription.CreatedBy,
+			&subscription.UserEmail,
+			&subscription.UserFullName,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		subscriptions = append(subscriptions, &subscription)
+	}
+
+	return subscriptions, rows.Err()
+}
+
+// GetNotifiedSubscriptionIds mengembalikan langganan yang sudah diproses untuk outbox ini
+func (psr *productSubscriptionRepository) GetNotifiedSubscriptionIds(ctx context.Context, outboxId string) (map[string]bool, error) {
+	rows, err := psr.db.QueryContext(
+		ctx,
+		"SELECT subscription_id FROM product_notification WHERE outbox_id = $1",
+		UUIDOrNil(outboxId),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subscriptionIds := make(map[string]bool)
+	for rows.Next() {
+		var subscriptionId string
+		err = rows.Scan(&subscriptionId)
+		if err != nil {
+			return nil, err
+		}
+
+		subscriptionIds[subscriptionId] = true
+	}
+
+	return subscriptionIds, rows.Err()
+}
+
+func (psr *productSubscriptionRepository) CountSentNotificationsSince(ctx context.Context, userId string, since time.Time) (int, error) {
+	row := psr.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM product_notification WHERE user_id = $1 AND status = $2 AND created_at >= $3",
+		UUIDOrNil(userId),
+		entity.ProductNotificationStatusSent,
+		since,
+	)
+	if row.Err() != nil {
+		return 0, row.Err()
+	}
+
+	var count int
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (psr *productSubscriptionRepository) CreateNotification(ctx context.Context, notification *entity.ProductNotification) error {
+	_, err := psr.db.ExecContext(
+		ctx,
+		"INSERT INTO product_notification (id, outbox_id, subscription_id, user_id, product_id, event_type, status, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (outbox_id, subscription_id) DO NOTHING",
+		notification.Id,
+		notification.OutboxId,
+		notification.SubscriptionId,
+		notification.UserId,
+		notification.ProductId,
+		notification.EventType,
+		notification.Status,
+		notification.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewProductSubscriptionRepository(db database.DatabaseQuery) IProductSubscriptionRepository {
+	return &productSubscriptionRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,273 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
+)
+
+type IRefundRepository interface {
+	WithTrancastion(tx *sql.Tx) IRefundRepository
+	CreateRefund(ctx context.Context, refund *entity.OrderRefund) error
+	CreateRefundItem(ctx context.Context, refundItem *entity.OrderRefundItem) error
+	GetRefundById(ctx context.Context, refundId string) (*entity.OrderRefund, error)
+	GetRefundsByOrderId(ctx context.Context, orderId string) ([]*entity.OrderRefund, error)
+	UpdateRefundStatus(ctx context.Context, refund *entity.OrderRefund, fromStatus string) (bool, error)
+	GetProcessingRefunds(ctx context.Context, updatedBefore time.Time, limit int) ([]*entity.OrderRefund, error)
+}
+
+type refundRepository struct {
+	db database.DatabaseQuery
+}
+
+func (rr *refundRepository) WithTrancastion(tx *sql.Tx) IRefundRepository {
+	return &refundRepository{
+		db: tx,
+	}
+}
+
+func (rr *refundRepository) CreateRefund(ctx context.Context, refund *entity.OrderRefund) error {
+	_, err := rr.db.ExecContext(
+		ctx,
+		"INSERT INTO order_refund (id, order_id, status, reason, amount, previous_order_status_code, created_at, created_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		refund.Id,
+		refund.OrderId,
+		refund.Status,
+		refund.Reason,
+		refund.Amount,
+		refund.PreviousOrderStatusCode,
+		refund.CreatedAt,
+		refund.CreatedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (rr *refundRepository) CreateRefundItem(ctx context.Context, refundItem *entity.OrderRefundItem) error {
+	_, err := rr.db.ExecContext(
+		ctx,
+		"INSERT INTO order_refund_item (id, refund_id, order_item_id, product_id, quantity, amount, created_at, created_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		refundItem.Id,
+		refundItem.RefundId,
+		refundItem.OrderItemId,
+		refundItem.ProductId,
+		refundItem.Quantity,
+		refundItem.Amount,
+		refundItem.CreatedAt,
+		refundItem.CreatedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (rr *refundRepository) GetRefundById(ctx context.Context, refundId string) (*entity.OrderRefund, error) {
+	row := rr.db.QueryRowContext(
+		ctx,
+		"SELECT id, order_id, status, reason, reject_reason, amount, previous_order_status_code, provider_refund_id, created_at, created_by FROM order_refund WHERE id = $1",
+		UUIDOrNil(refundId),
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var refund entity.OrderRefund
+	err := row.Scan(
+		&refund.Id,
+		&refund.OrderId,
+		&refund.Status,
+		&refund.Reason,
+		&refund.RejectReason,
+		&refund.Amount,
+		&refund.PreviousOrderStatusCode,
+		&refund.ProviderRefundId,
+		&refund.CreatedAt,
+		&refund.CreatedBy,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	items, err := rr.getRefundItems(ctx, "refund_id = $1", refund.Id)
+	if err != nil {
+		return nil, err
+	}
+	refund.Items = items
+
+	return &refund, nil
+}
+
+func (rr *refundRepository) GetRefundsByOrderId(ctx context.Context, orderId string) ([]*entity.OrderRefund, error) {
+	rows, err := rr.db.QueryContext(
+		ctx,
+		"SELECT id, order_id, status, reason, reject_reason, amount, previous_order_status_code, provider_refund_id, created_at, created_by FROM order_refund WHERE order_id = $1 ORDER BY created_at",
+		orderId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	refunds := make([]*entity.OrderRefund, 0)
+	refundMap := make(map[string]*entity.OrderRefund)
+	for rows.Next() {
+		var refund entity.OrderRefund
+		err = rows.Scan(
+			&refund.Id,
+			&refund.OrderId,
+			&refund.Status,
+			&refund.Reason,
+			&refund.RejectReason,
+			&refund.Amount,
+			&refund.PreviousOrderStatusCode,
+			&refund.ProviderRefundId,
+			&refund.CreatedAt,
+			&refund.CreatedBy,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		refund.Items = make([]*entity.OrderRefundItem, 0)
+		refunds = append(refunds, &refund)
+		refundMap[refund.Id] = &refund
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(refunds) == 0 {
+		return refunds, nil
+	}
+
+	items, err := rr.getRefundItems(ctx, "refund_id IN (SELECT id FROM order_refund WHERE order_id = $1)", orderId)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if refund, ok := refundMap[item.RefundId]; ok {
+			refund.Items = append(refund.Items, item)
+		}
+	}
+
+	return refunds, nil
+}
+
+func (rr *refundRepository) getRefundItems(ctx context.Context, where string, arg any) ([]*entity.OrderRefundItem, error) {
+	rows, err := rr.db.QueryContext(
+		ctx,
+		"SELECT id, refund_id, order_item_id, product_id, quantity, amount, created_at, created_by FROM order_refund_item WHERE "+where,
+		arg,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]*entity.OrderRefundItem, 0)
+	for rows.Next() {
+		var item entity.OrderRefundItem
+		err = rows.Scan(
+			&item.Id,
+			&item.RefundId,
+			&item.OrderItemId,
+			&item.ProductId,
+			&item.Quantity,
+			&item.Amount,
+			&item.CreatedAt,
+			&item.CreatedBy,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, rows.Err()
+}
+
+// UpdateRefundStatus hanya mengupdate refund yang statusnya masih fromStatus, mencegah refund diproses dua kali
+func (rr *refundRepository) UpdateRefundStatus(ctx context.Context, refund *entity.OrderRefund, fromStatus string) (bool, error) {
+	res, err := rr.db.ExecContext(
+		ctx,
+		"UPDATE order_refund SET status = $1, reject_reason = $2, provider_refund_id = $3, updated_at = $4, updated_by = $5 WHERE id = $6 AND status = $7",
+		refund.Status,
+		refund.RejectReason,
+		refund.ProviderRefundId,
+		refund.UpdatedAt,
+		refund.UpdatedBy,
+		refund.Id,
+		fromStatus,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// GetProcessingRefunds mengambil refund yang sudah dikirim ke provider tetapi belum mendapat status akhir,
+// termasuk refund yang provider_refund_id-nya belum sempat disimpan
+func (rr *refundRepository) GetProcessingRefunds(ctx context.Context, updatedBefore time.Time, limit int) ([]*entity.OrderRefund, error) {
+	rows, err := rr.db.QueryContext(
+		ctx,
+		"SELECT id, order_id, status, reason, reject_reason, amount, previous_order_status_code, provider_refund_id, created_at, created_by FROM order_refund WHERE status = $1 AND updated_at < $2 ORDER BY updated_at LIMIT $3",
+		entity.RefundStatusProcessing,
+		updatedBefore,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	refunds := make([]*entity.OrderRefund, 0)
+	for rows.Next() {
+		var refund entity.OrderRefund
+		err = rows.Scan(
+			&refund.Id,
+			&refund.OrderId,
+			&refund.Status,
+			&refund.Reason,
+			&refund.RejectReason,
+			&refund.Amount,
+			&refund.PreviousOrderStatusCode,
+			&refund.ProviderRefundId,
+			&refund.CreatedAt,
+			&refund.CreatedBy,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		refunds = append(refunds, &refund)
+	}
+
+	return refunds, rows.Err()
+}
+
+func NewRefundRepository(db database.DatabaseQuery) IRefundRepository {
+	return &refundRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
+)
+
+type IRevokedTokenRepository interface {
+	WithTrancastion(tx *sql.Tx) IRevokedTokenRepository
+	CreateRevokedToken(ctx context.Context, tokenHash string, expiresAt time.Time, createdAt time.Time) error
+	IsTokenRevoked(ctx context.Context, tokenHash string) (bool, error)
+	DeleteExpiredRevokedTokens(ctx context.Context, now time.Time) (int64, error)
+}
+
+type revokedTokenRepository struct {
+	db database.DatabaseQuery
+}
+
+func (rr *revokedTokenRepository) WithTrancastion(tx *sql.Tx) IRevokedTokenRepository {
+	return &revokedTokenRepository{
+		db: tx,
+	}
+}
+
+func (rr *revokedTokenRepository) CreateRevokedToken(ctx context.Context, tokenHash string, expiresAt time.Time, createdAt time.Time) error {
+	_, err := rr.db.ExecContext(
+		ctx,
+		"INSERT INTO revoked_token (token_hash, expires_at, created_at) VALUES ($1, $2, $3) ON CONFLICT (token_hash) DO NOTHING",
+		tokenHash,
+		expiresAt,
+		createdAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (rr *revokedTokenRepository) IsTokenRevoked(ctx context.Context, tokenHash string) (bool, error) {
+	row := rr.db.QueryRowContext(
+		ctx,
+		"SELECT EXISTS (SELECT 1 FROM revoked_token WHERE token_hash = $1)",
+		tokenHash,
+	)
+	if row.Err() != nil {
+		return false, row.Err()
+	}
+
+	var revoked bool
+	err := row.Scan(&revoked)
+	if err != nil {
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+// DeleteExpiredRevokedTokens menghapus token yang sudah expired, token tersebut sudah ditolak oleh validasi jwt
+func (rr *revokedTokenRepository) DeleteExpiredRevokedTokens(ctx context.Context, now time.Time) (int64, error) {
+	res, err := rr.db.ExecContext(
+		ctx,
+		"DELETE FROM revoked_token WHERE expires_at < $1",
+		now,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func NewRevokedTokenRepository(db database.DatabaseQuery) IRevokedTokenRepository {
+	return &revokedTokenRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,298 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
+)
+
+const voucherColumns = "id, code, description, discount_type, discount_value, max_discount, min_spend, currency, usage_limit, per_user_usage_limit, starts_at, ends_at, product_ids, categories, is_active, created_at, created_by"
+
+type IVoucherRepository interface {
+	WithTrancastion(tx *sql.Tx) IVoucherRepository
+	CreateVoucher(ctx context.Context, voucher *entity.Voucher) error
+	GetVoucherById(ctx context.Context, voucherId string) (*entity.Voucher, error)
+	GetVoucherByCode(ctx context.Context, code string, forUpdate bool) (*entity.Voucher, error)
+	UpdateVoucher(ctx context.Context, voucher *entity.Voucher) error
+	DeleteVoucher(ctx context.Context, voucherId string, deletedAt time.Time, deletedBy string) error
+	GetVouchersPaginationAdmin(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.Voucher, *common.PaginationResponse, error)
+	CountVoucherUsage(ctx context.Context, voucherId string, userId string) (int64, int64, error)
+	CreateVoucherUsage(ctx context.Context, usage *entity.VoucherUsage) error
+}
+
+type voucherRepository struct {
+	db database.DatabaseQuery
+}
+
+func (vr *voucherRepository) WithTrancastion(tx *sql.Tx) IVoucherRepository {
+	return &voucherRepository{
+		db: tx,
+	}
+}
+
+func (vr *voucherRepository) CreateVoucher(ctx context.Context, voucher *entity.Voucher) error {
+	_, err := vr.db.ExecContext(
+		ctx,
+		"INSERT INTO voucher (id, code, description, discount_type, discount_value, max_discount, min_spend, currency, usage_limit, per_user_usage_limit, starts_at, ends_at, product_ids, categories, is_active, created_at, created_by, is_deleted) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, false)",
+		voucher.Id,
+		voucher.Code,
+		voucher.Description,
+		voucher.DiscountType,
+		voucher.DiscountValue,
+		voucher.MaxDiscount,
+		voucher.MinSpend,
+		voucher.Currency,
+		voucher.UsageLimit,
+		voucher.PerUserUsageLimit,
+		voucher.StartsAt,
+		voucher.EndsAt,
+		pq.Array(voucher.ProductIds),
+		pq.Array(voucher.Categories),
+		voucher.IsActive,
+		voucher.CreatedAt,
+		voucher.CreatedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (vr *voucherRepository) GetVoucherById(ctx context.Context, voucherId string) (*entity.Voucher, error) {
+	row := vr.db.QueryRowContext(
+		ctx,
+		fmt.Sprintf("SELECT %s FROM voucher WHERE id = $1 AND is_deleted = false", voucherColumns),
+		UUIDOrNil(voucherId),
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return scanVoucher(row)
+}
+
+// GetVoucherByCode dengan forUpdate true mengunci voucher sampai transaksi selesai,
+// sehingga pengecekan batas pemakaian tidak balapan dengan checkout lain
+func (vr *voucherRepository) GetVoucherByCode(ctx context.Context, code string, forUpdate bool) (*entity.Voucher, error) {
+	query := fmt.Sprintf("SELECT %s FROM voucher WHERE code = $1 AND is_deleted = false", voucherColumns)
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+
+	row := vr.db.QueryRowContext(ctx, query, code)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	return scanVoucher(row)
+}
+
+func scanVoucher(row *sql.Row) (*entity.Voucher, error) {
+	var voucher entity.Voucher
+	err := row.Scan(
+		&voucher.Id,
+		&voucher.Code,
+		&voucher.Description,
+		&voucher.DiscountType,
+		&voucher.DiscountValue,
+		&voucher.MaxDiscount,
+		&voucher.MinSpend,
+		&voucher.Currency,
+		&voucher.UsageLimit,
+		&voucher.PerUserUsageLimit,
+		&voucher.StartsAt,
+		&voucher.EndsAt,
+		pq.Array(&voucher.ProductIds),
+		pq.Array(&voucher.Categories),
+		&voucher.IsActive,
+		&voucher.CreatedAt,
+		&voucher.CreatedBy,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &voucher, nil
+}
+
+func (vr *voucherRepository) UpdateVoucher(ctx context.Context, voucher *entity.Voucher) error {
+	_, err := vr.db.ExecContext(
+		ctx,
+		"UPDATE voucher SET description = $1, discount_type = $2, discount_value = $3, max_discount = $4, min_spend = $5, currency = $6, usage_limit = $7, per_user_usage_limit = $8, starts_at = $9, ends_at = $10, product_ids = $11, categories = $12, is_active = $13, updated_at = $14, updated_by = $15 WHERE id = $16",
+		voucher.Description,
+		voucher.DiscountType,
+		voucher.DiscountValue,
+		voucher.MaxDiscount,
+		voucher.MinSpend,
+		voucher.Currency,
+		voucher.UsageLimit,
+		voucher.PerUserUsageLimit,
+		voucher.StartsAt,
+		voucher.EndsAt,
+		pq.Array(voucher.ProductIds),
+		pq.Array(voucher.Categories),
+		voucher.IsActive,
+		voucher.UpdatedAt,
+		voucher.UpdatedBy,
+		voucher.Id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (vr *voucherRepository) DeleteVoucher(ctx context.Context, voucherId string, deletedAt time.Time, deletedBy string) error {
+	_, err := vr.db.ExecContext(
+		ctx,
+		"UPDATE voucher SET deleted_at = $1, deleted_by = $2, is_deleted = true WHERE id = $3",
+		deletedAt,
+		deletedBy,
+		voucherId,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (vr *voucherRepository) GetVouchersPaginationAdmin(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.Voucher, *common.PaginationResponse, error) {
+	row := vr.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM voucher WHERE is_deleted = false")
+	if row.Err() != nil {
+		return nil, nil, row.Err()
+	}
+
+	var totalCount int
+	err := row.Scan(&totalCount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	offset := (pagination.CurrentPage - 1) * pagination.ItemPerPage
+	totalPages := (totalCount + int(pagination.ItemPerPage) - 1) / int(pagination.ItemPerPage)
+
+	allowedSorts := map[string]bool{
+		"code":      true,
+		"starts_at": true,
+		"ends_at":   true,
+	}
+
+	orderQuery := "ORDER BY created_at DESC"
+	if pagination.Sort != nil && allowedSorts[pagination.Sort.Field] {
+		direction := "asc"
+		if pagination.Sort.Direction == "desc" {
+			direction = "desc"
+		}
+		orderQuery = fmt.Sprintf("ORDER BY %s %s", pagination.Sort.Field, direction)
+	}
+
+	rows, err := vr.db.QueryContext(
+		ctx,
+		fmt.Sprintf("SELECT %s FROM voucher WHERE is_deleted = false %s LIMIT $1 OFFSET $2", voucherColumns, orderQuery),
+		pagination.ItemPerPage,
+		offset,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vouchers := make([]*entity.Voucher, 0)
+	for rows.Next() {
+		var voucher entity.Voucher
+		err = rows.Scan(
+			&voucher.Id,
+			&voucher.Code,
+			&voucher.Description,
+			&voucher.DiscountType,
+			&voucher.DiscountValue,
+			&voucher.MaxDiscount,
+			&voucher.MinSpend,
+			&voucher.Currency,
+			&voucher.UsageLimit,
+			&voucher.PerUserUsageLimit,
+			&voucher.StartsAt,
+			&voucher.EndsAt,
+			pq.Array(&voucher.ProductIds),
+			pq.Array(&voucher.Categories),
+			&voucher.IsActive,
+			&voucher.CreatedAt,
+			&voucher.CreatedBy,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		vouchers = append(vouchers, &voucher)
+	}
+
+	paginationResponse := &common.PaginationResponse{
+		CurrentPage:    pagination.CurrentPage,
+		ItemPerPage:    pagination.ItemPerPage,
+		TotalItemCount: int32(totalCount),
+		TotalPageCount: int32(totalPages),
+	}
+	return vouchers, paginationResponse, nil
+}
+
+// CountVoucherUsage menghitung pemakaian global dan per user,
+// order yang dibatalkan atau expired tidak dihitung sehingga kuotanya kembali
+func (vr *voucherRepository) CountVoucherUsage(ctx context.Context, voucherId string, userId string) (int64, int64, error) {
+	row := vr.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*), COUNT(*) FILTER (WHERE vu.user_id = $2) FROM voucher_usage vu JOIN \"order\" o ON o.id = vu.order_id WHERE vu.voucher_id = $1 AND o.order_status_code NOT IN ($3, $4)",
+		voucherId,
+		userId,
+		entity.OrderStatusCodeCanceled,
+		entity.OrderStatusCodeExpired,
+	)
+	if row.Err() != nil {
+		return 0, 0, row.Err()
+	}
+
+	var globalUsage int64
+	var userUsage int64
+	err := row.Scan(&globalUsage, &userUsage)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return globalUsage, userUsage, nil
+}
+
+func (vr *voucherRepository) CreateVoucherUsage(ctx context.Context, usage *entity.VoucherUsage) error {
+	_, err := vr.db.ExecContext(
+		ctx,
+		"INSERT INTO voucher_usage (id, voucher_id, user_id, order_id, discount_amount, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
+		usage.Id,
+		usage.VoucherId,
+		usage.UserId,
+		usage.OrderId,
+		usage.DiscountAmount,
+		usage.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewVoucherRepository(db database.DatabaseQuery) IVoucherRepository {
+	return &voucherRepository{
+		db: db,
+	}
+}
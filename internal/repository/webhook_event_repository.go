@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
+)
+
+type IWebhookEventRepository interface {
+	WithTrancastion(tx *sql.Tx) IWebhookEventRepository
+	CreateWebhookEvent(ctx context.Context, webhookEvent *entity.WebhookEvent) (bool, error)
+}
+
+type webhookEventRepository struct {
+	db database.DatabaseQuery
+}
+
+func (wr *webhookEventRepository) WithTrancastion(tx *sql.Tx) IWebhookEventRepository {
+	return &webhookEventRepository{
+		db: tx,
+	}
+}
+
+// CreateWebhookEvent return false jika event dengan provider dan event id yang sama sudah pernah diproses
+func (wr *webhookEventRepository) CreateWebhookEvent(ctx context.Context, webhookEvent *entity.WebhookEvent) (bool, error) {
+	res, err := wr.db.ExecContext(
+		ctx,
+		"INSERT INTO webhook_event (id, provider, event_id, invoice_id, external_id, status, received_at) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (provider, event_id) DO NOTHING",
+		webhookEvent.Id,
+		webhookEvent.Provider,
+		webhookEvent.EventId,
+		webhookEvent.InvoiceId,
+		webhookEvent.ExternalId,
+		webhookEvent.Status,
+		webhookEvent.ReceivedAt,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func NewWebhookEventRepository(db database.DatabaseQuery) IWebhookEventRepository {
+	return &webhookEventRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
+)
+
+type IWishlistRepository interface {
+	WithTrancastion(tx *sql.Tx) IWishlistRepository
+	AddWishlist(ctx context.Context, wishlist *entity.UserWishlist) error
+	GetWishlistPagination(ctx context.Context, pagination *common.PaginationRequest, userId string) ([]*entity.UserWishlist, *common.PaginationResponse, error)
+	DeleteWishlist(ctx context.Context, userId string, productId string) (bool, error)
+}
+
+type wishlistRepository struct {
+	db database.DatabaseQuery
+}
+
+func (wr *wishlistRepository) WithTrancastion(tx *sql.Tx) IWishlistRepository {
+	return &wishlistRepository{
+		db: tx,
+	}
+}
+
+// AddWishlist tidak membuat baris ganda jika produk sudah ada di wishlist, Id diisi dengan id yang tersimpan
+func (wr *wishlistRepository) AddWishlist(ctx context.Context, wishlist *entity.UserWishlist) error {
+	row := wr.db.QueryRowContext(
+		ctx,
+		"INSERT INTO user_wishlist (id, user_id, product_id, created_at, created_by) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (user_id, product_id) DO UPDATE SET user_id = EXCLUDED.user_id RETURNING id",
+		wishlist.Id,
+		wishlist.UserId,
+		wishlist.ProductId,
+		wishlist.CreatedAt,
+		wishlist.CreatedBy,
+	)
+	if row.Err() != nil {
+		return row.Err()
+	}
+
+	return row.Scan(&wishlist.Id)
+}
+
+// GetWishlistPagination ikut mengembalikan produk yang sudah dihapus, Product nil jika produk tidak ditemukan
+func (wr *wishlistRepository) GetWishlistPagination(ctx context.Context, pagination *common.PaginationRequest, userId string) ([]*entity.UserWishlist, *common.PaginationResponse, error) {
+	row := wr.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM user_wishlist WHERE user_id = $1",
+		UUIDOrNil(userId),
+	)
+	if row.Err() != nil {
+		return nil, nil, row.Err()
+	}
+
+	var totalCount int
+	err := row.Scan(&totalCount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	offset := (pagination.CurrentPage - 1) * pagination.ItemPerPage
+	totalPages := (totalCount + int(pagination.ItemPerPage) - 1) / int(pagination.ItemPerPage)
+
+	allowedSorts := map[string]string{
+		"name":       "p.name",
+		"price":      "p.price",
+		"created_at": "w.created_at",
+	}
+	sort := "ORDER BY w.created_at DESC"
+	if pagination.Sort != nil {
+		direction := "ASC"
+		sortField, ok := allowedSorts[pagination.Sort.Field]
+		if ok {
+			if pagination.Sort.Direction == "desc" {
+				direction = "DESC"
+			}
+			sort = fmt.Sprintf("ORDER BY %s %s", sortField, direction)
+		}
+	}
+
+	rows, err := wr.db.QueryContext(
+		ctx,
+		fmt.Sprintf("SELECT w.id, w.user_id, w.product_id, w.created_at, w.created_by, p.id, p.name, p.image_file_name, p.price, p.currency, p.is_deleted FROM user_wishlist w LEFT JOIN product p ON w.product_id = p.id WHERE w.user_id = $1 %s LIMIT $2 OFFSET $3", sort),
+		UUIDOrNil(userId),
+		pagination.ItemPerPage,
+		offset,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	wishlists := make([]*entity.UserWishlist, 0)
+	for rows.Next() {
+		var wishlist entity.UserWishlist
+		var productId, productName, productImageFileName, productCurrency sql.NullString
+		var productPrice sql.NullInt64
+		var productIsDeleted sql.NullBool
+
+		err = rows.Scan(
+			&wishlist.Id,
+			&wishlist.UserId,
+			&wishlist.ProductId,
+			&wishlist.CreatedAt,
+			&wishlist.CreatedBy,
+			&productId,
+			&productName,
+			&productImageFileName,
+			&productPrice,
+			&productCurrency,
+			&productIsDeleted,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if productId.Valid {
+			wishlist.Product = &entity.Product{
+				Id:            productId.String,
+				Name:          productName.String,
+				ImageFileName: productImageFileName.String,
+				Price:         productPrice.Int64,
+				Currency:      productCurrency.String,
+				IsDeleted:     productIsDeleted.Bool,
+			}
+		}
+
+		wishlists = append(wishlists, &wishlist)
+	}
+
+	paginationResponse := &common.PaginationResponse{
+		CurrentPage:    pagination.CurrentPage,
+		TotalPageCount: int32(totalPages),
+		ItemPerPage:    pagination.ItemPerPage,
+		TotalItemCount: int32(totalCount),
+	}
+	return wishlists, paginationResponse, rows.Err()
+}
+
+// DeleteWishlist mengembalikan false jika produk tidak ada di wishlist user
+func (wr *wishlistRepository) DeleteWishlist(ctx context.Context, userId string, productId string) (bool, error) {
+	result, err := wr.db.ExecContext(
+		ctx,
+		"DELETE FROM user_wishlist WHERE user_id = $1 AND product_id = $2",
+		UUIDOrNil(userId),
+		UUIDOrNil(productId),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func NewWishlistRepository(db database.DatabaseQuery) IWishlistRepository {
+	return &wishlistRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,57 @@
+package restmiddleware
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/logger"
+)
+
+// LoggingMiddleware memberi setiap request id dari header X-Request-Id atau id baru, lalu mencatat hasil request.
+// Query string tidak ditulis karena bisa berisi token, contoh link unsubscribe
+func LoggingMiddleware(c *fiber.Ctx) error {
+	requestId := logger.NormalizeRequestId(c.Get(fiber.HeaderXRequestID))
+	c.Set(fiber.HeaderXRequestID, requestId)
+	c.SetUserContext(logger.WithRequestId(c.UserContext(), requestId))
+
+	start := time.Now()
+	err := c.Next()
+
+	statusCode := responseStatusCode(c, err)
+	level := slog.LevelInfo
+	if statusCode >= http.StatusInternalServerError {
+		level = slog.LevelError
+	} else if statusCode >= http.StatusBadRequest {
+		level = slog.LevelWarn
+	}
+	slog.LogAttrs(
+		c.UserContext(),
+		level,
+		"http request",
+		slog.String("method", c.Method()),
+		slog.String("path", c.Path()),
+		slog.Int("status", statusCode),
+		slog.Float64("duration_ms", float64(time.Since(start).Microseconds())/1000),
+		slog.String("ip", c.IP()),
+	)
+
+	return err
+}
+
+// responseStatusCode mengembalikan status yang akan diterima client
+func responseStatusCode(c *fiber.Ctx, err error) int {
+	if err == nil {
+		return c.Response().StatusCode()
+	}
+
+	// error yang dikembalikan handler diubah menjadi response oleh error handler fiber setelah middleware
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		return fiberErr.Code
+	}
+
+	return http.StatusInternalServerError
+}
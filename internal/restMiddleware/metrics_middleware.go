@@ -0,0 +1,26 @@
+package restmiddleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/metrics"
+)
+
+// MetricsMiddleware mencatat jumlah dan latency request per route.
+// Route dibaca setelah c.Next karena route yang cocok baru diketahui setelah routing
+func MetricsMiddleware(c *fiber.Ctx) error {
+	start := time.Now()
+	err := c.Next()
+
+	statusCode := responseStatusCode(c, err)
+	// request yang tidak cocok dengan route manapun digabung agar path acak tidak menjadi label baru
+	route := c.Route().Path
+	if statusCode == fiber.StatusNotFound && route == "/" {
+		route = "unmatched"
+	}
+	metrics.ObserveHttpRequest(c.Method(), route, strconv.Itoa(statusCode), time.Since(start).Seconds())
+
+	return err
+}
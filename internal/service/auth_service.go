@@ -2,9 +2,11 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"errors"
-	"log"
+	"log/slog"
 	"os"
+	"runtime/debug"
 	"time"
 
 	jwtentity "github.com/luzmareto/go-grpc-ecommerce-be/internal/entity/jwt"
@@ -15,7 +17,6 @@ import (
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/auth"
-	gocache "github.com/patrickmn/go-cache"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -31,8 +32,10 @@ type IAuthService interface {
 }
 
 type authService struct {
+	db             *sql.DB
 	authRepository repository.IAuthRepository
-	cacheService   *gocache.Cache
+	cartRepository repository.ICartRepository
+	tokenRevocationService ITokenRevocationService
 }
 
 func (as *authService) Register(ctx context.Context, request *auth.RegisterRequest) (*auth.RegisterResponse, error) {
@@ -95,10 +98,8 @@ func (as *authService) Login(ctx context.Context, request *auth.LoginRequest) (*
 	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password))
 	if err != nil {
 		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
-			log.Println("Password mismatch error:", err) // Tambahkan log ini
 			return nil, status.Errorf(codes.Unauthenticated, "Unauthenticated")
 		}
-		log.Println("Unexpected bcrypt error:", err)
 
 		return nil, err
 	}
@@ -121,6 +122,17 @@ func (as *authService) Login(ctx context.Context, request *auth.LoginRequest) (*
 		return nil, err
 	}
 
+	// gabungkan cart pengunjung ke cart user, login tetap berhasil walaupun cart token tidak valid
+	guestCartId, err := utils.GetGuestCartIdFromContext(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "invalid cart token on login, skip merging guest cart")
+	} else if guestCartId != "" {
+		err = as.mergeGuestCart(ctx, guestCartId, user)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// kirim response
 	return &auth.LoginResponse{
 		Base:       utils.SuccessResponse("Login successful"),
@@ -128,6 +140,41 @@ func (as *authService) Login(ctx context.Context, request *auth.LoginRequest) (*
 	}, nil
 }
 
+// mergeGuestCart menjumlahkan quantity produk yang sama dan memindahkan sisa cart guest ke user dalam satu transaksi
+func (as *authService) mergeGuestCart(ctx context.Context, guestCartId string, user *entity.User) error {
+	tx, err := as.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	err = as.cartRepository.WithTrancastion(tx).MergeGuestCart(ctx, guestCartId, user.Id, time.Now(), user.FullName)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Logout implements IAuthService.
 func (as *authService) Logout(ctx context.Context, request *auth.LogoutRequest) (*auth.LogoutResponse, error) {
 
@@ -141,7 +188,10 @@ func (as *authService) Logout(ctx context.Context, request *auth.LogoutRequest)
 		return nil, err
 	}
 
-	as.cacheService.Set(jwtToken, "", time.Duration(tokenClaims.ExpiresAt.Time.Unix()-time.Now().Unix())*time.Second)
+	err = as.tokenRevocationService.Revoke(ctx, jwtToken, tokenClaims.ExpiresAt.Time)
+	if err != nil {
+		return nil, err
+	}
 
 	return &auth.LogoutResponse{
 		Base: utils.SuccessResponse("Logout Success"),
@@ -234,9 +284,11 @@ func (as *authService) GetProfile(ctx context.Context, request *auth.GetProfileR
 	}, nil
 }
 
-func NewAuthService(authRepository repository.IAuthRepository, cacheService *gocache.Cache) IAuthService {
+func NewAuthService(db *sql.DB, authRepository repository.IAuthRepository, cartRepository repository.ICartRepository, tokenRevocationService ITokenRevocationService) IAuthService {
 	return &authService{
+		db:             db,
 		authRepository: authRepository,
-		cacheService:   cacheService,
+		cartRepository: cartRepository,
+		tokenRevocationService: tokenRevocationService,
 	}
 }
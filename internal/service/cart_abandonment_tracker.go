@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/metrics"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+)
+
+const (
+	cartAbandonmentTrackerInterval = 5 * time.Minute
+	defaultCartAbandonedAfter      = 24 * time.Hour
+)
+
+type ICartAbandonmentTracker interface {
+	Run(ctx context.Context)
+	TrackAbandoned(ctx context.Context) error
+}
+
+type cartAbandonmentTracker struct {
+	cartRepository repository.ICartRepository
+	abandonedAfter time.Duration
+}
+
+// Run menandai cart yang ditinggalkan secara berkala sampai ctx dibatalkan
+func (ct *cartAbandonmentTracker) Run(ctx context.Context) {
+	ticker := time.NewTicker(cartAbandonmentTrackerInterval)
+	defer ticker.Stop()
+
+	for {
+		err := ct.TrackAbandoned(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "cart abandonment tracker error", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// TrackAbandoned menghitung cart yang tidak diubah selama abandonedAfter ke metric carts abandoned
+func (ct *cartAbandonmentTracker) TrackAbandoned(ctx context.Context) error {
+	now := time.Now()
+	count, err := ct.cartRepository.MarkAbandonedCarts(ctx, now.Add(-ct.abandonedAfter), now)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		slog.InfoContext(ctx, "carts abandoned", "count", count)
+		metrics.AddCartsAbandoned(count)
+	}
+
+	return nil
+}
+
+// NewCartAbandonmentTracker membaca CART_ABANDONED_AFTER
+func NewCartAbandonmentTracker(cartRepository repository.ICartRepository) ICartAbandonmentTracker {
+	abandonedAfter := defaultCartAbandonedAfter
+	if value := os.Getenv("CART_ABANDONED_AFTER"); value != "" {
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration <= 0 {
+			slog.Warn("invalid CART_ABANDONED_AFTER, using default", "value", value, "default", defaultCartAbandonedAfter)
+		} else {
+			abandonedAfter = duration
+		}
+	}
+
+	return &cartAbandonmentTracker{
+		cartRepository: cartRepository,
+		abandonedAfter: abandonedAfter,
+	}
+}
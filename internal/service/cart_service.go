@@ -2,13 +2,17 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"os"
+	"runtime/debug"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/currency"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
 	jwtentity "github.com/luzmareto/go-grpc-ecommerce-be/internal/entity/jwt"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/cart"
@@ -19,15 +23,71 @@ type ICartService interface {
 	ListCart(ctx context.Context,request *cart.ListCartRequest) (*cart.ListCartResponse, error)
 	DeleteCart(ctx context.Context,request *cart.DeleteCartRequest) (*cart.DeleteCartResponse, error)
 	UpdateCartQuantity(ctx context.Context, request *cart.UpdateCartQuantityRequest) (*cart.UpdateCartQuantityResponse, error)
+	BulkUpdateCart(ctx context.Context, request *cart.BulkUpdateCartRequest) (*cart.BulkUpdateCartResponse, error)
+	ClearCart(ctx context.Context, request *cart.ClearCartRequest) (*cart.ClearCartResponse, error)
 }
 
 type cartService struct {
+	db *sql.DB
 	productRepository repository.IProductRepository
 	cartRepository repository.ICartRepository
+	rateTable currency.IRateTable
 }
 
-func (cs *cartService) AddProductToCart(ctx context.Context, request *cart.AddProductToCartRequest) (*cart.AddProductToCartResponse, error) {
+// getCartOwner mengambil pemilik cart dari jwt, jika belum login dari cart token.
+// createGuest membuat guest cart baru dan token barunya ikut dikembalikan
+func (cs *cartService) getCartOwner(ctx context.Context, createGuest bool) (*entity.CartOwner, string, error) {
 	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err == nil {
+		return &entity.CartOwner{
+			UserId: claims.Subject,
+			Actor:  claims.FullName,
+		}, "", nil
+	}
+
+	guestCartId, err := utils.GetGuestCartIdFromContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if guestCartId != "" {
+		return &entity.CartOwner{
+			GuestCartId: guestCartId,
+			Actor:       entity.GuestCartActor,
+		}, "", nil
+	}
+
+	if !createGuest {
+		return nil, "", nil
+	}
+
+	guestCartId = uuid.NewString()
+	return &entity.CartOwner{
+		GuestCartId: guestCartId,
+		Actor:       entity.GuestCartActor,
+	}, utils.NewCartToken(guestCartId), nil
+}
+
+// newCartEntity menyiapkan baris cart beserta harga produk saat ditambahkan
+func newCartEntity(owner *entity.CartOwner, productEntity *entity.Product, quantity int64) *entity.UserCart {
+	cartEntity := entity.UserCart{
+		Id: uuid.NewString(),
+		UserId: owner.UserId,
+		ProductId: productEntity.Id,
+		Quantity: int(quantity),
+		PriceAtAdd: &productEntity.Price,
+		CurrencyAtAdd: &productEntity.Currency,
+		CreatedAt: time.Now(),
+		CreatedBy: owner.Actor,
+	}
+	if owner.IsGuest() {
+		cartEntity.GuestCartId = &owner.GuestCartId
+	}
+
+	return &cartEntity
+}
+
+func (cs *cartService) AddProductToCart(ctx context.Context, request *cart.AddProductToCartRequest) (*cart.AddProductToCartResponse, error) {
+	owner, cartToken, err := cs.getCartOwner(ctx, true)
 	if err != nil {
 		return nil, err
 	}
@@ -44,37 +104,20 @@ func (cs *cartService) AddProductToCart(ctx context.Context, request *cart.AddPr
 		}, nil
 	}
 
-	cartEntity, err := cs.cartRepository.GetCartByProductAndUserId(ctx, request.ProductId, claims.Subject)
-	if err != nil {
-		return nil, err
+	// client lama tidak mengirim quantity
+	quantity := request.Quantity
+	if quantity == 0 {
+		quantity = 1
 	}
 
-	if cartEntity != nil {
-		now := time.Now()
-		cartEntity.Quantity += 1
-		cartEntity.UpdatedAt = &now
-		cartEntity.UpdatedBy = &claims.Subject
-
-		err = cs.cartRepository.UpdateCart(ctx, cartEntity)
-		if err != nil {
-			return nil, err
-		}
-
+	if !productEntity.HasStock(quantity) {
 		return &cart.AddProductToCartResponse{
-		Base: utils.SuccessResponse(" Add product to cart success"),
-		Id: cartEntity.Id,
-	},nil 
+			Base: utils.BadRequestResponse("Product is out of stock"),
+		}, nil
 	}
 
-	newCartEntity := entity.UserCart{
-		Id: uuid.NewString(),
-		UserId: claims.Subject,
-		ProductId: request.ProductId,
-		Quantity: 1,
-		CreatedAt: time.Now(),
-		CreatedBy: claims.FullName,
-	}
-	err = cs.cartRepository.CreateNewCart(ctx, &newCartEntity)
+	newCartEntity := newCartEntity(owner, productEntity, quantity)
+	err = cs.cartRepository.UpsertCart(ctx, newCartEntity, true)
 	if err != nil {
 		return nil, err
 	}
@@ -82,46 +125,127 @@ func (cs *cartService) AddProductToCart(ctx context.Context, request *cart.AddPr
 	return &cart.AddProductToCartResponse{
 		Base: utils.SuccessResponse(" Add product to cart success"),
 		Id: newCartEntity.Id,
+		CartToken: cartToken,
 	},nil
 }
 
 func (cs *cartService) ListCart(ctx context.Context,request *cart.ListCartRequest) (*cart.ListCartResponse, error){
-	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	owner, _, err := cs.getCartOwner(ctx, false)
 	if err != nil {
 		return nil, err
 	}
 
-	carts, err :=  cs.cartRepository.GetlistCart(ctx, claims.Subject)
+	if request.Currency != "" && !cs.rateTable.IsSupported(request.Currency) {
+		return &cart.ListCartResponse{
+			Base: utils.BadRequestResponse("Currency is not supported"),
+		}, nil
+	}
+
+	// total dihitung dalam mata uang request, jika kosong memakai base currency
+	totalCurrency := request.Currency
+	if totalCurrency == "" {
+		totalCurrency = cs.rateTable.BaseCurrency()
+	}
+
+	var items []*cart.ListCartResponseItem = make([]*cart.ListCartResponseItem, 0)
+	// pengunjung tanpa cart token belum punya cart
+	if owner == nil {
+		return &cart.ListCartResponse{
+			Base: utils.SuccessResponse("Get list cart success"),
+			Items: items,
+			Total: utils.MoneyResponse(0, totalCurrency),
+		}, nil
+	}
+
+	carts, err :=  cs.cartRepository.GetlistCart(ctx, owner)
 	if err != nil {
 		return nil, err
 	}
 
-	var items []*cart.ListCartResponseItem = make([]*cart.ListCartResponseItem, 0)
+	total := money.New(0, totalCurrency)
+	var totalQuantity int64
+	var hasPriceChanges, hasUnavailableItems bool
 	for _, cartEntity := range carts {
 		item := cart.ListCartResponseItem{
 			CartId: cartEntity.Id,
-			ProductId: cartEntity.Product.Id,
-			ProductName: cartEntity.Product.Name,
-			ProductImageUrl: fmt.Sprintf("%s/product/%s", os.Getenv("STORAGE_SERVICE_URL"), cartEntity.Product.ImageFileName),
-			ProductPrice: cartEntity.Product.Price,
+			ProductId: cartEntity.ProductId,
 			Quantity: int64(cartEntity.Quantity),
+			Available: true,
 		}
-	
+
+		// item dengan produk yang sudah dihapus tetap ditampilkan beserta alasannya
+		if cartEntity.Product == nil {
+			item.Available = false
+			item.UnavailableReason = entity.ProductUnavailableReasonNotFound
+			hasUnavailableItems = true
+			items = append(items, &item)
+			continue
+		}
+
+		price, err := cs.rateTable.Convert(money.New(cartEntity.Product.Price, cartEntity.Product.Currency), request.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		item.ProductName = cartEntity.Product.Name
+		item.ProductImageUrl = fmt.Sprintf("%s/product/%s", os.Getenv("STORAGE_SERVICE_URL"), cartEntity.Product.ImageFileName)
+		item.ProductPrice = utils.MajorAmount(price.Amount, price.Currency)
+		item.ProductPriceMoney = utils.MoneyResponse(price.Amount, price.Currency)
+
+		if cartEntity.PriceAtAdd != nil && cartEntity.CurrencyAtAdd != nil {
+			priceAtAdd, err := cs.rateTable.Convert(money.New(*cartEntity.PriceAtAdd, *cartEntity.CurrencyAtAdd), price.Currency)
+			if err != nil {
+				return nil, err
+			}
+
+			item.PriceAtAdd = utils.MoneyResponse(priceAtAdd.Amount, priceAtAdd.Currency)
+			// dibandingkan dalam mata uang produk agar perubahan kurs tidak dianggap perubahan harga
+			item.PriceChanged = *cartEntity.PriceAtAdd != cartEntity.Product.Price || money.NormalizeCurrency(*cartEntity.CurrencyAtAdd) != money.NormalizeCurrency(cartEntity.Product.Currency)
+			if item.PriceChanged {
+				hasPriceChanges = true
+			}
+		}
+
+		if cartEntity.Product.IsDeleted {
+			item.Available = false
+			item.UnavailableReason = entity.ProductUnavailableReasonDeleted
+			hasUnavailableItems = true
+			items = append(items, &item)
+			continue
+		}
+
+		subtotal := price.Mul(int64(cartEntity.Quantity))
+		item.Subtotal = utils.MoneyResponse(subtotal.Amount, subtotal.Currency)
+
+		subtotalInTotalCurrency, err := cs.rateTable.Convert(subtotal, totalCurrency)
+		if err != nil {
+			return nil, err
+		}
+		total.Amount += subtotalInTotalCurrency.Amount
+		totalQuantity += int64(cartEntity.Quantity)
+
 	items = append(items, &item)
 	}
 
 	return &cart.ListCartResponse{
 		Base: utils.SuccessResponse("Get list cart success"),
 		Items: items,
+		Total: utils.MoneyResponse(total.Amount, total.Currency),
+		TotalQuantity: totalQuantity,
+		HasPriceChanges: hasPriceChanges,
+		HasUnavailableItems: hasUnavailableItems,
 	},nil
 	
 }
 
 func (cs *cartService) DeleteCart(ctx context.Context,request *cart.DeleteCartRequest) (*cart.DeleteCartResponse, error){
-	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	owner, _, err := cs.getCartOwner(ctx, false)
 	if err != nil {
 		return nil, err
 	}
+	if owner == nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
 
 	cartEtnity, err := cs.cartRepository.GetCartById(ctx, request.CartId)
 	if err != nil {
@@ -133,7 +257,7 @@ func (cs *cartService) DeleteCart(ctx context.Context,request *cart.DeleteCartRe
 		}, nil
 	}
 	
-	if cartEtnity.UserId != claims.Subject{
+	if !owner.Owns(cartEtnity) {
 		return &cart.DeleteCartResponse{
 			Base: utils.BadRequestResponse("Cart user is not matched"),
 		}, nil
@@ -149,10 +273,13 @@ func (cs *cartService) DeleteCart(ctx context.Context,request *cart.DeleteCartRe
 }
 
 func (cs *cartService) UpdateCartQuantity(ctx context.Context, request *cart.UpdateCartQuantityRequest) (*cart.UpdateCartQuantityResponse, error){
-	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	owner, _, err := cs.getCartOwner(ctx, false)
 	if err != nil {
 		return nil, err
 	}
+	if owner == nil {
+		return nil, utils.UnauthenticatedResponse()
+	}
 
 	
 	cartEntity, err := cs.cartRepository.GetCartById(ctx, request.CartId)
@@ -165,7 +292,7 @@ func (cs *cartService) UpdateCartQuantity(ctx context.Context, request *cart.Upd
 		}, nil
 	}
 
-	if cartEntity.UserId != claims.Subject {
+	if !owner.Owns(cartEntity) {
 		return  &cart.UpdateCartQuantityResponse{
 			Base: utils.BadRequestResponse("Cart user is not matched"),
 		}, nil		
@@ -181,10 +308,20 @@ func (cs *cartService) UpdateCartQuantity(ctx context.Context, request *cart.Upd
 			Base: utils.SuccessResponse("Update cart quantity success"),
 		}, nil
 	}
+	productEntity, err := cs.productRepository.GetProductById(ctx, cartEntity.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productEntity != nil && !productEntity.HasStock(request.NewQuantity) {
+		return &cart.UpdateCartQuantityResponse{
+			Base: utils.BadRequestResponse("Product is out of stock"),
+		}, nil
+	}
+
 	now := time.Now()
 	cartEntity.Quantity = int(request.NewQuantity)
 	cartEntity.UpdatedAt = &now
-	cartEntity.UpdatedBy = &claims.FullName
+	cartEntity.UpdatedBy = &owner.Actor
 
 	err = cs.cartRepository.UpdateCart(ctx, cartEntity)
 	if err != nil {
@@ -197,9 +334,132 @@ func (cs *cartService) UpdateCartQuantity(ctx context.Context, request *cart.Upd
 
 }
 
-func NewCartService(productRepository repository.IProductRepository, cartRepository repository.ICartRepository) ICartService {
+// BulkUpdateCart mengganti quantity beberapa produk sekaligus dalam satu transaksi
+func (cs *cartService) BulkUpdateCart(ctx context.Context, request *cart.BulkUpdateCartRequest) (*cart.BulkUpdateCartResponse, error) {
+	productIds := make([]string, 0)
+	removedProductIds := make([]string, 0)
+	quantities := make(map[string]int64)
+	for _, item := range request.Items {
+		if _, ok := quantities[item.ProductId]; ok {
+			return &cart.BulkUpdateCartResponse{
+				Base: utils.BadRequestResponse("Duplicate product in cart items"),
+			}, nil
+		}
+
+		quantities[item.ProductId] = item.Quantity
+		if item.Quantity == 0 {
+			removedProductIds = append(removedProductIds, item.ProductId)
+		} else {
+			productIds = append(productIds, item.ProductId)
+		}
+	}
+
+	// guest cart baru hanya dibuat jika ada produk yang disimpan
+	owner, cartToken, err := cs.getCartOwner(ctx, len(productIds) > 0)
+	if err != nil {
+		return nil, err
+	}
+	if owner == nil {
+		return &cart.BulkUpdateCartResponse{
+			Base: utils.SuccessResponse("Bulk update cart success"),
+		}, nil
+	}
+
+	products := make([]*entity.Product, 0)
+	if len(productIds) > 0 {
+		products, err = cs.productRepository.GetProductsByIds(ctx, productIds)
+		if err != nil {
+			return nil, err
+		}
+		if len(products) != len(productIds) {
+			return &cart.BulkUpdateCartResponse{
+				Base: utils.NotFoundResponse("Product not found"),
+			}, nil
+		}
+		for _, productEntity := range products {
+			if !productEntity.HasStock(quantities[productEntity.Id]) {
+				return &cart.BulkUpdateCartResponse{
+					Base: utils.BadRequestResponse(fmt.Sprintf("Product %s is out of stock", productEntity.Name)),
+				}, nil
+			}
+		}
+	}
+
+	tx, err := cs.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	cartRepo := cs.cartRepository.WithTrancastion(tx)
+
+	if request.Replace {
+		err = cartRepo.DeleteCartByOwner(ctx, owner, productIds)
+		if err != nil {
+			return nil, err
+		}
+	} else if len(removedProductIds) > 0 {
+		err = cartRepo.DeleteCartByProductIds(ctx, owner, removedProductIds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, productEntity := range products {
+		err = cartRepo.UpsertCart(ctx, newCartEntity(owner, productEntity, quantities[productEntity.Id]), false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &cart.BulkUpdateCartResponse{
+		Base: utils.SuccessResponse("Bulk update cart success"),
+		CartToken: cartToken,
+	}, nil
+}
+
+func (cs *cartService) ClearCart(ctx context.Context, request *cart.ClearCartRequest) (*cart.ClearCartResponse, error) {
+	owner, _, err := cs.getCartOwner(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if owner != nil {
+		err = cs.cartRepository.DeleteCartByOwner(ctx, owner, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &cart.ClearCartResponse{
+		Base: utils.SuccessResponse("Clear cart success"),
+	}, nil
+}
+
+func NewCartService(db *sql.DB, productRepository repository.IProductRepository, cartRepository repository.ICartRepository, rateTable currency.IRateTable) ICartService {
 	return  &cartService{
+		db: db,
 		productRepository: productRepository,
 		cartRepository: cartRepository,
+		rateTable: rateTable,
 	}
 }
\ No newline at end of file
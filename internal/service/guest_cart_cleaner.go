@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+)
+
+const (
+	guestCartCleanerInterval = time.Hour
+	defaultGuestCartTTL      = 30 * 24 * time.Hour
+)
+
+type IGuestCartCleaner interface {
+	Run(ctx context.Context)
+	CleanupExpired(ctx context.Context) error
+}
+
+type guestCartCleaner struct {
+	cartRepository repository.ICartRepository
+	ttl            time.Duration
+}
+
+// Run menghapus cart guest yang kedaluwarsa secara berkala sampai ctx dibatalkan
+func (gc *guestCartCleaner) Run(ctx context.Context) {
+	ticker := time.NewTicker(guestCartCleanerInterval)
+	defer ticker.Stop()
+
+	for {
+		err := gc.CleanupExpired(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "guest cart cleaner error", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CleanupExpired menghapus cart guest yang tidak diubah selama ttl, cart token lama tetap valid dan mendapat cart kosong
+func (gc *guestCartCleaner) CleanupExpired(ctx context.Context) error {
+	count, err := gc.cartRepository.DeleteExpiredGuestCarts(ctx, time.Now().Add(-gc.ttl))
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		slog.InfoContext(ctx, "expired guest carts deleted", "rows", count)
+	}
+
+	return nil
+}
+
+// NewGuestCartCleaner membaca GUEST_CART_TTL
+func NewGuestCartCleaner(cartRepository repository.ICartRepository) IGuestCartCleaner {
+	ttl := defaultGuestCartTTL
+	if value := os.Getenv("GUEST_CART_TTL"); value != "" {
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration <= 0 {
+			slog.Warn("invalid GUEST_CART_TTL, using default", "value", value, "default", defaultGuestCartTTL)
+		} else {
+			ttl = duration
+		}
+	}
+
+	return &guestCartCleaner{
+		cartRepository: cartRepository,
+		ttl:            ttl,
+	}
+}
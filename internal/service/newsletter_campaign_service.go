@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	jwtentity "github.com/luzmareto/go-grpc-ecommerce-be/internal/entity/jwt"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/mailtemplate"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/newsletter"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type INewsletterCampaignService interface {
+	ListCampaignTemplates(ctx context.Context, request *newsletter.ListCampaignTemplatesRequest) (*newsletter.ListCampaignTemplatesResponse, error)
+	CreateCampaign(ctx context.Context, request *newsletter.CreateCampaignRequest) (*newsletter.CreateCampaignResponse, error)
+	ListCampaigns(ctx context.Context, request *newsletter.ListCampaignsRequest) (*newsletter.ListCampaignsResponse, error)
+	DetailCampaign(ctx context.Context, request *newsletter.DetailCampaignRequest) (*newsletter.DetailCampaignResponse, error)
+	ScheduleCampaign(ctx context.Context, request *newsletter.ScheduleCampaignRequest) (*newsletter.ScheduleCampaignResponse, error)
+	CancelCampaign(ctx context.Context, request *newsletter.CancelCampaignRequest) (*newsletter.CancelCampaignResponse, error)
+	TrackOpen(ctx context.Context, recipientId string, signature string) error
+	TrackClick(ctx context.Context, recipientId string, targetUrl string, signature string) error
+}
+
+type newsletterCampaignService struct {
+	newsletterCampaignRepository repository.INewsletterCampaignRepository
+}
+
+func (ncs *newsletterCampaignService) ListCampaignTemplates(ctx context.Context, request *newsletter.ListCampaignTemplatesRequest) (*newsletter.ListCampaignTemplatesResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	items := make([]*newsletter.ListCampaignTemplatesResponseItem, 0)
+	for _, t := range mailtemplate.NewsletterTemplates {
+		items = append(items, &newsletter.ListCampaignTemplatesResponseItem{
+			Code: t.Code,
+			Name: t.Name,
+		})
+	}
+
+	return &newsletter.ListCampaignTemplatesResponse{
+		Base:  utils.SuccessResponse("Get list campaign template success"),
+		Items: items,
+	}, nil
+}
+
+func (ncs *newsletterCampaignService) CreateCampaign(ctx context.Context, request *newsletter.CreateCampaignRequest) (*newsletter.CreateCampaignResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	if !mailtemplate.IsNewsletterTemplate(request.TemplateCode) {
+		return &newsletter.CreateCampaignResponse{
+			Base: utils.BadRequestResponse("Template not found"),
+		}, nil
+	}
+
+	var topic *string
+	if request.Segment == entity.NewsletterSegmentTopic {
+		if !slices.Contains(entity.NewsletterTopics, request.Topic) {
+			return &newsletter.CreateCampaignResponse{
+				Base: utils.BadRequestResponse("Topic is required for topic segment"),
+			}, nil
+		}
+		topic = &request.Topic
+	}
+
+	var ctaText, ctaUrl *string
+	if request.CtaUrl != "" {
+		parsedUrl, err := url.Parse(request.CtaUrl)
+		if err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+			return &newsletter.CreateCampaignResponse{
+				Base: utils.BadRequestResponse("Call to action url must be an absolute http url"),
+			}, nil
+		}
+		if request.CtaText == "" {
+			return &newsletter.CreateCampaignResponse{
+				Base: utils.BadRequestResponse("Call to action text is required"),
+			}, nil
+		}
+		ctaText = &request.CtaText
+		ctaUrl = &request.CtaUrl
+	}
+
+	campaignEntity := entity.NewsletterCampaign{
+		Id:           uuid.NewString(),
+		Name:         request.Name,
+		TemplateCode: request.TemplateCode,
+		Subject:      request.Subject,
+		Title:        request.Title,
+		Body:         request.Body,
+		CtaText:      ctaText,
+		CtaUrl:       ctaUrl,
+		Segment:      request.Segment,
+		Topic:        topic,
+		Status:       entity.NewsletterCampaignStatusDraft,
+		CreatedAt:    time.Now(),
+		CreatedBy:    claims.FullName,
+	}
+
+	// pastikan template bisa dirender sebelum campaign disimpan
+	_, err = newsletterCampaignMessage(&campaignEntity, &entity.NewsletterCampaignRecipient{
+		Id:       uuid.NewString(),
+		FullName: claims.FullName,
+		Email:    claims.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = ncs.newsletterCampaignRepository.CreateCampaign(ctx, &campaignEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newsletter.CreateCampaignResponse{
+		Base: utils.SuccessResponse("Create campaign success"),
+		Id:   campaignEntity.Id,
+	}, nil
+}
+
+func (ncs *newsletterCampaignService) ListCampaigns(ctx context.Context, request *newsletter.ListCampaignsRequest) (*newsletter.ListCampaignsResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	campaigns, paginationResponse, err := ncs.newsletterCampaignRepository.GetCampaignsPagination(ctx, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*newsletter.ListCampaignsResponseItem, 0)
+	for _, c := range campaigns {
+		item := &newsletter.ListCampaignsResponseItem{
+			Id:              c.Id,
+			Name:            c.Name,
+			TemplateCode:    c.TemplateCode,
+			Subject:         c.Subject,
+			Segment:         c.Segment,
+			Status:          c.Status,
+			TotalRecipients: c.TotalRecipients,
+			CreatedAt:       timestamppb.New(c.CreatedAt),
+		}
+		if c.Topic != nil {
+			item.Topic = *c.Topic
+		}
+		if c.ScheduledAt != nil {
+			item.ScheduledAt = timestamppb.New(*c.ScheduledAt)
+		}
+		if c.CompletedAt != nil {
+			item.CompletedAt = timestamppb.New(*c.CompletedAt)
+		}
+
+		items = append(items, item)
+	}
+
+	return &newsletter.ListCampaignsResponse{
+		Base:       utils.SuccessResponse("Get list campaign success"),
+		Pagination: paginationResponse,
+		Items:      items,
+	}, nil
+}
+
+func (ncs *newsletterCampaignService) DetailCampaign(ctx context.Context, request *newsletter.DetailCampaignRequest) (*newsletter.DetailCampaignResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	campaignEntity, err := ncs.newsletterCampaignRepository.GetCampaignById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if campaignEntity == nil {
+		return &newsletter.DetailCampaignResponse{
+			Base: utils.NotFoundResponse("Campaign not found"),
+		}, nil
+	}
+
+	stats, err := ncs.newsletterCampaignRepository.GetCampaignStats(ctx, campaignEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &newsletter.DetailCampaignResponse{
+		Base:            utils.SuccessResponse("Get detail campaign success"),
+		Id:              campaignEntity.Id,
+		Name:            campaignEntity.Name,
+		TemplateCode:    campaignEntity.TemplateCode,
+		Subject:         campaignEntity.Subject,
+		Title:           campaignEntity.Title,
+		Body:            campaignEntity.Body,
+		Segment:         campaignEntity.Segment,
+		Status:          campaignEntity.Status,
+		TotalRecipients: campaignEntity.TotalRecipients,
+		PendingCount:    stats.PendingCount,
+		SentCount:       stats.SentCount,
+		FailedCount:     stats.FailedCount,
+		SkippedCount:    stats.SkippedCount,
+		OpenedCount:     stats.OpenedCount,
+		ClickedCount:    stats.ClickedCount,
+		CreatedAt:       timestamppb.New(campaignEntity.CreatedAt),
+	}
+	if campaignEntity.CtaText != nil {
+		res.CtaText = *campaignEntity.CtaText
+	}
+	if campaignEntity.CtaUrl != nil {
+		res.CtaUrl = *campaignEntity.CtaUrl
+	}
+	if campaignEntity.Topic != nil {
+		res.Topic = *campaignEntity.Topic
+	}
+	if campaignEntity.ScheduledAt != nil {
+		res.ScheduledAt = timestamppb.New(*campaignEntity.ScheduledAt)
+	}
+	if campaignEntity.StartedAt != nil {
+		res.StartedAt = timestamppb.New(*campaignEntity.StartedAt)
+	}
+	if campaignEntity.CompletedAt != nil {
+		res.CompletedAt = timestamppb.New(*campaignEntity.CompletedAt)
+	}
+
+	return res, nil
+}
+
+// ScheduleCampaign menjadwalkan pengiriman, jadwal masih bisa diubah selama campaign belum mulai dikirim
+func (ncs *newsletterCampaignService) ScheduleCampaign(ctx context.Context, request *newsletter.ScheduleCampaignRequest) (*newsletter.ScheduleCampaignResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	now := time.Now()
+	scheduledAt := now
+	if request.ScheduledAt != nil {
+		scheduledAt = request.ScheduledAt.AsTime()
+		if scheduledAt.Before(now) {
+			scheduledAt = now
+		}
+	}
+
+	scheduled, err := ncs.newsletterCampaignRepository.ScheduleCampaign(ctx, request.Id, scheduledAt, now, claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+	if !scheduled {
+		return &newsletter.ScheduleCampaignResponse{
+			Base: utils.BadRequestResponse("Campaign not found or already sent"),
+		}, nil
+	}
+
+	return &newsletter.ScheduleCampaignResponse{
+		Base: utils.SuccessResponse("Schedule campaign success"),
+	}, nil
+}
+
+func (ncs *newsletterCampaignService) CancelCampaign(ctx context.Context, request *newsletter.CancelCampaignRequest) (*newsletter.CancelCampaignResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	canceled, err := ncs.newsletterCampaignRepository.CancelCampaign(ctx, request.Id, time.Now(), claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+	if !canceled {
+		return &newsletter.CancelCampaignResponse{
+			Base: utils.BadRequestResponse("Campaign not found or already finished"),
+		}, nil
+	}
+
+	return &newsletter.CancelCampaignResponse{
+		Base: utils.SuccessResponse("Cancel campaign success"),
+	}, nil
+}
+
+// TrackOpen dipanggil dari tracking pixel, signature mencegah statistik dipalsukan
+func (ncs *newsletterCampaignService) TrackOpen(ctx context.Context, recipientId string, signature string) error {
+	if !utils.VerifyLinkValue(utils.SignedLinkPurposeNewsletterOpen, recipientId, signature) {
+		return utils.UnauthenticatedResponse()
+	}
+
+	return ncs.newsletterCampaignRepository.MarkRecipientOpened(ctx, recipientId, time.Now())
+}
+
+// TrackClick memverifikasi url tujuan ikut ditandatangani agar endpoint tidak bisa dipakai sebagai open redirect
+func (ncs *newsletterCampaignService) TrackClick(ctx context.Context, recipientId string, targetUrl string, signature string) error {
+	if !utils.VerifyLinkValue(utils.SignedLinkPurposeNewsletterClick, newsletterClickValue(recipientId, targetUrl), signature) {
+		return utils.UnauthenticatedResponse()
+	}
+
+	return ncs.newsletterCampaignRepository.MarkRecipientClicked(ctx, recipientId, time.Now())
+}
+
+func NewNewsletterCampaignService(newsletterCampaignRepository repository.INewsletterCampaignRepository) INewsletterCampaignService {
+	return &newsletterCampaignService{
+		newsletterCampaignRepository: newsletterCampaignRepository,
+	}
+}
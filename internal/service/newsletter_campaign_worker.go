@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"runtime/debug"
+	"strconv"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/mailer"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/mailtemplate"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
+)
+
+const (
+	newsletterCampaignWorkerInterval    = 10 * time.Second
+	newsletterCampaignWorkerLease       = 5 * time.Minute
+	newsletterCampaignWorkerMaxAttempts = 5
+	newsletterCampaignWorkerBackoff     = time.Minute
+	newsletterCampaignStartBatchSize    = 5
+
+	defaultNewsletterSendRatePerMinute = 60
+)
+
+type INewsletterCampaignWorker interface {
+	Run(ctx context.Context)
+	ProcessPending(ctx context.Context) error
+}
+
+type newsletterCampaignWorker struct {
+	db                           *sql.DB
+	newsletterCampaignRepository repository.INewsletterCampaignRepository
+	mailer                       mailer.IMailer
+
+	// jumlah email maksimal per menit agar tidak terkena limit provider email
+	ratePerMinute int
+}
+
+// Run memulai campaign terjadwal dan mengirim email ke penerima secara berkala sampai ctx dibatalkan
+func (nw *newsletterCampaignWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(newsletterCampaignWorkerInterval)
+	defer ticker.Stop()
+
+	for {
+		err := nw.ProcessPending(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "newsletter campaign worker error", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (nw *newsletterCampaignWorker) ProcessPending(ctx context.Context) error {
+	err := nw.startDueCampaigns(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = nw.sendPendingRecipients(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nw.newsletterCampaignRepository.CompleteSendingCampaigns(ctx, time.Now())
+}
+
+// startDueCampaigns mengubah campaign terjadwal menjadi sending dan mengisi penerimanya dalam satu transaksi
+func (nw *newsletterCampaignWorker) startDueCampaigns(ctx context.Context) error {
+	tx, err := nw.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	campaignRepo := nw.newsletterCampaignRepository.WithTrancastion(tx)
+
+	now := time.Now()
+	campaigns, err := campaignRepo.ClaimDueCampaigns(ctx, now, newsletterCampaignStartBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, campaign := range campaigns {
+		total, err := campaignRepo.CreateCampaignRecipients(ctx, campaign, now)
+		if err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "newsletter campaign started", "campaign_id", campaign.Id, "recipients", total)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// sendPendingRecipients mengirim satu batch email dengan jeda antar email sesuai ratePerMinute
+func (nw *newsletterCampaignWorker) sendPendingRecipients(ctx context.Context) error {
+	recipients, err := nw.newsletterCampaignRepository.ClaimPendingRecipients(
+		ctx,
+		nw.batchSize(),
+		time.Now().Add(newsletterCampaignWorkerLease),
+	)
+	if err != nil {
+		return err
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	throttle := time.NewTicker(time.Minute / time.Duration(nw.ratePerMinute))
+	defer throttle.Stop()
+
+	campaigns := make(map[string]*entity.NewsletterCampaign)
+	for i, recipient := range recipients {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-throttle.C:
+			}
+		}
+
+		campaign, ok := campaigns[recipient.CampaignId]
+		if !ok {
+			campaign, err = nw.newsletterCampaignRepository.GetCampaignById(ctx, recipient.CampaignId)
+			if err != nil {
+				return err
+			}
+			campaigns[recipient.CampaignId] = campaign
+		}
+		if campaign == nil {
+			err = nw.newsletterCampaignRepository.MarkRecipientFailed(ctx, recipient.Id, "campaign not found")
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = nw.send(ctx, campaign, recipient)
+		if err == nil {
+			err = nw.newsletterCampaignRepository.MarkRecipientSent(ctx, recipient.Id, time.Now())
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		slog.WarnContext(ctx, "send newsletter campaign failed", "campaign_id", campaign.Id, "recipient_id", recipient.Id, "attempt", recipient.AttemptCount, "error", err)
+		if recipient.AttemptCount >= newsletterCampaignWorkerMaxAttempts {
+			err = nw.newsletterCampaignRepository.MarkRecipientFailed(ctx, recipient.Id, err.Error())
+		} else {
+			err = nw.newsletterCampaignRepository.MarkRecipientRetry(ctx, recipient.Id, time.Now().Add(newsletterCampaignWorkerBackoff), err.Error())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (nw *newsletterCampaignWorker) send(ctx context.Context, campaign *entity.NewsletterCampaign, recipient *entity.NewsletterCampaignRecipient) error {
+	message, err := newsletterCampaignMessage(campaign, recipient)
+	if err != nil {
+		return err
+	}
+
+	return nw.mailer.Send(ctx, message)
+}
+
+// batchSize adalah jumlah email yang bisa dikirim dalam satu interval worker
+func (nw *newsletterCampaignWorker) batchSize() int {
+	batchSize := int(time.Duration(nw.ratePerMinute) * newsletterCampaignWorkerInterval / time.Minute)
+	if batchSize < 1 {
+		return 1
+	}
+
+	return batchSize
+}
+
+type newsletterCampaignTemplateData struct {
+	FullName         string
+	Title            string
+	Body             string
+	CtaText          string
+	CtaUrl           string
+	UnsubscribeUrl   string
+	TrackingPixelUrl string
+}
+
+// newsletterCampaignMessage merender template campaign untuk satu penerima, link cta diganti dengan link tracking klik
+func newsletterCampaignMessage(campaign *entity.NewsletterCampaign, recipient *entity.NewsletterCampaignRecipient) (*mailer.Message, error) {
+	unsubscribeUrl := newsletterUnsubscribeUrl(recipient.NewsletterId)
+	data := newsletterCampaignTemplateData{
+		FullName:       recipient.FullName,
+		Title:          campaign.Title,
+		Body:           campaign.Body,
+		UnsubscribeUrl: unsubscribeUrl,
+		TrackingPixelUrl: fmt.Sprintf(
+			"%s/newsletter/track/open?r=%s&sig=%s",
+			os.Getenv("REST_BASE_URL"),
+			url.QueryEscape(recipient.Id),
+			url.QueryEscape(utils.SignLinkValue(utils.SignedLinkPurposeNewsletterOpen, recipient.Id)),
+		),
+	}
+	if campaign.CtaUrl != nil && campaign.CtaText != nil {
+		data.CtaText = *campaign.CtaText
+		data.CtaUrl = fmt.Sprintf(
+			"%s/newsletter/track/click?r=%s&u=%s&sig=%s",
+			os.Getenv("REST_BASE_URL"),
+			url.QueryEscape(recipient.Id),
+			url.QueryEscape(*campaign.CtaUrl),
+			url.QueryEscape(utils.SignLinkValue(utils.SignedLinkPurposeNewsletterClick, newsletterClickValue(recipient.Id, *campaign.CtaUrl))),
+		)
+	}
+
+	rendered, err := mailtemplate.Render("newsletter/"+campaign.TemplateCode, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mailer.Message{
+		To:       recipient.Email,
+		ToName:   recipient.FullName,
+		Subject:  campaign.Subject,
+		TextBody: rendered.Text,
+		HTMLBody: rendered.HTML,
+		Headers: map[string]string{
+			"List-Unsubscribe":      fmt.Sprintf("<%s>", unsubscribeUrl),
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+	}, nil
+}
+
+func newsletterClickValue(recipientId string, targetUrl string) string {
+	return recipientId + "\n" + targetUrl
+}
+
+// NewNewsletterCampaignWorker membaca NEWSLETTER_SEND_RATE_PER_MINUTE
+func NewNewsletterCampaignWorker(db *sql.DB, newsletterCampaignRepository repository.INewsletterCampaignRepository, mailer mailer.IMailer) INewsletterCampaignWorker {
+	ratePerMinute := defaultNewsletterSendRatePerMinute
+	if value := os.Getenv("NEWSLETTER_SEND_RATE_PER_MINUTE"); value != "" {
+		rate, err := strconv.Atoi(value)
+		if err != nil || rate <= 0 {
+			slog.Warn("invalid NEWSLETTER_SEND_RATE_PER_MINUTE, using default", "value", value, "default", defaultNewsletterSendRatePerMinute)
+		} else {
+			ratePerMinute = rate
+		}
+	}
+
+	return &newsletterCampaignWorker{
+		db:                           db,
+		newsletterCampaignRepository: newsletterCampaignRepository,
+		mailer:                       mailer,
+		ratePerMinute:                ratePerMinute,
+	}
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/mailer"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+)
+
+const (
+	newsletterConfirmDispatcherInterval    = 5 * time.Second
+	newsletterConfirmDispatcherBatchSize   = 10
+	newsletterConfirmDispatcherLease       = 5 * time.Minute
+	newsletterConfirmDispatcherMaxAttempts = 5
+	newsletterConfirmDispatcherBackoff     = time.Minute
+)
+
+type INewsletterConfirmDispatcher interface {
+	Run(ctx context.Context)
+	DispatchPending(ctx context.Context) error
+}
+
+type newsletterConfirmDispatcher struct {
+	newsletterRepository repository.InewsLetterRepository
+	outboxRepository     repository.IOutboxRepository
+	mailer               mailer.IMailer
+}
+
+// Run memproses outbox newsletter_confirm secara berkala sampai ctx dibatalkan
+func (nd *newsletterConfirmDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(newsletterConfirmDispatcherInterval)
+	defer ticker.Stop()
+
+	for {
+		err := nd.DispatchPending(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "newsletter confirm dispatcher error", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (nd *newsletterConfirmDispatcher) DispatchPending(ctx context.Context) error {
+	outboxes, err := nd.outboxRepository.ClaimPendingOutbox(
+		ctx,
+		entity.OutboxEventTypeNewsletterConfirm,
+		newsletterConfirmDispatcherBatchSize,
+		time.Now().Add(newsletterConfirmDispatcherLease),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, outbox := range outboxes {
+		err = nd.dispatch(ctx, outbox)
+		if err == nil {
+			err = nd.outboxRepository.MarkOutboxDone(ctx, outbox.Id)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		slog.WarnContext(ctx, "send newsletter confirmation failed", "newsletter_id", outbox.AggregateId, "attempt", outbox.AttemptCount, "error", err)
+		if outbox.AttemptCount >= newsletterConfirmDispatcherMaxAttempts {
+			err = nd.outboxRepository.MarkOutboxFailed(ctx, outbox.Id, err.Error())
+		} else {
+			err = nd.outboxRepository.MarkOutboxRetry(ctx, outbox.Id, time.Now().Add(newsletterConfirmDispatcherBackoff), err.Error())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dispatch membuat token konfirmasi baru lalu mengirim emailnya, token dari email sebelumnya tidak berlaku lagi
+func (nd *newsletterConfirmDispatcher) dispatch(ctx context.Context, outbox *entity.Outbox) error {
+	var payload entity.NewsletterConfirmPayload
+	err := json.Unmarshal(outbox.Payload, &payload)
+	if err != nil {
+		return err
+	}
+
+	newsletterEntity, err := nd.newsletterRepository.GetNewsletterById(ctx, payload.NewsletterId)
+	if err != nil {
+		return err
+	}
+	// subscriber sudah konfirmasi atau unsubscribe sebelum email dikirim
+	if newsletterEntity == nil || newsletterEntity.Status != entity.NewsletterStatusPending {
+		return nil
+	}
+
+	confirmToken, confirmTokenHash, err := newNewsletterConfirmToken()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	expiresAt := now.Add(newsletterConfirmTokenTTL)
+	updatedBy := "System"
+	newsletterEntity.ConfirmTokenHash = &confirmTokenHash
+	newsletterEntity.ConfirmTokenExpiresAt = &expiresAt
+	newsletterEntity.UpdatedAt = &now
+	newsletterEntity.UpdatedBy = &updatedBy
+
+	err = nd.newsletterRepository.UpdateNewsletterConfirmToken(ctx, newsletterEntity)
+	if err != nil {
+		return err
+	}
+
+	return nd.mailer.Send(ctx, newsletterConfirmMessage(newsletterEntity, confirmToken))
+}
+
+func NewNewsletterConfirmDispatcher(newsletterRepository repository.InewsLetterRepository, outboxRepository repository.IOutboxRepository, mailer mailer.IMailer) INewsletterConfirmDispatcher {
+	return &newsletterConfirmDispatcher{
+		newsletterRepository: newsletterRepository,
+		outboxRepository:     outboxRepository,
+		mailer:               mailer,
+	}
+}
@@ -2,54 +2,581 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/mail"
+	"net/url"
+	"os"
+	"runtime/debug"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	jwtentity "github.com/luzmareto/go-grpc-ecommerce-be/internal/entity/jwt"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/mailer"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/newsletter"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	newsletterConfirmTokenTTL       = 48 * time.Hour
+	newsletterConfirmResendCooldown = 10 * time.Minute
+
+	// status untuk user yang belum berlangganan
+	newsletterStatusNotSubscribed = "not_subscribed"
+
+	// topik di csv dipisah dengan |
+	newsletterCsvTopicSeparator = "|"
+	newsletterImportMaxErrors   = 20
+)
+
+var ErrNewsletterImportHeader = errors.New("csv header must contain email and full_name")
+
+var newsletterCsvHeader = []string{"id", "email", "full_name", "status", "topics", "confirmed_at", "created_at"}
+
 type InewsLetterService interface {
 	SubcribeNewsletter(ctx context.Context, request *newsletter.SubcribeNewsletterRequest) (*newsletter.SubcribeNewsletterResponse, error)
+	ConfirmNewsletter(ctx context.Context, request *newsletter.ConfirmNewsletterRequest) (*newsletter.ConfirmNewsletterResponse, error)
+	GetNewsletterSubscription(ctx context.Context, request *newsletter.GetNewsletterSubscriptionRequest) (*newsletter.GetNewsletterSubscriptionResponse, error)
+	UpdateNewsletterPreferences(ctx context.Context, request *newsletter.UpdateNewsletterPreferencesRequest) (*newsletter.UpdateNewsletterPreferencesResponse, error)
+	UnsubscribeNewsletter(ctx context.Context, request *newsletter.UnsubscribeNewsletterRequest) (*newsletter.UnsubscribeNewsletterResponse, error)
+	UnsubscribeByToken(ctx context.Context, token string) error
+	ListSubscribers(ctx context.Context, request *newsletter.ListSubscribersRequest) (*newsletter.ListSubscribersResponse, error)
+	RemoveSubscriber(ctx context.Context, request *newsletter.RemoveSubscriberRequest) (*newsletter.RemoveSubscriberResponse, error)
+	ExportSubscribers(ctx context.Context, search string, status string, w io.Writer) error
+	ImportSubscribers(ctx context.Context, r io.Reader) (*entity.NewsletterImportResult, error)
 }
 
 type newsletterService struct {
+	db                   *sql.DB
 	newsletterRepository repository.InewsLetterRepository
+	outboxRepository     repository.IOutboxRepository
 }
 
+// SubcribeNewsletter mencatat subscriber sebagai pending dan mengantrekan email konfirmasi (double opt-in).
+// Response selalu sama agar status email tidak bisa ditebak dari luar
 func (ns *newsletterService) SubcribeNewsletter(ctx context.Context, request *newsletter.SubcribeNewsletterRequest) (*newsletter.SubcribeNewsletterResponse, error) {
 
 	newsletterEntity, err := ns.newsletterRepository.GetNewsLetterByEmail(ctx, request.Email)
 	if err != nil {
 		return nil, err
 	}
-	if newsletterEntity != nil {
+	if newsletterEntity != nil && newsletterEntity.Status == entity.NewsletterStatusConfirmed {
 		return &newsletter.SubcribeNewsletterResponse{
 			Base: utils.SuccessResponse("Subcribe newsletter success"),
 		}, nil
 	}
 
-	newNewsletterEntity := entity.Newsletter{ // insert db
-		Id:        uuid.NewString(),
-		Fullname:  request.FullName,
-		Email:     request.Email,
-		CreatedAt: time.Now(),
-		CreatedBy: "Public",
+	tx, err := ns.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	newsletterRepo := ns.newsletterRepository.WithTrancastion(tx)
+	outboxRepo := ns.outboxRepository.WithTrancastion(tx)
+
+	now := time.Now()
+	// nama dan topik subscriber pending tidak diganti karena siapa pun bisa mendaftarkan email orang lain,
+	// topik bisa diubah setelah email dikonfirmasi
+	if newsletterEntity == nil {
+		topics := request.Topics
+		if len(topics) == 0 {
+			topics = entity.NewsletterTopics
+		}
+
+		newsletterEntity = &entity.Newsletter{ // insert db
+			Id:        uuid.NewString(),
+			Fullname:  request.FullName,
+			Email:     request.Email,
+			Status:    entity.NewsletterStatusPending,
+			Topics:    topics,
+			CreatedAt: now,
+			CreatedBy: "Public",
+		}
+
+		err = newsletterRepo.CreateNewNewsletter(ctx, newsletterEntity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// email konfirmasi dikirim ulang paling cepat setelah cooldown agar endpoint publik tidak dipakai untuk spam
+	requested, err := newsletterRepo.RequestNewsletterConfirmation(ctx, newsletterEntity.Id, now, now.Add(-newsletterConfirmResendCooldown))
+	if err != nil {
+		return nil, err
+	}
+	if requested {
+		var payload []byte
+		payload, err = json.Marshal(entity.NewsletterConfirmPayload{
+			NewsletterId: newsletterEntity.Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = outboxRepo.CreateOutbox(ctx, &entity.Outbox{
+			Id:            uuid.NewString(),
+			EventType:     entity.OutboxEventTypeNewsletterConfirm,
+			AggregateId:   newsletterEntity.Id,
+			Payload:       payload,
+			Status:        entity.OutboxStatusPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	err = ns.newsletterRepository.CreateNewNewsletter(ctx, &newNewsletterEntity)
+	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
+
 	return &newsletter.SubcribeNewsletterResponse{
 		Base: utils.SuccessResponse("Subcribe newsletter success"),
 	}, nil
 }
 
-func NewNewsLetterService(newsletterRepository repository.InewsLetterRepository) InewsLetterService {
+func (ns *newsletterService) ConfirmNewsletter(ctx context.Context, request *newsletter.ConfirmNewsletterRequest) (*newsletter.ConfirmNewsletterResponse, error) {
+	confirmed, err := ns.newsletterRepository.ConfirmNewsletter(ctx, hashNewsletterConfirmToken(request.Token), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !confirmed {
+		return &newsletter.ConfirmNewsletterResponse{
+			Base: utils.BadRequestResponse("Confirmation link is invalid or expired"),
+		}, nil
+	}
+
+	return &newsletter.ConfirmNewsletterResponse{
+		Base: utils.SuccessResponse("Confirm newsletter success"),
+	}, nil
+}
+
+func (ns *newsletterService) GetNewsletterSubscription(ctx context.Context, request *newsletter.GetNewsletterSubscriptionRequest) (*newsletter.GetNewsletterSubscriptionResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	newsletterEntity, err := ns.newsletterRepository.GetNewsLetterByEmail(ctx, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+	if newsletterEntity == nil {
+		return &newsletter.GetNewsletterSubscriptionResponse{
+			Base:            utils.SuccessResponse("Get newsletter subscription success"),
+			Status:          newsletterStatusNotSubscribed,
+			Topics:          make([]string, 0),
+			AvailableTopics: entity.NewsletterTopics,
+		}, nil
+	}
+
+	return &newsletter.GetNewsletterSubscriptionResponse{
+		Base:            utils.SuccessResponse("Get newsletter subscription success"),
+		Status:          newsletterEntity.Status,
+		Topics:          newsletterEntity.Topics,
+		AvailableTopics: entity.NewsletterTopics,
+	}, nil
+}
+
+func (ns *newsletterService) UpdateNewsletterPreferences(ctx context.Context, request *newsletter.UpdateNewsletterPreferencesRequest) (*newsletter.UpdateNewsletterPreferencesResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	newsletterEntity, err := ns.newsletterRepository.GetNewsLetterByEmail(ctx, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+	if newsletterEntity == nil {
+		return &newsletter.UpdateNewsletterPreferencesResponse{
+			Base: utils.NotFoundResponse("Newsletter subscription not found"),
+		}, nil
+	}
+
+	err = ns.newsletterRepository.UpdateNewsletterTopics(ctx, newsletterEntity.Id, request.Topics, time.Now(), claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newsletter.UpdateNewsletterPreferencesResponse{
+		Base: utils.SuccessResponse("Update newsletter preferences success"),
+	}, nil
+}
+
+func (ns *newsletterService) UnsubscribeNewsletter(ctx context.Context, request *newsletter.UnsubscribeNewsletterRequest) (*newsletter.UnsubscribeNewsletterResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	newsletterEntity, err := ns.newsletterRepository.GetNewsLetterByEmail(ctx, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+	if newsletterEntity == nil {
+		return &newsletter.UnsubscribeNewsletterResponse{
+			Base: utils.NotFoundResponse("Newsletter subscription not found"),
+		}, nil
+	}
+
+	_, err = ns.newsletterRepository.DeleteNewsletter(ctx, newsletterEntity.Id, time.Now(), claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newsletter.UnsubscribeNewsletterResponse{
+		Base: utils.SuccessResponse("Unsubscribe newsletter success"),
+	}, nil
+}
+
+// UnsubscribeByToken dipakai link unsubscribe di email, subscriber yang sudah berhenti dianggap berhasil
+func (ns *newsletterService) UnsubscribeByToken(ctx context.Context, token string) error {
+	newsletterId, err := utils.ParseSignedLinkToken(utils.SignedLinkPurposeNewsletterUnsubscribe, token)
+	if err != nil {
+		return err
+	}
+
+	_, err = ns.newsletterRepository.DeleteNewsletter(ctx, newsletterId, time.Now(), "Unsubscribe Link")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ns *newsletterService) ListSubscribers(ctx context.Context, request *newsletter.ListSubscribersRequest) (*newsletter.ListSubscribersResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	newsletters, paginationResponse, err := ns.newsletterRepository.GetNewslettersPagination(ctx, request.Pagination, strings.TrimSpace(request.Search), request.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*newsletter.ListSubscribersResponseItem, 0)
+	for _, n := range newsletters {
+		item := &newsletter.ListSubscribersResponseItem{
+			Id:        n.Id,
+			Email:     n.Email,
+			FullName:  n.Fullname,
+			Status:    n.Status,
+			Topics:    n.Topics,
+			CreatedAt: timestamppb.New(n.CreatedAt),
+		}
+		if n.ConfirmedAt != nil {
+			item.ConfirmedAt = timestamppb.New(*n.ConfirmedAt)
+		}
+
+		items = append(items, item)
+	}
+
+	return &newsletter.ListSubscribersResponse{
+		Base:       utils.SuccessResponse("Get list subscriber success"),
+		Pagination: paginationResponse,
+		Items:      items,
+	}, nil
+}
+
+func (ns *newsletterService) RemoveSubscriber(ctx context.Context, request *newsletter.RemoveSubscriberRequest) (*newsletter.RemoveSubscriberResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	deleted, err := ns.newsletterRepository.DeleteNewsletter(ctx, request.Id, time.Now(), claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return &newsletter.RemoveSubscriberResponse{
+			Base: utils.NotFoundResponse("Subscriber not found"),
+		}, nil
+	}
+
+	return &newsletter.RemoveSubscriberResponse{
+		Base: utils.SuccessResponse("Remove subscriber success"),
+	}, nil
+}
+
+// ExportSubscribers menulis csv subscriber aktif per baris ke w, format kolom sama dengan yang dibaca ImportSubscribers
+func (ns *newsletterService) ExportSubscribers(ctx context.Context, search string, status string, w io.Writer) error {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return utils.PermissionDeniedResponse()
+	}
+
+	csvWriter := csv.NewWriter(w)
+	err = csvWriter.Write(newsletterCsvHeader)
+	if err != nil {
+		return err
+	}
+
+	err = ns.newsletterRepository.StreamNewsletters(ctx, strings.TrimSpace(search), status, func(n *entity.Newsletter) error {
+		confirmedAt := ""
+		if n.ConfirmedAt != nil {
+			confirmedAt = n.ConfirmedAt.Format(time.RFC3339)
+		}
+
+		err := csvWriter.Write([]string{
+			n.Id,
+			csvSafe(n.Email),
+			csvSafe(n.Fullname),
+			n.Status,
+			strings.Join(n.Topics, newsletterCsvTopicSeparator),
+			confirmedAt,
+			n.CreatedAt.Format(time.RFC3339),
+		})
+		if err != nil {
+			return err
+		}
+
+		csvWriter.Flush()
+		return csvWriter.Error()
+	})
+	if err != nil {
+		return err
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// ImportSubscribers membaca csv dengan header email dan full_name (topics opsional).
+// Email yang sudah terdaftar atau muncul dua kali di file dilewati. Subscriber hasil import dianggap sudah memberi
+// persetujuan di luar sistem sehingga langsung berstatus confirmed
+func (ns *newsletterService) ImportSubscribers(ctx context.Context, r io.Reader) (*entity.NewsletterImportResult, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	csvReader := csv.NewReader(r)
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int)
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))] = i
+	}
+	emailColumn, hasEmail := columns["email"]
+	nameColumn, hasName := columns["full_name"]
+	if !hasEmail || !hasName {
+		return nil, ErrNewsletterImportHeader
+	}
+	topicsColumn, hasTopics := columns["topics"]
+
+	result := &entity.NewsletterImportResult{
+		Errors: make([]string, 0),
+	}
+	addError := func(line int, message string) {
+		result.Invalid++
+		if len(result.Errors) < newsletterImportMaxErrors {
+			result.Errors = append(result.Errors, fmt.Sprintf("line %d: %s", line, message))
+		}
+	}
+
+	seenEmails := make(map[string]bool)
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseError *csv.ParseError
+			if errors.As(err, &parseError) {
+				addError(parseError.StartLine, "malformed row")
+				continue
+			}
+			return nil, err
+		}
+		line, _ := csvReader.FieldPos(0)
+
+		email := strings.ToLower(strings.TrimSpace(csvField(record, emailColumn)))
+		fullName := strings.TrimSpace(csvField(record, nameColumn))
+		address, err := mail.ParseAddress(email)
+		if err != nil || address.Address != email || len(email) > 255 {
+			addError(line, "invalid email")
+			continue
+		}
+		if fullName == "" || len(fullName) > 255 {
+			addError(line, "invalid full_name")
+			continue
+		}
+
+		topics := entity.NewsletterTopics
+		if hasTopics && strings.TrimSpace(csvField(record, topicsColumn)) != "" {
+			topics, err = parseNewsletterTopics(csvField(record, topicsColumn))
+			if err != nil {
+				addError(line, err.Error())
+				continue
+			}
+		}
+
+		if seenEmails[email] {
+			result.Duplicate++
+			continue
+		}
+		seenEmails[email] = true
+
+		now := time.Now()
+		imported, err := ns.newsletterRepository.ImportNewsletter(ctx, &entity.Newsletter{
+			Id:          uuid.NewString(),
+			Fullname:    fullName,
+			Email:       email,
+			Status:      entity.NewsletterStatusConfirmed,
+			Topics:      topics,
+			ConfirmedAt: &now,
+			CreatedAt:   now,
+			CreatedBy:   claims.FullName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !imported {
+			result.Duplicate++
+			continue
+		}
+		result.Imported++
+	}
+
+	return result, nil
+}
+
+func parseNewsletterTopics(value string) ([]string, error) {
+	topics := make([]string, 0)
+	for _, topic := range strings.Split(value, newsletterCsvTopicSeparator) {
+		topic = strings.ToLower(strings.TrimSpace(topic))
+		if topic == "" || slices.Contains(topics, topic) {
+			continue
+		}
+		if !slices.Contains(entity.NewsletterTopics, topic) {
+			return nil, fmt.Errorf("unknown topic %s", topic)
+		}
+		topics = append(topics, topic)
+	}
+
+	return topics, nil
+}
+
+func csvField(record []string, index int) string {
+	if index >= len(record) {
+		return ""
+	}
+
+	return record[index]
+}
+
+// csvSafe mencegah formula injection saat file export dibuka di spreadsheet
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+// newNewsletterConfirmToken membuat token acak untuk link konfirmasi, yang disimpan hanya hash-nya
+func newNewsletterConfirmToken() (string, string, error) {
+	randomBytes := make([]byte, 32)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", "", err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(randomBytes)
+	return token, hashNewsletterConfirmToken(token), nil
+}
+
+func hashNewsletterConfirmToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func newsletterUnsubscribeUrl(newsletterId string) string {
+	return fmt.Sprintf(
+		"%s/newsletter/unsubscribe?token=%s",
+		os.Getenv("REST_BASE_URL"),
+		url.QueryEscape(utils.NewSignedLinkToken(utils.SignedLinkPurposeNewsletterUnsubscribe, newsletterId)),
+	)
+}
+
+func newsletterConfirmMessage(newsletterEntity *entity.Newsletter, confirmToken string) *mailer.Message {
+	confirmUrl := fmt.Sprintf("%s/newsletter/confirm?token=%s", os.Getenv("REST_BASE_URL"), url.QueryEscape(confirmToken))
+	unsubscribeUrl := newsletterUnsubscribeUrl(newsletterEntity.Id)
+	text := fmt.Sprintf("Hi %s,\n\nPlease confirm your newsletter subscription by opening the link below.", newsletterEntity.Fullname)
+
+	return &mailer.Message{
+		To:       newsletterEntity.Email,
+		ToName:   newsletterEntity.Fullname,
+		Subject:  "Confirm your newsletter subscription",
+		TextBody: fmt.Sprintf("%s\n\n%s\n\nIf you did not subscribe, ignore this email or unsubscribe: %s\n", text, confirmUrl, unsubscribeUrl),
+		HTMLBody: fmt.Sprintf(
+			"<p>%s</p><p><a href=\"%s\">Confirm subscription</a></p><p><a href=\"%s\">Unsubscribe</a></p>",
+			html.EscapeString(text),
+			html.EscapeString(confirmUrl),
+			html.EscapeString(unsubscribeUrl),
+		),
+		Headers: map[string]string{
+			"List-Unsubscribe":      fmt.Sprintf("<%s>", unsubscribeUrl),
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+	}
+}
+
+func NewNewsLetterService(db *sql.DB, newsletterRepository repository.InewsLetterRepository, outboxRepository repository.IOutboxRepository) InewsLetterService {
 	return &newsletterService{
+		db:                   db,
 		newsletterRepository: newsletterRepository,
+		outboxRepository:     outboxRepository,
 	}
 }
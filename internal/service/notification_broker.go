@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+)
+
+// jumlah notifikasi yang bisa antri per subscriber sebelum notifikasi baru dibuang
+const notificationSubscriberBuffer = 16
+
+type INotificationBroker interface {
+	Publish(ctx context.Context, notification *entity.UserNotification) error
+	Subscribe(userId string) (<-chan *entity.UserNotification, func())
+}
+
+// notificationBroker meneruskan notifikasi ke stream yang sedang terbuka di proses ini saja,
+// untuk lebih dari satu instance server grpc gunakan NewPostgresNotificationBroker.
+// Notifikasi yang terlewat tetap tersimpan di database dan bisa diambil lewat ListNotifications
+type notificationBroker struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan *entity.UserNotification]struct{}
+}
+
+// Publish tidak pernah blocking, subscriber yang lambat akan kehilangan notifikasi
+func (nb *notificationBroker) Publish(ctx context.Context, notification *entity.UserNotification) error {
+	nb.mu.RLock()
+	defer nb.mu.RUnlock()
+
+	for ch := range nb.subscribers[notification.UserId] {
+		select {
+		case ch <- notification:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// Subscribe mengembalikan channel notifikasi user dan fungsi untuk berhenti berlangganan
+func (nb *notificationBroker) Subscribe(userId string) (<-chan *entity.UserNotification, func()) {
+	ch := make(chan *entity.UserNotification, notificationSubscriberBuffer)
+
+	nb.mu.Lock()
+	if nb.subscribers[userId] == nil {
+		nb.subscribers[userId] = make(map[chan *entity.UserNotification]struct{})
+	}
+	nb.subscribers[userId][ch] = struct{}{}
+	nb.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			nb.mu.Lock()
+			defer nb.mu.Unlock()
+
+			delete(nb.subscribers[userId], ch)
+			if len(nb.subscribers[userId]) == 0 {
+				delete(nb.subscribers, userId)
+			}
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+func NewNotificationBroker() INotificationBroker {
+	return &notificationBroker{
+		subscribers: make(map[string]map[chan *entity.UserNotification]struct{}),
+	}
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+)
+
+const (
+	notificationChannel              = "user_notification"
+	notificationListenerMinReconnect = 10 * time.Second
+	notificationListenerMaxReconnect = time.Minute
+	notificationListenerPingInterval = 90 * time.Second
+)
+
+type IPostgresNotificationBroker interface {
+	INotificationBroker
+	Run(ctx context.Context)
+}
+
+// postgresNotificationBroker mengirim notifikasi ke semua instance server grpc lewat LISTEN/NOTIFY postgres.
+// Setiap instance menerima semua notifikasi lalu meneruskannya ke stream yang terbuka di instance tersebut
+type postgresNotificationBroker struct {
+	db       *sql.DB
+	listener *pq.Listener
+	local    INotificationBroker
+}
+
+// Publish dikirim lewat pg_notify, payload dibatasi 8000 byte oleh postgres sehingga hanya cocok untuk notifikasi pendek
+func (pb *postgresNotificationBroker) Publish(ctx context.Context, notification *entity.UserNotification) error {
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	_, err = pb.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", notificationChannel, string(payload))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (pb *postgresNotificationBroker) Subscribe(userId string) (<-chan *entity.UserNotification, func()) {
+	return pb.local.Subscribe(userId)
+}
+
+// Run mendengarkan channel notifikasi sampai ctx dibatalkan
+func (pb *postgresNotificationBroker) Run(ctx context.Context) {
+	defer pb.listener.Close()
+
+	err := pb.listener.Listen(notificationChannel)
+	if err != nil {
+		slog.ErrorContext(ctx, "listen notification channel failed", "error", err)
+		return
+	}
+
+	ticker := time.NewTicker(notificationListenerPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// ping mendeteksi koneksi yang putus tanpa error agar listener tersambung ulang
+			go pb.listener.Ping()
+		case message := <-pb.listener.Notify:
+			// nil dikirim setelah koneksi tersambung ulang, notifikasi selama terputus tetap ada di ListNotifications
+			if message == nil {
+				continue
+			}
+
+			var notification entity.UserNotification
+			err = json.Unmarshal([]byte(message.Extra), &notification)
+			if err != nil {
+				slog.WarnContext(ctx, "invalid notification payload", "error", err)
+				continue
+			}
+
+			err = pb.local.Publish(ctx, &notification)
+			if err != nil {
+				slog.WarnContext(ctx, "publish notification to local subscribers failed", "notification_id", notification.Id, "error", err)
+			}
+		}
+	}
+}
+
+// NewPostgresNotificationBroker membuka koneksi listener terpisah dari pool db memakai connection string yang sama
+func NewPostgresNotificationBroker(db *sql.DB, connstr string) IPostgresNotificationBroker {
+	listener := pq.NewListener(connstr, notificationListenerMinReconnect, notificationListenerMaxReconnect, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			slog.Warn("notification listener connection error", "event", event, "error", err)
+		}
+	})
+
+	return &postgresNotificationBroker{
+		db:       db,
+		listener: listener,
+		local:    NewNotificationBroker(),
+	}
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	jwtentity "github.com/luzmareto/go-grpc-ecommerce-be/internal/entity/jwt"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/notification"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type INotificationService interface {
+	ListNotifications(ctx context.Context, request *notification.ListNotificationsRequest) (*notification.ListNotificationsResponse, error)
+	MarkRead(ctx context.Context, request *notification.MarkReadRequest) (*notification.MarkReadResponse, error)
+	Subscribe(request *notification.SubscribeRequest, stream notification.NotificationService_SubscribeServer) error
+}
+
+// interval pengecekan token logout untuk stream notifikasi yang sedang terbuka
+const notificationStreamRevocationCheckInterval = time.Minute
+
+type notificationService struct {
+	notificationRepository repository.INotificationRepository
+	notificationBroker     INotificationBroker
+	tokenRevocationService ITokenRevocationService
+}
+
+func (ns *notificationService) ListNotifications(ctx context.Context, request *notification.ListNotificationsRequest) (*notification.ListNotificationsResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, paginationResponse, err := ns.notificationRepository.GetNotificationsPagination(ctx, request.Pagination, claims.Subject, request.UnreadOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	unreadCount, err := ns.notificationRepository.CountUnreadNotifications(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*notification.NotificationItem, 0)
+	for _, notificationEntity := range notifications {
+		items = append(items, newNotificationItem(notificationEntity))
+	}
+
+	return &notification.ListNotificationsResponse{
+		Base:        utils.SuccessResponse("Get notification list success"),
+		Pagination:  paginationResponse,
+		Items:       items,
+		UnreadCount: unreadCount,
+	}, nil
+}
+
+func (ns *notificationService) MarkRead(ctx context.Context, request *notification.MarkReadRequest) (*notification.MarkReadResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !request.All && len(request.Ids) == 0 {
+		return &notification.MarkReadResponse{
+			Base: utils.BadRequestResponse("Ids is required when all is false"),
+		}, nil
+	}
+
+	now := time.Now()
+	if request.All {
+		err = ns.notificationRepository.MarkAllNotificationsRead(ctx, claims.Subject, now)
+	} else {
+		err = ns.notificationRepository.MarkNotificationsRead(ctx, claims.Subject, request.Ids, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	unreadCount, err := ns.notificationRepository.CountUnreadNotifications(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notification.MarkReadResponse{
+		Base:        utils.SuccessResponse("Mark notification as read success"),
+		UnreadCount: unreadCount,
+	}, nil
+}
+
+// Subscribe mengirim jumlah notifikasi belum dibaca lalu setiap notifikasi baru sampai client menutup stream.
+// Stream ditutup dengan Unauthenticated saat token kedaluwarsa atau sudah logout
+func (ns *notificationService) Subscribe(request *notification.SubscribeRequest, stream notification.NotificationService_SubscribeServer) error {
+	ctx := stream.Context()
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	tokenStr, err := jwtentity.ParseTokenFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var tokenExpired <-chan time.Time
+	if claims.ExpiresAt != nil {
+		expiryTimer := time.NewTimer(time.Until(claims.ExpiresAt.Time))
+		defer expiryTimer.Stop()
+		tokenExpired = expiryTimer.C
+	}
+	revocationTicker := time.NewTicker(notificationStreamRevocationCheckInterval)
+	defer revocationTicker.Stop()
+
+	// subscribe sebelum menghitung unread agar notifikasi di antaranya tidak terlewat
+	notifications, unsubscribe := ns.notificationBroker.Subscribe(claims.Subject)
+	defer unsubscribe()
+
+	unreadCount, err := ns.notificationRepository.CountUnreadNotifications(ctx, claims.Subject)
+	if err != nil {
+		return err
+	}
+	err = stream.Send(&notification.SubscribeResponse{
+		UnreadCount: unreadCount,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tokenExpired:
+			return utils.UnauthenticatedResponse()
+		case <-revocationTicker.C:
+			revoked, err := ns.tokenRevocationService.IsRevoked(ctx, tokenStr)
+			if err != nil {
+				return err
+			}
+			if revoked {
+				return utils.UnauthenticatedResponse()
+			}
+		case notificationEntity := <-notifications:
+			unreadCount, err = ns.notificationRepository.CountUnreadNotifications(ctx, claims.Subject)
+			if err != nil {
+				return err
+			}
+
+			err = stream.Send(&notification.SubscribeResponse{
+				Notification: newNotificationItem(notificationEntity),
+				UnreadCount:  unreadCount,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func newNotificationItem(notificationEntity *entity.UserNotification) *notification.NotificationItem {
+	item := &notification.NotificationItem{
+		Id:        notificationEntity.Id,
+		Type:      notificationEntity.Type,
+		Event:     notificationEntity.Event,
+		Title:     notificationEntity.Title,
+		Body:      notificationEntity.Body,
+		IsRead:    notificationEntity.ReadAt != nil,
+		CreatedAt: timestamppb.New(notificationEntity.CreatedAt),
+	}
+	if notificationEntity.OrderId != nil {
+		item.OrderId = *notificationEntity.OrderId
+	}
+	if notificationEntity.OrderStatusCode != nil {
+		item.OrderStatusCode = *notificationEntity.OrderStatusCode
+	}
+
+	return item
+}
+
+func NewNotificationService(notificationRepository repository.INotificationRepository, notificationBroker INotificationBroker, tokenRevocationService ITokenRevocationService) INotificationService {
+	return &notificationService{
+		notificationRepository: notificationRepository,
+		notificationBroker:     notificationBroker,
+		tokenRevocationService: tokenRevocationService,
+	}
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/mailer"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/mailtemplate"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+)
+
+const (
+	orderEmailDispatcherInterval    = 5 * time.Second
+	orderEmailDispatcherBatchSize   = 10
+	orderEmailDispatcherLease       = 5 * time.Minute
+	orderEmailDispatcherMaxAttempts = 8
+	orderEmailDispatcherBaseBackoff = 30 * time.Second
+	orderEmailDispatcherMaxBackoff  = time.Hour
+)
+
+// template email untuk setiap event order, folder templates/order/<locale>
+var orderEmailTemplates = map[string]string{
+	entity.OrderEventCreated:  "order_confirmation",
+	entity.OrderEventPaid:     "payment_receipt",
+	entity.OrderEventShipped:  "shipping_notice",
+	entity.OrderEventCanceled: "order_canceled",
+}
+
+type IOrderEmailDispatcher interface {
+	Run(ctx context.Context)
+	DispatchPending(ctx context.Context) error
+}
+
+type orderEmailDispatcher struct {
+	orderRepository  repository.IOrderRepository
+	authRepository   repository.IAuthRepository
+	outboxRepository repository.IOutboxRepository
+	mailer           mailer.IMailer
+}
+
+// Run memproses outbox order_email secara berkala sampai ctx dibatalkan
+func (od *orderEmailDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(orderEmailDispatcherInterval)
+	defer ticker.Stop()
+
+	for {
+		err := od.DispatchPending(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "order email dispatcher error", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (od *orderEmailDispatcher) DispatchPending(ctx context.Context) error {
+	outboxes, err := od.outboxRepository.ClaimPendingOutbox(
+		ctx,
+		entity.OutboxEventTypeOrderEmail,
+		orderEmailDispatcherBatchSize,
+		time.Now().Add(orderEmailDispatcherLease),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, outbox := range outboxes {
+		err = od.dispatch(ctx, outbox)
+		if err == nil {
+			err = od.outboxRepository.MarkOutboxDone(ctx, outbox.Id)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		slog.WarnContext(ctx, "send order email failed", "order_id", outbox.AggregateId, "attempt", outbox.AttemptCount, "error", err)
+		if outbox.AttemptCount >= orderEmailDispatcherMaxAttempts {
+			err = od.outboxRepository.MarkOutboxFailed(ctx, outbox.Id, err.Error())
+		} else {
+			err = od.outboxRepository.MarkOutboxRetry(ctx, outbox.Id, time.Now().Add(orderEmailBackoff(outbox.AttemptCount)), err.Error())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (od *orderEmailDispatcher) dispatch(ctx context.Context, outbox *entity.Outbox) error {
+	var payload entity.OrderEventPayload
+	err := json.Unmarshal(outbox.Payload, &payload)
+	if err != nil {
+		return err
+	}
+
+	// tidak semua event dikirim lewat email, contoh order_expired cukup lewat notifikasi in-app
+	templateName, ok := orderEmailTemplates[payload.Event]
+	if !ok {
+		return nil
+	}
+
+	orderEntity, err := od.orderRepository.GetOrderById(ctx, payload.OrderId)
+	if err != nil {
+		return err
+	}
+	if orderEntity == nil {
+		return errors.New("order not found")
+	}
+
+	user, err := od.authRepository.GetUserById(ctx, orderEntity.UserId)
+	if err != nil {
+		return err
+	}
+	// akun sudah dihapus, tidak ada alamat email tujuan
+	if user == nil {
+		slog.WarnContext(ctx, "user of order not found, skipping email", "user_id", orderEntity.UserId, "order_id", orderEntity.Id, "event", payload.Event)
+		return nil
+	}
+
+	locale := ""
+	if orderEntity.Locale != nil {
+		locale = *orderEntity.Locale
+	}
+	rendered, err := mailtemplate.RenderLocalized("order", templateName, locale, newOrderEmailTemplateData(orderEntity))
+	if err != nil {
+		return err
+	}
+
+	return od.mailer.Send(ctx, &mailer.Message{
+		To:       user.Email,
+		ToName:   user.FullName,
+		Subject:  rendered.Subject,
+		TextBody: rendered.Text,
+		HTMLBody: rendered.HTML,
+	})
+}
+
+type orderEmailTemplateItem struct {
+	Name      string
+	Quantity  int64
+	UnitPrice string
+	Subtotal  string
+}
+
+type orderEmailTemplateData struct {
+	CustomerName    string
+	OrderNumber     string
+	Address         string
+	Items           []orderEmailTemplateItem
+	Subtotal        string
+	Discount        string
+	ShippingCost    string
+	TaxName         string
+	Tax             string
+	Total           string
+	PaidAmount      string
+	PaidAt          string
+	PaymentMethod   string
+	TrackingCourier string
+	TrackingNumber  string
+}
+
+// newOrderEmailTemplateData menyiapkan data template, nominal sudah diformat sesuai mata uang order
+func newOrderEmailTemplateData(orderEntity *entity.Order) *orderEmailTemplateData {
+	data := &orderEmailTemplateData{
+		CustomerName: orderEntity.UserFullName,
+		OrderNumber:  orderEntity.Number,
+		Address:      orderEntity.Address,
+		Items:        make([]orderEmailTemplateItem, 0),
+		Subtotal:     money.New(orderEntity.Subtotal, orderEntity.Currency).String(),
+		ShippingCost: money.New(orderEntity.ShippingCost, orderEntity.Currency).String(),
+		Total:        money.New(orderEntity.Total, orderEntity.Currency).String(),
+	}
+	for _, item := range orderEntity.Items {
+		data.Items = append(data.Items, orderEmailTemplateItem{
+			Name:      item.ProductName,
+			Quantity:  item.Quantity,
+			UnitPrice: money.New(item.ProductPrice, orderEntity.Currency).String(),
+			Subtotal:  money.New(item.ProductPrice, orderEntity.Currency).Mul(item.Quantity).String(),
+		})
+	}
+	if orderEntity.DiscountAmount > 0 {
+		data.Discount = money.New(orderEntity.DiscountAmount, orderEntity.Currency).String()
+	}
+	// pajak inclusive sudah termasuk di harga produk sehingga tidak ditampilkan terpisah
+	if !orderEntity.TaxInclusive && orderEntity.TaxAmount > 0 {
+		data.Tax = money.New(orderEntity.TaxAmount, orderEntity.Currency).String()
+		if orderEntity.TaxName != nil {
+			data.TaxName = *orderEntity.TaxName
+		}
+	}
+	if orderEntity.XenditPaidAmount != nil {
+		data.PaidAmount = money.New(*orderEntity.XenditPaidAmount, orderEntity.Currency).String()
+	} else {
+		data.PaidAmount = data.Total
+	}
+	if orderEntity.XenditPaidAt != nil {
+		data.PaidAt = orderEntity.XenditPaidAt.Format("02 Jan 2006 15:04 MST")
+	}
+	paymentMethods := make([]string, 0)
+	for _, value := range []*string{orderEntity.XenditPaymentMethod, orderEntity.XenditPaymentChannel} {
+		if value != nil && *value != "" {
+			paymentMethods = append(paymentMethods, *value)
+		}
+	}
+	data.PaymentMethod = strings.Join(paymentMethods, " - ")
+	if orderEntity.TrackingCourier != nil {
+		data.TrackingCourier = *orderEntity.TrackingCourier
+	}
+	if orderEntity.TrackingNumber != nil {
+		data.TrackingNumber = *orderEntity.TrackingNumber
+	}
+
+	return data
+}
+
+func orderEmailBackoff(attempt int) time.Duration {
+	backoff := orderEmailDispatcherBaseBackoff
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if backoff >= orderEmailDispatcherMaxBackoff {
+			return orderEmailDispatcherMaxBackoff
+		}
+	}
+
+	return backoff
+}
+
+func NewOrderEmailDispatcher(orderRepository repository.IOrderRepository, authRepository repository.IAuthRepository, outboxRepository repository.IOutboxRepository, mailer mailer.IMailer) IOrderEmailDispatcher {
+	return &orderEmailDispatcher{
+		orderRepository:  orderRepository,
+		authRepository:   authRepository,
+		outboxRepository: outboxRepository,
+		mailer:           mailer,
+	}
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/mailer"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+)
+
+type fakeEmailOutboxRepository struct {
+	repository.IOutboxRepository
+	outboxes []*entity.Outbox
+	done     []string
+	retried  map[string]time.Time
+	failed   []string
+}
+
+func (fr *fakeEmailOutboxRepository) ClaimPendingOutbox(ctx context.Context, eventType string, limit int, leaseUntil time.Time) ([]*entity.Outbox, error) {
+	outboxes := fr.outboxes
+	fr.outboxes = nil
+	return outboxes, nil
+}
+
+func (fr *fakeEmailOutboxRepository) MarkOutboxDone(ctx context.Context, id string) error {
+	fr.done = append(fr.done, id)
+	return nil
+}
+
+func (fr *fakeEmailOutboxRepository) MarkOutboxRetry(ctx context.Context, id string, nextAttemptAt time.Time, lastError string) error {
+	if fr.retried == nil {
+		fr.retried = make(map[string]time.Time)
+	}
+	fr.retried[id] = nextAttemptAt
+	return nil
+}
+
+func (fr *fakeEmailOutboxRepository) MarkOutboxFailed(ctx context.Context, id string, lastError string) error {
+	fr.failed = append(fr.failed, id)
+	return nil
+}
+
+type fakeEmailOrderRepository struct {
+	repository.IOrderRepository
+	orders map[string]*entity.Order
+}
+
+func (fr *fakeEmailOrderRepository) GetOrderById(ctx context.Context, orderId string) (*entity.Order, error) {
+	return fr.orders[orderId], nil
+}
+
+type fakeEmailAuthRepository struct {
+	repository.IAuthRepository
+	users map[string]*entity.User
+}
+
+func (fr *fakeEmailAuthRepository) GetUserById(ctx context.Context, id string) (*entity.User, error) {
+	return fr.users[id], nil
+}
+
+type sentEmail struct {
+	To      string
+	Subject string
+	Text    string
+}
+
+// readSentEmails membaca semua file .eml yang ditulis file mailer
+func readSentEmails(t *testing.T, dir string) []sentEmail {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	emails := make([]sentEmail, 0, len(files))
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		message, err := mail.ReadMessage(strings.NewReader(string(content)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		email := sentEmail{To: message.Header.Get("To"), Subject: subject}
+		reader := multipart.NewReader(message.Body, params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			// multipart.Reader sudah mendecode quoted-printable
+			body, err := io.ReadAll(part)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.HasPrefix(part.Header.Get("Content-Type"), "text/plain") {
+				email.Text = string(body)
+			}
+		}
+		emails = append(emails, email)
+	}
+
+	return emails
+}
+
+func newOrderEmailOutbox(t *testing.T, id string, orderId string, event string, attemptCount int) *entity.Outbox {
+	t.Helper()
+
+	payload, err := json.Marshal(entity.OrderEventPayload{OrderId: orderId, Event: event, OccurredAt: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &entity.Outbox{
+		Id:           id,
+		EventType:    entity.OutboxEventTypeOrderEmail,
+		AggregateId:  orderId,
+		Payload:      payload,
+		AttemptCount: attemptCount,
+	}
+}
+
+func newOrderEmailFixture(locale *string) (*fakeEmailOrderRepository, *fakeEmailAuthRepository) {
+	orderRepository := &fakeEmailOrderRepository{orders: map[string]*entity.Order{
+		"order-1": {
+			Id:           "order-1",
+			Number:       "ORD-0001",
+			UserId:       "user-1",
+			UserFullName: "Budi",
+			Address:      "Jl. Merdeka 1",
+			Currency:     "IDR",
+			Subtotal:     100000,
+			Total:        100000,
+			Locale:       locale,
+		},
+	}}
+	authRepository := &fakeEmailAuthRepository{users: map[string]*entity.User{
+		"user-1": {Id: "user-1", FullName: "Budi", Email: "budi@example.com"},
+	}}
+
+	return orderRepository, authRepository
+}
+
+func TestOrderEmailDispatcherLocale(t *testing.T) {
+	localeOf := func(value string) *string {
+		return &value
+	}
+
+	tests := []struct {
+		name          string
+		locale        *string
+		defaultLocale string
+		wantSubject   string
+		wantText      string
+	}{
+		{
+			name:        "indonesian order",
+			locale:      localeOf("id"),
+			wantSubject: "Pesanan ORD-0001 diterima",
+			wantText:    "Terima kasih atas pesanan Anda",
+		},
+		{
+			name:        "english order",
+			locale:      localeOf("en"),
+			wantSubject: "Order ORD-0001 received",
+			wantText:    "Thank you for your order",
+		},
+		{
+			name:        "order without locale uses english fallback",
+			locale:      nil,
+			wantSubject: "Order ORD-0001 received",
+			wantText:    "Thank you for your order",
+		},
+		{
+			name:          "unsupported locale uses mail default locale",
+			locale:        localeOf("fr"),
+			defaultLocale: "id",
+			wantSubject:   "Pesanan ORD-0001 diterima",
+			wantText:      "Terima kasih atas pesanan Anda",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("MAIL_DEFAULT_LOCALE", tt.defaultLocale)
+
+			dir := t.TempDir()
+			orderRepository, authRepository := newOrderEmailFixture(tt.locale)
+			outboxRepository := &fakeEmailOutboxRepository{outboxes: []*entity.Outbox{
+				newOrderEmailOutbox(t, "outbox-1", "order-1", entity.OrderEventCreated, 1),
+			}}
+			dispatcher := NewOrderEmailDispatcher(orderRepository, authRepository, outboxRepository, mailer.NewFileMailer(dir, "shop@example.com"))
+
+			err := dispatcher.DispatchPending(context.Background())
+			if err != nil {
+				t.Fatalf("DispatchPending() error = %v", err)
+			}
+
+			if len(outboxRepository.done) != 1 || outboxRepository.done[0] != "outbox-1" {
+				t.Fatalf("done = %v, want [outbox-1]", outboxRepository.done)
+			}
+			emails := readSentEmails(t, dir)
+			if len(emails) != 1 {
+				t.Fatalf("sent %d emails, want 1", len(emails))
+			}
+			if !strings.Contains(emails[0].To, "budi@example.com") {
+				t.Errorf("To = %q, want budi@example.com", emails[0].To)
+			}
+			if emails[0].Subject != tt.wantSubject {
+				t.Errorf("Subject = %q, want %q", emails[0].Subject, tt.wantSubject)
+			}
+			if !strings.Contains(emails[0].Text, tt.wantText) {
+				t.Errorf("Text = %q, want contains %q", emails[0].Text, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestOrderEmailDispatcherSkipsEventWithoutTemplate(t *testing.T) {
+	dir := t.TempDir()
+	orderRepository, authRepository := newOrderEmailFixture(nil)
+	outboxRepository := &fakeEmailOutboxRepository{outboxes: []*entity.Outbox{
+		newOrderEmailOutbox(t, "outbox-1", "order-1", entity.OrderEventExpired, 1),
+	}}
+	dispatcher := NewOrderEmailDispatcher(orderRepository, authRepository, outboxRepository, mailer.NewFileMailer(dir, "shop@example.com"))
+
+	err := dispatcher.DispatchPending(context.Background())
+	if err != nil {
+		t.Fatalf("DispatchPending() error = %v", err)
+	}
+
+	if len(outboxRepository.done) != 1 {
+		t.Fatalf("done = %v, want [outbox-1]", outboxRepository.done)
+	}
+	if emails := readSentEmails(t, dir); len(emails) != 0 {
+		t.Fatalf("sent %d emails, want 0", len(emails))
+	}
+}
+
+func TestOrderEmailDispatcherRetry(t *testing.T) {
+	// dir berada di bawah file biasa sehingga file mailer selalu gagal menulis
+	blocker := filepath.Join(t.TempDir(), "blocker")
+	err := os.WriteFile(blocker, nil, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	brokenMailer := mailer.NewFileMailer(filepath.Join(blocker, "mail"), "shop@example.com")
+
+	tests := []struct {
+		name         string
+		attemptCount int
+		wantRetry    bool
+		wantBackoff  time.Duration
+	}{
+		{name: "first attempt", attemptCount: 1, wantRetry: true, wantBackoff: 30 * time.Second},
+		{name: "third attempt", attemptCount: 3, wantRetry: true, wantBackoff: 2 * time.Minute},
+		{name: "last retry", attemptCount: orderEmailDispatcherMaxAttempts - 1, wantRetry: true, wantBackoff: 32 * time.Minute},
+		{name: "max attempts", attemptCount: orderEmailDispatcherMaxAttempts, wantRetry: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderRepository, authRepository := newOrderEmailFixture(nil)
+			outboxRepository := &fakeEmailOutboxRepository{outboxes: []*entity.Outbox{
+				newOrderEmailOutbox(t, "outbox-1", "order-1", entity.OrderEventPaid, tt.attemptCount),
+			}}
+			dispatcher := NewOrderEmailDispatcher(orderRepository, authRepository, outboxRepository, brokenMailer)
+
+			before := time.Now()
+			err := dispatcher.DispatchPending(context.Background())
+			if err != nil {
+				t.Fatalf("DispatchPending() error = %v", err)
+			}
+			after := time.Now()
+
+			if len(outboxRepository.done) != 0 {
+				t.Fatalf("done = %v, want none", outboxRepository.done)
+			}
+			if !tt.wantRetry {
+				if len(outboxRepository.failed) != 1 || len(outboxRepository.retried) != 0 {
+					t.Fatalf("failed = %v, retried = %v, want failed only", outboxRepository.failed, outboxRepository.retried)
+				}
+				return
+			}
+
+			nextAttemptAt, ok := outboxRepository.retried["outbox-1"]
+			if !ok || len(outboxRepository.failed) != 0 {
+				t.Fatalf("failed = %v, retried = %v, want retried only", outboxRepository.failed, outboxRepository.retried)
+			}
+			if nextAttemptAt.Before(before.Add(tt.wantBackoff)) || nextAttemptAt.After(after.Add(tt.wantBackoff)) {
+				t.Errorf("next attempt in %v, want %v", nextAttemptAt.Sub(before), tt.wantBackoff)
+			}
+		})
+	}
+}
+
+func TestOrderEmailBackoff(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 0, want: 30 * time.Second},
+		{attempt: 1, want: 30 * time.Second},
+		{attempt: 2, want: time.Minute},
+		{attempt: 5, want: 8 * time.Minute},
+		{attempt: 8, want: time.Hour},
+		{attempt: 20, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		got := orderEmailBackoff(tt.attempt)
+		if got != tt.want {
+			t.Errorf("orderEmailBackoff(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
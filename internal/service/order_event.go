@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+)
+
+// consumer domain event order, setiap consumer mendapat outbox sendiri agar retry-nya tidak saling menunggu
+var orderEventOutboxTypes = []string{
+	entity.OutboxEventTypeOrderEmail,
+	entity.OutboxEventTypeOrderNotification,
+}
+
+// publishOrderEvent menulis domain event order ke outbox, outboxRepo harus memakai transaksi yang sama dengan update order
+func publishOrderEvent(ctx context.Context, outboxRepo repository.IOutboxRepository, orderId string, event string, occurredAt time.Time) error {
+	payload, err := json.Marshal(entity.OrderEventPayload{
+		OrderId:    orderId,
+		Event:      event,
+		OccurredAt: occurredAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, eventType := range orderEventOutboxTypes {
+		err = outboxRepo.CreateOutbox(ctx, &entity.Outbox{
+			Id:            uuid.NewString(),
+			EventType:     eventType,
+			AggregateId:   orderId,
+			Payload:       payload,
+			Status:        entity.OutboxStatusPending,
+			NextAttemptAt: occurredAt,
+			CreatedAt:     occurredAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/mailtemplate"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+)
+
+const (
+	orderNotificationDispatcherInterval    = 2 * time.Second
+	orderNotificationDispatcherBatchSize   = 50
+	orderNotificationDispatcherLease       = time.Minute
+	orderNotificationDispatcherMaxAttempts = 5
+	orderNotificationDispatcherBackoff     = 30 * time.Second
+)
+
+type orderNotificationMessage struct {
+	Title string
+	Body  string
+}
+
+// judul dan isi notifikasi untuk setiap event order per bahasa, %s diisi nomor order
+var orderNotificationMessages = map[string]map[string]orderNotificationMessage{
+	"en": {
+		entity.OrderEventCreated:       {"Order placed", "Your order %s has been placed and is waiting for payment."},
+		entity.OrderEventPaid:          {"Payment received", "Payment for order %s has been received."},
+		entity.OrderEventPaymentReview: {"Payment under review", "Payment for order %s is being reviewed by our team."},
+		entity.OrderEventShipped:       {"Order shipped", "Your order %s is on its way."},
+		entity.OrderEventCompleted:     {"Order completed", "Your order %s has been completed."},
+		entity.OrderEventCanceled:      {"Order canceled", "Your order %s has been canceled."},
+		entity.OrderEventExpired:       {"Order expired", "Payment for order %s has expired."},
+	},
+	"id": {
+		entity.OrderEventCreated:       {"Pesanan dibuat", "Pesanan %s berhasil dibuat dan menunggu pembayaran."},
+		entity.OrderEventPaid:          {"Pembayaran diterima", "Pembayaran untuk pesanan %s sudah kami terima."},
+		entity.OrderEventPaymentReview: {"Pembayaran sedang dicek", "Pembayaran untuk pesanan %s sedang dicek oleh tim kami."},
+		entity.OrderEventShipped:       {"Pesanan dikirim", "Pesanan %s sedang dalam perjalanan."},
+		entity.OrderEventCompleted:     {"Pesanan selesai", "Pesanan %s telah selesai."},
+		entity.OrderEventCanceled:      {"Pesanan dibatalkan", "Pesanan %s telah dibatalkan."},
+		entity.OrderEventExpired:       {"Pesanan kedaluwarsa", "Batas waktu pembayaran pesanan %s telah habis."},
+	},
+}
+
+type IOrderNotificationDispatcher interface {
+	Run(ctx context.Context)
+	DispatchPending(ctx context.Context) error
+}
+
+type orderNotificationDispatcher struct {
+	orderRepository        repository.IOrderRepository
+	notificationRepository repository.INotificationRepository
+	outboxRepository       repository.IOutboxRepository
+	notificationBroker     INotificationBroker
+}
+
+// Run memproses outbox order_notification secara berkala sampai ctx dibatalkan
+func (nd *orderNotificationDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(orderNotificationDispatcherInterval)
+	defer ticker.Stop()
+
+	for {
+		err := nd.DispatchPending(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "order notification dispatcher error", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (nd *orderNotificationDispatcher) DispatchPending(ctx context.Context) error {
+	outboxes, err := nd.outboxRepository.ClaimPendingOutbox(
+		ctx,
+		entity.OutboxEventTypeOrderNotification,
+		orderNotificationDispatcherBatchSize,
+		time.Now().Add(orderNotificationDispatcherLease),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, outbox := range outboxes {
+		err = nd.dispatch(ctx, outbox)
+		if err == nil {
+			err = nd.outboxRepository.MarkOutboxDone(ctx, outbox.Id)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		slog.WarnContext(ctx, "create order notification failed", "order_id", outbox.AggregateId, "attempt", outbox.AttemptCount, "error", err)
+		if outbox.AttemptCount >= orderNotificationDispatcherMaxAttempts {
+			err = nd.outboxRepository.MarkOutboxFailed(ctx, outbox.Id, err.Error())
+		} else {
+			err = nd.outboxRepository.MarkOutboxRetry(ctx, outbox.Id, time.Now().Add(orderNotificationDispatcherBackoff), err.Error())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (nd *orderNotificationDispatcher) dispatch(ctx context.Context, outbox *entity.Outbox) error {
+	var payload entity.OrderEventPayload
+	err := json.Unmarshal(outbox.Payload, &payload)
+	if err != nil {
+		return err
+	}
+
+	orderEntity, err := nd.orderRepository.GetOrderById(ctx, payload.OrderId)
+	if err != nil {
+		return err
+	}
+	if orderEntity == nil {
+		return errors.New("order not found")
+	}
+
+	locale := ""
+	if orderEntity.Locale != nil {
+		locale = mailtemplate.MatchLocale(*orderEntity.Locale)
+	}
+	if locale == "" {
+		locale = mailtemplate.DefaultLocale()
+	}
+	message, ok := orderNotificationMessages[locale][payload.Event]
+	if !ok {
+		slog.WarnContext(ctx, "no notification message for order event", "event", payload.Event)
+		return nil
+	}
+
+	notificationType := entity.NotificationTypeOrder
+	if payload.Event == entity.OrderEventPaid || payload.Event == entity.OrderEventPaymentReview {
+		notificationType = entity.NotificationTypePayment
+	}
+
+	notification := &entity.UserNotification{
+		Id:              uuid.NewString(),
+		UserId:          orderEntity.UserId,
+		Type:            notificationType,
+		Event:           payload.Event,
+		Title:           message.Title,
+		Body:            fmt.Sprintf(message.Body, orderEntity.Number),
+		OrderId:         &orderEntity.Id,
+		OrderStatusCode: &orderEntity.OrderStatusCode,
+		OutboxId:        &outbox.Id,
+		CreatedAt:       payload.OccurredAt,
+	}
+	created, err := nd.notificationRepository.CreateNotification(ctx, notification)
+	if err != nil {
+		return err
+	}
+	// outbox yang diproses ulang setelah lease habis sudah pernah dikirim ke subscriber
+	// notifikasi sudah tersimpan, gagal publish cukup dicatat karena client tetap bisa mengambilnya lewat ListNotifications
+	if created {
+		err = nd.notificationBroker.Publish(ctx, notification)
+		if err != nil {
+			slog.WarnContext(ctx, "publish notification failed", "notification_id", notification.Id, "error", err)
+		}
+	}
+
+	return nil
+}
+
+func NewOrderNotificationDispatcher(orderRepository repository.IOrderRepository, notificationRepository repository.INotificationRepository, outboxRepository repository.IOutboxRepository, notificationBroker INotificationBroker) IOrderNotificationDispatcher {
+	return &orderNotificationDispatcher{
+		orderRepository:        orderRepository,
+		notificationRepository: notificationRepository,
+		outboxRepository:       outboxRepository,
+		notificationBroker:     notificationBroker,
+	}
+}
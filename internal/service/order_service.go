@@ -3,19 +3,29 @@ package service
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
-	operatingsystem "os"
+	"log/slog"
 	"runtime/debug"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/currency"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
 	jwtentity "github.com/luzmareto/go-grpc-ecommerce-be/internal/entity/jwt"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/mailtemplate"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/metrics"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/payment"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/promotion"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/shipping"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/tax"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/order"
-	"github.com/xendit/xendit-go"
-	"github.com/xendit/xendit-go/invoice"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -25,12 +35,26 @@ type IOrderService interface {
 	ListOrder(ctx context.Context, request *order.ListOrderRequest) (*order.ListOrderResponse, error)
 	DetailOrder(ctx context.Context, request *order.DetailOrderRequest) (*order.DetailOrderResponse, error)
 	UpdateOrderStatus(ctx context.Context, request *order.UpdateOrderStatusRequest) (*order.UpdateOrderStatusResponse, error)
+	GetOrderPaymentLink(ctx context.Context, request *order.GetOrderPaymentLinkRequest) (*order.GetOrderPaymentLinkResponse, error)
+	RequestReturn(ctx context.Context, request *order.RequestReturnRequest) (*order.RequestReturnResponse, error)
+	ApproveRefund(ctx context.Context, request *order.ApproveRefundRequest) (*order.ApproveRefundResponse, error)
+	RejectRefund(ctx context.Context, request *order.RejectRefundRequest) (*order.RejectRefundResponse, error)
+	ApplyVoucher(ctx context.Context, request *order.ApplyVoucherRequest) (*order.ApplyVoucherResponse, error)
 }
 
 type orderService struct {
 	db               *sql.DB
 	orderRepository  repository.IOrderRepository
 	productRepostory repository.IProductRepository
+	outboxRepository repository.IOutboxRepository
+	refundRepository repository.IRefundRepository
+	paymentGateway   payment.IPaymentGateway
+
+	addressRepository      repository.IAddressRepository
+	shippingRateCalculator shipping.IShippingRateCalculator
+	voucherRepository      repository.IVoucherRepository
+	taxCalculator          tax.ITaxCalculator
+	rateTable              currency.IRateTable
 }
 
 func (os *orderService) CreateOrder(ctx context.Context, request *order.CreateOrderRequest) (*order.CreateOrderResponse, error) {
@@ -39,6 +63,36 @@ func (os *orderService) CreateOrder(ctx context.Context, request *order.CreateOr
 		return nil, err
 	}
 
+	// alamat dari buku alamat menggantikan alamat free text
+	userFullName := request.FullName
+	address := request.Address
+	phoneNumber := request.PhoneNumber
+	var shippingAddressId *string
+	rateRequest := shipping.RateRequest{}
+	if request.AddressId != "" {
+		addressEntity, err := os.addressRepository.GetAddressById(ctx, request.AddressId)
+		if err != nil {
+			return nil, err
+		}
+		if addressEntity == nil || addressEntity.UserId != claims.Subject {
+			return &order.CreateOrderResponse{
+				Base: utils.NotFoundResponse("Address not found"),
+			}, nil
+		}
+
+		userFullName = addressEntity.RecipientName
+		phoneNumber = addressEntity.PhoneNumber
+		address = fmt.Sprintf("%s, %s, %s %s", addressEntity.Address, addressEntity.City, addressEntity.Province, addressEntity.PostalCode)
+		shippingAddressId = &addressEntity.Id
+		rateRequest.Province = addressEntity.Province
+		rateRequest.City = addressEntity.City
+		rateRequest.PostalCode = addressEntity.PostalCode
+	} else if userFullName == "" || address == "" || phoneNumber == "" {
+		return &order.CreateOrderResponse{
+			Base: utils.BadRequestResponse("Full name, address and phone number are required"),
+		}, nil
+	}
+
 	tx, err := os.db.Begin()
 	if err != nil {
 		return nil, err
@@ -61,95 +115,109 @@ func (os *orderService) CreateOrder(ctx context.Context, request *order.CreateOr
 
 	orderRepo := os.orderRepository.WithTrancastion(tx)
 	productRepo := os.productRepostory.WithTrancastion(tx)
+	outboxRepo := os.outboxRepository.WithTrancastion(tx)
+	voucherRepo := os.voucherRepository.WithTrancastion(tx)
 
-	numbering, err := orderRepo.GetNumbering(ctx, "order")
+	// diskon dihitung di dalam transaksi dengan voucher terkunci agar batas pemakaian tidak terlewati
+	pricing, failedResponse, err := os.calculateOrderPricing(ctx, productRepo, voucherRepo, &orderPricingRequest{
+		userId:          claims.Subject,
+		products:        request.Products,
+		rateRequest:     &rateRequest,
+		shippingCourier: request.ShippingCourier,
+		shippingService: request.ShippingService,
+		voucherCode:     request.VoucherCode,
+		currency:        request.Currency,
+		lockVoucher:     true,
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	var productIds = make([]string, len(request.Products))
-	for i := range request.Products {
-		productIds[i] = request.Products[i].Id
+	if failedResponse != nil {
+		err = tx.Rollback()
+		tx = nil
+		if err != nil {
+			return nil, err
+		}
+		return &order.CreateOrderResponse{
+			Base: failedResponse,
+		}, nil
 	}
+	productMap := pricing.productMap
+	shippingRate := pricing.shippingRate
 
-	products, err := productRepo.GetProductsByIds(ctx, productIds)
+	// numbering dikunci setelah ongkir dihitung agar lock tidak tertahan saat memanggil API kurir
+	numbering, err := orderRepo.GetNumbering(ctx, "order")
 	if err != nil {
 		return nil, err
 	}
 
-	productMap := make(map[string]*entity.Product)
-	for i := range products {
-		productMap[products[i].Id] = products[i]
-	}
-
-	var total float64 = 0
-	for _, p := range request.Products {
-		if productMap[p.Id] == nil {
-			return &order.CreateOrderResponse{
-				Base: utils.NotFoundResponse(fmt.Sprintf("Product %s not found", p.Id)),
-			}, nil
-		}
-		total += productMap[p.Id].Price * float64(p.Quantity)
-	}
-
 	now := time.Now()
 	expiredAt := now.Add(24 * time.Hour)
 	orderEntity := entity.Order{
-		Id:              uuid.NewString(),
-		Number:          fmt.Sprintf("ORD-%d%08d", now.Year(), numbering.Number),
-		UserId:          claims.Subject,
-		OrderStatusCode: entity.OrderStatusCodeUnpaid,
-		UserFullName:    request.FullName,
-		Address:         request.Address,
-		PhoneNumber:     request.PhoneNumber,
-		Notes:           &request.Notes,
-		Total:           total,
-		ExpiredAt:       &expiredAt,
-		CreatedAt:       now,
-		CreatedBy:       claims.FullName,
-	}
-
-	invoiceItems := make([]xendit.InvoiceItem, 0)
-	for _, p := range request.Products {
-		prod := productMap[p.Id]
-		if prod != nil {
-			invoiceItems = append(invoiceItems, xendit.InvoiceItem{
-				Name:     prod.Name,
-				Price:    prod.Price,
-				Quantity: int(p.Quantity),
-			})
-		}
+		Id:                    uuid.NewString(),
+		Number:                fmt.Sprintf("ORD-%d%08d", now.Year(), numbering.Number),
+		UserId:                claims.Subject,
+		OrderStatusCode:       entity.OrderStatusCodePendingPaymentLink,
+		UserFullName:          userFullName,
+		Address:               address,
+		PhoneNumber:           phoneNumber,
+		Notes:                 &request.Notes,
+		Total:                 pricing.total,
+		Currency:              pricing.currency,
+		BaseCurrency:          os.rateTable.BaseCurrency(),
+		ExchangeRate:          pricing.exchangeRate,
+		ExpiredAt:             &expiredAt,
+		CreatedAt:             now,
+		CreatedBy:             claims.FullName,
+		ShippingAddressId:     shippingAddressId,
+		ShippingCourier:       &shippingRate.Courier,
+		ShippingService:       &shippingRate.Service,
+		ShippingCost:          shippingRate.Cost,
+		ShippingWeightGram:    pricing.weightGram,
+		Subtotal:              pricing.subtotal,
+		DiscountAmount:        pricing.discount.TotalDiscount(),
+		ProductDiscountAmount: pricing.discount.ProductDiscount,
+		TaxName:               &pricing.tax.Name,
+		TaxRate:               pricing.tax.Rate,
+		TaxInclusive:          pricing.tax.Inclusive,
+		TaxAmount:             pricing.tax.TaxAmount,
+		StockReserved:         true,
 	}
-	xenditInvoice, xenditErr := invoice.CreateWithContext(ctx, &invoice.CreateParams{
-		ExternalID: orderEntity.Id,
-		Amount:     total,
-		Customer: xendit.InvoiceCustomer{
-			GivenNames: claims.FullName,
-		},
-		Currency:           "IDR",
-		SuccessRedirectURL: fmt.Sprintf("%s/checkout/%s/success", operatingsystem.Getenv("FRONTEND_BASE_URL"), orderEntity.Id),
-		Items:              invoiceItems,
-	})
-	if xenditErr != nil {
-		err = xenditErr
-		return nil, err
+	if pricing.voucher != nil {
+		orderEntity.VoucherId = &pricing.voucher.Id
+		orderEntity.VoucherCode = &pricing.voucher.Code
+	}
+	// bahasa email order mengikuti bahasa yang dipakai saat checkout
+	if locale := mailtemplate.MatchLocale(utils.GetAcceptLanguageFromContext(ctx)); locale != "" {
+		orderEntity.Locale = &locale
 	}
-
-	orderEntity.XenditInvoiceId = &xenditInvoice.ID
-	orderEntity.XenditInvoiceUrl = &xenditInvoice.InvoiceURL
 
 	err = orderRepo.CreateOrder(ctx, &orderEntity)
 	if err != nil {
 		return nil, err
 	}
 
+	if pricing.voucher != nil {
+		err = voucherRepo.CreateVoucherUsage(ctx, &entity.VoucherUsage{
+			Id:             uuid.NewString(),
+			VoucherId:      pricing.voucher.Id,
+			UserId:         claims.Subject,
+			OrderId:        orderEntity.Id,
+			DiscountAmount: orderEntity.DiscountAmount,
+			CreatedAt:      now,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	for _, p := range request.Products {
 		var orderItem = entity.OrderItem{
 			Id:                   uuid.NewString(),
 			ProductId:            p.Id,
 			ProductName:          productMap[p.Id].Name,
 			ProductImageFileName: productMap[p.Id].ImageFileName,
-			ProductPrice:         productMap[p.Id].Price,
+			ProductPrice:         pricing.unitPrices[p.Id],
 			Quantity:             p.Quantity,
 			OrderId:              orderEntity.Id,
 			CreatedAt:            now,
@@ -160,6 +228,23 @@ func (os *orderService) CreateOrder(ctx context.Context, request *order.CreateOr
 		if err != nil {
 			return nil, err
 		}
+
+		// stok dikurangi di transaksi order, baris produk terkunci sampai commit sehingga checkout bersamaan tidak membuat stok minus
+		var inStock bool
+		inStock, err = productRepo.DecreaseProductStock(ctx, p.Id, p.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		if !inStock {
+			err = tx.Rollback()
+			tx = nil
+			if err != nil {
+				return nil, err
+			}
+			return &order.CreateOrderResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Product %s is out of stock", productMap[p.Id].Name)),
+			}, nil
+		}
 	}
 
 	numbering.Number++
@@ -168,14 +253,42 @@ func (os *orderService) CreateOrder(ctx context.Context, request *order.CreateOr
 		return nil, err
 	}
 
+	// invoice xendit dibuat oleh dispatcher setelah transaksi commit
+	payload, err := json.Marshal(entity.CreateInvoicePayload{
+		OrderId:      orderEntity.Id,
+		CustomerName: claims.FullName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = outboxRepo.CreateOutbox(ctx, &entity.Outbox{
+		Id:            uuid.NewString(),
+		EventType:     entity.OutboxEventTypeCreateInvoice,
+		AggregateId:   orderEntity.Id,
+		Payload:       payload,
+		Status:        entity.OutboxStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = publishOrderEvent(ctx, outboxRepo, orderEntity.Id, entity.OrderEventCreated, now)
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
+	metrics.IncOrdersCreated()
 
 	return &order.CreateOrderResponse{
-		Base: utils.SuccessResponse("Create order success"),
-		Id:   orderEntity.Id,
+		Base:            utils.SuccessResponse("Create order success"),
+		Id:              orderEntity.Id,
+		OrderStatusCode: orderEntity.OrderStatusCode,
 	}, nil
 }
 
@@ -185,7 +298,7 @@ func (os *orderService) ListOrderAdmin(ctx context.Context, request *order.ListO
 		return nil, err
 	}
 	if claims.Role != entity.UserRoleAdmin {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, utils.PermissionDeniedResponse()
 	}
 
 	orders, metadata, err := os.orderRepository.GetListOrderAdminPagination(ctx, request.Pagination)
@@ -200,10 +313,11 @@ func (os *orderService) ListOrderAdmin(ctx context.Context, request *order.ListO
 		products := make([]*order.ListOrderAdminResponseItemProduct, 0)
 		for _, oi := range o.Items {
 			products = append(products, &order.ListOrderAdminResponseItemProduct{
-				Id:       oi.ProductId,
-				Name:     oi.ProductName,
-				Price:    oi.ProductPrice,
-				Quantity: oi.Quantity,
+				Id:         oi.ProductId,
+				Name:       oi.ProductName,
+				Price:      utils.MajorAmount(oi.ProductPrice, o.Currency),
+				Quantity:   oi.Quantity,
+				PriceMoney: utils.MoneyResponse(oi.ProductPrice, o.Currency),
 			})
 		}
 
@@ -212,14 +326,29 @@ func (os *orderService) ListOrderAdmin(ctx context.Context, request *order.ListO
 			orderStatusCode = entity.OrderStatusCodeExpired
 		}
 
+		paymentReviewReason := ""
+		if o.PaymentReviewReason != nil {
+			paymentReviewReason = *o.PaymentReviewReason
+		}
+
 		items = append(items, &order.ListOrderAdminResponseItem{
-			Id:         o.Id,
-			Number:     o.Number,
-			Customer:   o.UserFullName,
-			StatusCode: orderStatusCode,
-			Total:      o.Total,
-			CreatedAt:  timestamppb.New(o.CreatedAt),
-			Products:   products,
+			Id:                  o.Id,
+			Number:              o.Number,
+			Customer:            o.UserFullName,
+			StatusCode:          orderStatusCode,
+			Total:               utils.MajorAmount(o.Total, o.Currency),
+			CreatedAt:           timestamppb.New(o.CreatedAt),
+			Products:            products,
+			PaymentReviewReason: paymentReviewReason,
+			Subtotal:            utils.MajorAmount(o.Subtotal, o.Currency),
+			Discount:            utils.MajorAmount(o.DiscountAmount, o.Currency),
+			Tax:                 utils.MajorAmount(o.TaxAmount, o.Currency),
+			ShippingCost:        utils.MajorAmount(o.ShippingCost, o.Currency),
+			TotalMoney:          utils.MoneyResponse(o.Total, o.Currency),
+			SubtotalMoney:       utils.MoneyResponse(o.Subtotal, o.Currency),
+			DiscountMoney:       utils.MoneyResponse(o.DiscountAmount, o.Currency),
+			TaxMoney:            utils.MoneyResponse(o.TaxAmount, o.Currency),
+			ShippingCostMoney:   utils.MoneyResponse(o.ShippingCost, o.Currency),
 		})
 	}
 
@@ -248,10 +377,11 @@ func (os *orderService) ListOrder(ctx context.Context, request *order.ListOrderR
 		products := make([]*order.ListOrderResponseItemProduct, 0)
 		for _, oi := range o.Items {
 			products = append(products, &order.ListOrderResponseItemProduct{
-				Id:       oi.ProductId,
-				Name:     oi.ProductName,
-				Price:    oi.ProductPrice,
-				Quantity: oi.Quantity,
+				Id:         oi.ProductId,
+				Name:       oi.ProductName,
+				Price:      utils.MajorAmount(oi.ProductPrice, o.Currency),
+				Quantity:   oi.Quantity,
+				PriceMoney: utils.MoneyResponse(oi.ProductPrice, o.Currency),
 			})
 		}
 
@@ -269,10 +399,11 @@ func (os *orderService) ListOrder(ctx context.Context, request *order.ListOrderR
 			Number:          o.Number,
 			Customer:        o.UserFullName,
 			StatusCode:      orderStatusCode,
-			Total:           o.Total,
+			Total:           utils.MajorAmount(o.Total, o.Currency),
 			CreatedAt:       timestamppb.New(o.CreatedAt),
 			Products:        products,
 			XenditNvoiceUrl: xenditInoviceUrl,
+			TotalMoney:      utils.MoneyResponse(o.Total, o.Currency),
 		})
 	}
 
@@ -314,29 +445,134 @@ func (os *orderService) DetailOrder(ctx context.Context, request *order.DetailOr
 		orderStatusCode = entity.OrderStatusCodeExpired
 	}
 
+	var paidAt *timestamppb.Timestamp
+	if orderEntity.XenditPaidAt != nil {
+		paidAt = timestamppb.New(*orderEntity.XenditPaidAt)
+	}
+	var paidAmount int64
+	if orderEntity.XenditPaidAmount != nil {
+		paidAmount = *orderEntity.XenditPaidAmount
+	}
+	paymentReviewReason := ""
+	if orderEntity.PaymentReviewReason != nil {
+		paymentReviewReason = *orderEntity.PaymentReviewReason
+	}
+
+	shippingCourier := ""
+	if orderEntity.ShippingCourier != nil {
+		shippingCourier = *orderEntity.ShippingCourier
+	}
+	shippingService := ""
+	if orderEntity.ShippingService != nil {
+		shippingService = *orderEntity.ShippingService
+	}
+	trackingCourier := ""
+	if orderEntity.TrackingCourier != nil {
+		trackingCourier = *orderEntity.TrackingCourier
+	}
+	trackingNumber := ""
+	if orderEntity.TrackingNumber != nil {
+		trackingNumber = *orderEntity.TrackingNumber
+	}
+	voucherCode := ""
+	if orderEntity.VoucherCode != nil {
+		voucherCode = *orderEntity.VoucherCode
+	}
+	taxName := ""
+	if orderEntity.TaxName != nil {
+		taxName = *orderEntity.TaxName
+	}
+	var shippedAt *timestamppb.Timestamp
+	if orderEntity.ShippedAt != nil {
+		shippedAt = timestamppb.New(*orderEntity.ShippedAt)
+	}
+
 	items := make([]*order.DetailOrderResponseItem, 0)
 	for _, oi := range orderEntity.Items {
 		items = append(items, &order.DetailOrderResponseItem{
-			Id:       oi.ProductId,
-			Name:     oi.ProductName,
-			Price:    oi.ProductPrice,
-			Quantity: oi.Quantity,
+			Id:          oi.ProductId,
+			Name:        oi.ProductName,
+			Price:       utils.MajorAmount(oi.ProductPrice, orderEntity.Currency),
+			Quantity:    oi.Quantity,
+			OrderItemId: oi.Id,
+			PriceMoney:  utils.MoneyResponse(oi.ProductPrice, orderEntity.Currency),
 		})
 	}
+
+	refundEntities, err := os.refundRepository.GetRefundsByOrderId(ctx, orderEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+	refunds := make([]*order.DetailOrderResponseRefund, 0)
+	for _, re := range refundEntities {
+		refundItems := make([]*order.DetailOrderResponseRefundItem, 0)
+		for _, ri := range re.Items {
+			refundItems = append(refundItems, &order.DetailOrderResponseRefundItem{
+				OrderItemId: ri.OrderItemId,
+				ProductId:   ri.ProductId,
+				Quantity:    ri.Quantity,
+				Amount:      utils.MajorAmount(ri.Amount, orderEntity.Currency),
+				AmountMoney: utils.MoneyResponse(ri.Amount, orderEntity.Currency),
+			})
+		}
+
+		rejectReason := ""
+		if re.RejectReason != nil {
+			rejectReason = *re.RejectReason
+		}
+
+		refunds = append(refunds, &order.DetailOrderResponseRefund{
+			Id:           re.Id,
+			Status:       re.Status,
+			Reason:       re.Reason,
+			RejectReason: rejectReason,
+			Amount:       utils.MajorAmount(re.Amount, orderEntity.Currency),
+			Items:        refundItems,
+			CreatedAt:    timestamppb.New(re.CreatedAt),
+			AmountMoney:  utils.MoneyResponse(re.Amount, orderEntity.Currency),
+		})
+	}
+
 	return &order.DetailOrderResponse{
-		Base:             utils.SuccessResponse("get order detail success"),
-		Id:               orderEntity.Id,
-		Number:           orderEntity.Number,
-		UserFullName:     orderEntity.UserFullName,
-		Address:          orderEntity.Address,
-		PhoneNumber:      orderEntity.PhoneNumber,
-		Notes:            notes,
-		OrderStatusCode:  orderStatusCode,
-		CreatedAt:        timestamppb.New(orderEntity.CreatedAt),
-		XenditInvoiceUrl: xenditInvoiceUrl,
-		Items:            items,
-		Total:            orderEntity.Total,
-		ExpiredAt:        timestamppb.New(*orderEntity.ExpiredAt),
+		Base:                utils.SuccessResponse("get order detail success"),
+		Id:                  orderEntity.Id,
+		Number:              orderEntity.Number,
+		UserFullName:        orderEntity.UserFullName,
+		Address:             orderEntity.Address,
+		PhoneNumber:         orderEntity.PhoneNumber,
+		Notes:               notes,
+		OrderStatusCode:     orderStatusCode,
+		CreatedAt:           timestamppb.New(orderEntity.CreatedAt),
+		XenditInvoiceUrl:    xenditInvoiceUrl,
+		Items:               items,
+		Total:               utils.MajorAmount(orderEntity.Total, orderEntity.Currency),
+		ExpiredAt:           timestamppb.New(*orderEntity.ExpiredAt),
+		PaidAt:              paidAt,
+		PaidAmount:          utils.MajorAmount(paidAmount, orderEntity.Currency),
+		PaymentReviewReason: paymentReviewReason,
+		Refunds:             refunds,
+		ShippingCourier:     shippingCourier,
+		ShippingService:     shippingService,
+		ShippingCost:        utils.MajorAmount(orderEntity.ShippingCost, orderEntity.Currency),
+		TrackingCourier:     trackingCourier,
+		TrackingNumber:      trackingNumber,
+		ShippedAt:           shippedAt,
+		VoucherCode:         voucherCode,
+		Discount:            utils.MajorAmount(orderEntity.DiscountAmount, orderEntity.Currency),
+		Subtotal:            utils.MajorAmount(orderEntity.Subtotal, orderEntity.Currency),
+		TaxName:             taxName,
+		TaxRate:             orderEntity.TaxRate,
+		TaxInclusive:        orderEntity.TaxInclusive,
+		Tax:                 utils.MajorAmount(orderEntity.TaxAmount, orderEntity.Currency),
+		TotalMoney:          utils.MoneyResponse(orderEntity.Total, orderEntity.Currency),
+		PaidAmountMoney:     utils.MoneyResponse(paidAmount, orderEntity.Currency),
+		ShippingCostMoney:   utils.MoneyResponse(orderEntity.ShippingCost, orderEntity.Currency),
+		DiscountMoney:       utils.MoneyResponse(orderEntity.DiscountAmount, orderEntity.Currency),
+		SubtotalMoney:       utils.MoneyResponse(orderEntity.Subtotal, orderEntity.Currency),
+		TaxMoney:            utils.MoneyResponse(orderEntity.TaxAmount, orderEntity.Currency),
+		Currency:            orderEntity.Currency,
+		ExchangeRate:        orderEntity.ExchangeRate,
+		BaseCurrency:        orderEntity.BaseCurrency,
 	}, nil
 }
 
@@ -362,13 +598,14 @@ func (os *orderService) UpdateOrderStatus(ctx context.Context, request *order.Up
 	}
 
 	if request.NewStatusCode == entity.OrderStatusCodePaid {
-		if claims.Role != entity.UserRoleAdmin || orderEntity.OrderStatusCode != entity.OrderStatusCodeUnpaid {
+		// admin dapat menyelesaikan order payment_review setelah pembayaran dicek manual
+		if claims.Role != entity.UserRoleAdmin || (orderEntity.OrderStatusCode != entity.OrderStatusCodeUnpaid && orderEntity.OrderStatusCode != entity.OrderStatusCodePaymentReview) {
 			return &order.UpdateOrderStatusResponse{
 				Base: utils.BadRequestResponse("Update status is not allowed"),
 			}, nil
 		}
 	} else if request.NewStatusCode == entity.OrderStatusCodeCanceled {
-		if orderEntity.OrderStatusCode != entity.OrderStatusCodeUnpaid {
+		if orderEntity.OrderStatusCode != entity.OrderStatusCodeUnpaid && orderEntity.OrderStatusCode != entity.OrderStatusCodePendingPaymentLink {
 			return &order.UpdateOrderStatusResponse{
 				Base: utils.BadRequestResponse("Update status is not allowed"),
 			}, nil
@@ -379,6 +616,11 @@ func (os *orderService) UpdateOrderStatus(ctx context.Context, request *order.Up
 				Base: utils.BadRequestResponse("Update status is not allowed"),
 			}, nil
 		}
+		if request.TrackingCourier == "" || request.TrackingNumber == "" {
+			return &order.UpdateOrderStatusResponse{
+				Base: utils.BadRequestResponse("Tracking courier and tracking number are required"),
+			}, nil
+		}
 	} else if request.NewStatusCode == entity.OrderStatusCodeDone {
 		if orderEntity.OrderStatusCode != entity.OrderStatusCodeShipped {
 			return &order.UpdateOrderStatusResponse{
@@ -391,12 +633,90 @@ func (os *orderService) UpdateOrderStatus(ctx context.Context, request *order.Up
 		}, nil
 	}
 
+	fromStatusCode := orderEntity.OrderStatusCode
+
+	tx, err := os.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// order dibaca ulang dengan lock, status yang sudah diubah webhook atau request lain tidak boleh ditimpa
+	orderEntity, err = os.orderRepository.WithTrancastion(tx).GetOrderByIdForUpdate(ctx, request.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if orderEntity == nil || orderEntity.OrderStatusCode != fromStatusCode {
+		err = tx.Rollback()
+		tx = nil
+		if err != nil {
+			return nil, err
+		}
+
+		return &order.UpdateOrderStatusResponse{
+			Base: utils.BadRequestResponse("Order status has changed, please reload the order"),
+		}, nil
+	}
+
+	// invoice yang sudah dibuat di-expire agar order yang dibatalkan tidak bisa dibayar
+	if request.NewStatusCode == entity.OrderStatusCodeCanceled && orderEntity.XenditInvoiceId != nil {
+		err = os.paymentGateway.ExpireInvoice(ctx, *orderEntity.XenditInvoiceId)
+		if err != nil {
+			slog.ErrorContext(ctx, "expire invoice failed", "invoice_id", *orderEntity.XenditInvoiceId, "error", err)
+		}
+	}
+
 	now := time.Now()
 	orderEntity.OrderStatusCode = request.NewStatusCode
 	orderEntity.UpdatedAt = &now
 	orderEntity.UpdatedBy = &claims.Subject
+	if request.NewStatusCode == entity.OrderStatusCodeShipped {
+		orderEntity.TrackingCourier = &request.TrackingCourier
+		orderEntity.TrackingNumber = &request.TrackingNumber
+		orderEntity.ShippedAt = &now
+	}
+
+	err = os.orderRepository.WithTrancastion(tx).UpdateOrder(ctx, orderEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.NewStatusCode == entity.OrderStatusCodeCanceled {
+		err = os.orderRepository.WithTrancastion(tx).ReleaseOrderStock(ctx, orderEntity.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// event dicatat di transaksi yang sama agar email tidak terkirim untuk perubahan yang gagal disimpan
+	orderEvents := map[string]string{
+		entity.OrderStatusCodePaid:     entity.OrderEventPaid,
+		entity.OrderStatusCodeShipped:  entity.OrderEventShipped,
+		entity.OrderStatusCodeDone:     entity.OrderEventCompleted,
+		entity.OrderStatusCodeCanceled: entity.OrderEventCanceled,
+	}
+	if event, ok := orderEvents[request.NewStatusCode]; ok {
+		err = publishOrderEvent(ctx, os.outboxRepository.WithTrancastion(tx), orderEntity.Id, event, now)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	err = os.orderRepository.UpdateOrder(ctx, orderEntity)
+	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
@@ -406,10 +726,816 @@ func (os *orderService) UpdateOrderStatus(ctx context.Context, request *order.Up
 	}, nil
 }
 
-func NewOrderService(db *sql.DB, orderRepository repository.IOrderRepository, productRepository repository.IProductRepository) IOrderService {
-	return &orderService{
-		db:               db,
-		orderRepository:  orderRepository,
-		productRepostory: productRepository,
+func (os *orderService) GetOrderPaymentLink(ctx context.Context, request *order.GetOrderPaymentLinkRequest) (*order.GetOrderPaymentLinkResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderEntity, err := os.orderRepository.GetOrderById(ctx, request.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if orderEntity == nil {
+		return &order.GetOrderPaymentLinkResponse{
+			Base: utils.NotFoundResponse("Order not found"),
+		}, nil
+	}
+
+	if claims.Role != entity.UserRoleAdmin && claims.Subject != orderEntity.UserId {
+		return &order.GetOrderPaymentLinkResponse{
+			Base: utils.BadRequestResponse("User id is not matched"),
+		}, nil
+	}
+
+	xenditInvoiceUrl := ""
+	if orderEntity.XenditInvoiceUrl != nil {
+		xenditInvoiceUrl = *orderEntity.XenditInvoiceUrl
+	}
+
+	return &order.GetOrderPaymentLinkResponse{
+		Base:             utils.SuccessResponse("Get order payment link success"),
+		OrderId:          orderEntity.Id,
+		OrderStatusCode:  orderEntity.OrderStatusCode,
+		XenditInvoiceUrl: xenditInvoiceUrl,
+		IsReady:          xenditInvoiceUrl != "",
+	}, nil
+}
+
+func (os *orderService) RequestReturn(ctx context.Context, request *order.RequestReturnRequest) (*order.RequestReturnResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderEntity, err := os.orderRepository.GetOrderById(ctx, request.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if orderEntity == nil {
+		return &order.RequestReturnResponse{
+			Base: utils.NotFoundResponse("Order not found"),
+		}, nil
+	}
+
+	if orderEntity.UserId != claims.Subject {
+		return &order.RequestReturnResponse{
+			Base: utils.BadRequestResponse("User id is not matched"),
+		}, nil
+	}
+
+	// return hanya bisa diajukan untuk order yang sudah dibayar dan tidak sedang diproses refund lain
+	if orderEntity.OrderStatusCode != entity.OrderStatusCodePaid &&
+		orderEntity.OrderStatusCode != entity.OrderStatusCodeShipped &&
+		orderEntity.OrderStatusCode != entity.OrderStatusCodeDone &&
+		orderEntity.OrderStatusCode != entity.OrderStatusCodePartiallyRefunded {
+		return &order.RequestReturnResponse{
+			Base: utils.BadRequestResponse("Return is not allowed"),
+		}, nil
+	}
+
+	tx, err := os.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	orderRepo := os.orderRepository.WithTrancastion(tx)
+	refundRepo := os.refundRepository.WithTrancastion(tx)
+
+	refunds, err := refundRepo.GetRefundsByOrderId(ctx, orderEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+	refundedQuantity := getRefundedQuantity(refunds, false)
+	refundedAmount := getRefundedAmount(refunds)
+	paidAmount := getOrderItemPaidAmount(orderEntity)
+
+	orderItemMap := make(map[string]*entity.OrderItem)
+	for _, oi := range orderEntity.Items {
+		orderItemMap[oi.Id] = oi
+	}
+
+	// item yang sama bisa dikirim lebih dari sekali, quantity dijumlahkan
+	requestQuantity := make(map[string]int64)
+	orderItemIds := make([]string, 0)
+	for _, ri := range request.Items {
+		orderItem, ok := orderItemMap[ri.OrderItemId]
+		if !ok {
+			err = tx.Rollback()
+			tx = nil
+			if err != nil {
+				return nil, err
+			}
+			return &order.RequestReturnResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Order item %s not found", ri.OrderItemId)),
+			}, nil
+		}
+
+		if _, ok := requestQuantity[ri.OrderItemId]; !ok {
+			orderItemIds = append(orderItemIds, ri.OrderItemId)
+		}
+		requestQuantity[ri.OrderItemId] += ri.Quantity
+
+		if requestQuantity[ri.OrderItemId]+refundedQuantity[ri.OrderItemId] > orderItem.Quantity {
+			err = tx.Rollback()
+			tx = nil
+			if err != nil {
+				return nil, err
+			}
+			return &order.RequestReturnResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Return quantity for %s exceeds ordered quantity", orderItem.ProductName)),
+			}, nil
+		}
+	}
+
+	now := time.Now()
+	refundEntity := entity.OrderRefund{
+		Id:                      uuid.NewString(),
+		OrderId:                 orderEntity.Id,
+		Status:                  entity.RefundStatusRequested,
+		Reason:                  request.Reason,
+		PreviousOrderStatusCode: orderEntity.OrderStatusCode,
+		CreatedAt:               now,
+		CreatedBy:               claims.Subject,
+	}
+	refundItems := make([]*entity.OrderRefundItem, 0)
+	for _, orderItemId := range orderItemIds {
+		orderItem := orderItemMap[orderItemId]
+		// nominal refund dihitung dari yang benar-benar dibayar untuk item, sisa pembulatan masuk ke return terakhir
+		remainingAmount := paidAmount[orderItemId] - refundedAmount[orderItemId]
+		amount := money.MulRatio(paidAmount[orderItemId], requestQuantity[orderItemId], orderItem.Quantity)
+		if requestQuantity[orderItemId]+refundedQuantity[orderItemId] == orderItem.Quantity || amount > remainingAmount {
+			amount = remainingAmount
+		}
+		if amount < 0 {
+			amount = 0
+		}
+		refundEntity.Amount += amount
+
+		refundItems = append(refundItems, &entity.OrderRefundItem{
+			Id:          uuid.NewString(),
+			RefundId:    refundEntity.Id,
+			OrderItemId: orderItem.Id,
+			ProductId:   orderItem.ProductId,
+			Quantity:    requestQuantity[orderItemId],
+			Amount:      amount,
+			CreatedAt:   now,
+			CreatedBy:   claims.Subject,
+		})
+	}
+
+	orderEntity.OrderStatusCode = entity.OrderStatusCodeRefundPending
+	orderEntity.UpdatedAt = &now
+	orderEntity.UpdatedBy = &claims.Subject
+	updated, err := orderRepo.UpdateOrderStatusFrom(ctx, orderEntity, refundEntity.PreviousOrderStatusCode)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		err = tx.Rollback()
+		tx = nil
+		if err != nil {
+			return nil, err
+		}
+		return &order.RequestReturnResponse{
+			Base: utils.BadRequestResponse("Order status has changed, please try again"),
+		}, nil
+	}
+
+	err = refundRepo.CreateRefund(ctx, &refundEntity)
+	if err != nil {
+		return nil, err
+	}
+	for _, ri := range refundItems {
+		err = refundRepo.CreateRefundItem(ctx, ri)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &order.RequestReturnResponse{
+		Base:        utils.SuccessResponse("Request return success"),
+		RefundId:    refundEntity.Id,
+		Amount:      utils.MajorAmount(refundEntity.Amount, orderEntity.Currency),
+		AmountMoney: utils.MoneyResponse(refundEntity.Amount, orderEntity.Currency),
+	}, nil
+}
+
+func (os *orderService) ApproveRefund(ctx context.Context, request *order.ApproveRefundRequest) (*order.ApproveRefundResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	refundEntity, err := os.refundRepository.GetRefundById(ctx, request.RefundId)
+	if err != nil {
+		return nil, err
+	}
+	if refundEntity == nil {
+		return &order.ApproveRefundResponse{
+			Base: utils.NotFoundResponse("Refund not found"),
+		}, nil
+	}
+	if refundEntity.Status != entity.RefundStatusRequested {
+		return &order.ApproveRefundResponse{
+			Base: utils.BadRequestResponse("Refund is already processed"),
+		}, nil
+	}
+
+	orderEntity, err := os.orderRepository.GetOrderById(ctx, refundEntity.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if orderEntity == nil {
+		return &order.ApproveRefundResponse{
+			Base: utils.NotFoundResponse("Order not found"),
+		}, nil
+	}
+	if orderEntity.XenditInvoiceId == nil {
+		return &order.ApproveRefundResponse{
+			Base: utils.BadRequestResponse("Order has no payment to refund"),
+		}, nil
+	}
+
+	// refund di-claim sebelum memanggil provider agar approve yang bersamaan tidak merefund dua kali
+	now := time.Now()
+	refundEntity.Status = entity.RefundStatusProcessing
+	refundEntity.UpdatedAt = &now
+	refundEntity.UpdatedBy = &claims.Subject
+	claimed, err := os.refundRepository.UpdateRefundStatus(ctx, refundEntity, entity.RefundStatusRequested)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return &order.ApproveRefundResponse{
+			Base: utils.BadRequestResponse("Refund is already processed"),
+		}, nil
+	}
+
+	// reference id memakai id refund sehingga retry ke provider tetap idempotent
+	providerRefund, err := os.paymentGateway.Refund(ctx, &payment.RefundParams{
+		InvoiceId:   *orderEntity.XenditInvoiceId,
+		ReferenceId: refundEntity.Id,
+		Amount:      refundEntity.Amount,
+		Currency:    orderEntity.Currency,
+		Reason:      refundEntity.Reason,
+	})
+	if err != nil {
+		// kembalikan ke requested agar admin bisa mencoba approve ulang
+		refundEntity.Status = entity.RefundStatusRequested
+		_, revertErr := os.refundRepository.UpdateRefundStatus(ctx, refundEntity, entity.RefundStatusProcessing)
+		if revertErr != nil {
+			slog.ErrorContext(ctx, "revert refund to requested failed", "refund_id", refundEntity.Id, "error", revertErr)
+		}
+
+		return nil, err
+	}
+
+	// id refund provider langsung disimpan agar refundStatusDispatcher bisa menyelesaikan refund jika transaksi di bawah gagal
+	refundEntity.ProviderRefundId = &providerRefund.Id
+	_, err = os.refundRepository.UpdateRefundStatus(ctx, refundEntity, entity.RefundStatusProcessing)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := os.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	orderRepo := os.orderRepository.WithTrancastion(tx)
+	refundRepo := os.refundRepository.WithTrancastion(tx)
+
+	_, err = applyRefundStatus(ctx, orderRepo, refundRepo, orderEntity, refundEntity, providerRefund.Status, now, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &order.ApproveRefundResponse{
+		Base:         utils.SuccessResponse("Approve refund success"),
+		RefundStatus: refundEntity.Status,
+	}, nil
+}
+
+func (os *orderService) RejectRefund(ctx context.Context, request *order.RejectRefundRequest) (*order.RejectRefundResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	refundEntity, err := os.refundRepository.GetRefundById(ctx, request.RefundId)
+	if err != nil {
+		return nil, err
+	}
+	if refundEntity == nil {
+		return &order.RejectRefundResponse{
+			Base: utils.NotFoundResponse("Refund not found"),
+		}, nil
+	}
+	if refundEntity.Status != entity.RefundStatusRequested {
+		return &order.RejectRefundResponse{
+			Base: utils.BadRequestResponse("Refund is already processed"),
+		}, nil
+	}
+
+	tx, err := os.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	orderRepo := os.orderRepository.WithTrancastion(tx)
+	refundRepo := os.refundRepository.WithTrancastion(tx)
+
+	now := time.Now()
+	refundEntity.Status = entity.RefundStatusRejected
+	refundEntity.RejectReason = &request.Reason
+	refundEntity.UpdatedAt = &now
+	refundEntity.UpdatedBy = &claims.Subject
+	updated, err := refundRepo.UpdateRefundStatus(ctx, refundEntity, entity.RefundStatusRequested)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		err = tx.Rollback()
+		tx = nil
+		if err != nil {
+			return nil, err
+		}
+		return &order.RejectRefundResponse{
+			Base: utils.BadRequestResponse("Refund is already processed"),
+		}, nil
+	}
+
+	// order dikembalikan ke status sebelum return diajukan
+	_, err = orderRepo.UpdateOrderStatusFrom(ctx, &entity.Order{
+		Id:              refundEntity.OrderId,
+		OrderStatusCode: refundEntity.PreviousOrderStatusCode,
+		UpdatedAt:       &now,
+		UpdatedBy:       &claims.Subject,
+	}, entity.OrderStatusCodeRefundPending)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &order.RejectRefundResponse{
+		Base: utils.SuccessResponse("Reject refund success"),
+	}, nil
+}
+
+// applyRefundStatus mengubah refund processing sesuai status dari provider. Refund yang masih diproses provider
+// tetap processing dan order tetap refund_pending, status akhir diambil oleh refundStatusDispatcher.
+// Return false jika refund sudah tidak processing, contoh sudah diselesaikan proses lain
+func applyRefundStatus(ctx context.Context, orderRepo repository.IOrderRepository, refundRepo repository.IRefundRepository, orderEntity *entity.Order, refundEntity *entity.OrderRefund, providerStatus string, now time.Time, updatedBy string) (bool, error) {
+	switch providerStatus {
+	case payment.RefundStatusSucceeded:
+		refundEntity.Status = entity.RefundStatusSucceeded
+	case payment.RefundStatusFailed:
+		refundEntity.Status = entity.RefundStatusFailed
+	default:
+		refundEntity.Status = entity.RefundStatusProcessing
+	}
+	refundEntity.UpdatedAt = &now
+	refundEntity.UpdatedBy = &updatedBy
+
+	updated, err := refundRepo.UpdateRefundStatus(ctx, refundEntity, entity.RefundStatusProcessing)
+	if err != nil {
+		return false, err
+	}
+	if !updated || refundEntity.Status == entity.RefundStatusProcessing {
+		return updated, nil
+	}
+
+	orderEntity.OrderStatusCode = refundEntity.PreviousOrderStatusCode
+	if refundEntity.Status == entity.RefundStatusSucceeded {
+		refunds, err := refundRepo.GetRefundsByOrderId(ctx, orderEntity.Id)
+		if err != nil {
+			return false, err
+		}
+
+		orderEntity.OrderStatusCode = entity.OrderStatusCodeRefunded
+		refundedQuantity := getRefundedQuantity(refunds, true)
+		for _, oi := range orderEntity.Items {
+			if refundedQuantity[oi.Id] < oi.Quantity {
+				orderEntity.OrderStatusCode = entity.OrderStatusCodePartiallyRefunded
+				break
+			}
+		}
+	}
+
+	orderEntity.UpdatedAt = &now
+	orderEntity.UpdatedBy = &updatedBy
+	_, err = orderRepo.UpdateOrderStatusFrom(ctx, orderEntity, entity.OrderStatusCodeRefundPending)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// getRefundedAmount menjumlahkan nominal refund per order item yang belum ditolak atau gagal
+func getRefundedAmount(refunds []*entity.OrderRefund) map[string]int64 {
+	refundedAmount := make(map[string]int64)
+	for _, refund := range refunds {
+		if refund.Status == entity.RefundStatusRejected || refund.Status == entity.RefundStatusFailed {
+			continue
+		}
+
+		for _, item := range refund.Items {
+			refundedAmount[item.OrderItemId] += item.Amount
+		}
+	}
+
+	return refundedAmount
+}
+
+// getOrderItemPaidAmount menghitung nominal yang dibayar per order item setelah diskon voucher produk
+// ditambah pajak exclusive. Diskon dan pajak dibagi ke item sesuai proporsi nilai item
+func getOrderItemPaidAmount(orderEntity *entity.Order) map[string]int64 {
+	lineAmounts := make([]int64, len(orderEntity.Items))
+	var subtotal int64
+	for i, oi := range orderEntity.Items {
+		lineAmounts[i] = oi.ProductPrice * oi.Quantity
+		subtotal += lineAmounts[i]
+	}
+
+	productDiscount := orderEntity.ProductDiscountAmount
+	if productDiscount > subtotal {
+		productDiscount = subtotal
+	}
+	discounts := money.Allocate(productDiscount, lineAmounts)
+	for i := range lineAmounts {
+		lineAmounts[i] -= discounts[i]
+	}
+
+	// pajak inclusive sudah termasuk di harga item. Untuk pajak exclusive hanya bagian pajak produk yang dikembalikan,
+	// pajak ongkir (jika dikenakan) tidak ikut karena ongkir tidak direfund
+	taxes := make([]int64, len(lineAmounts))
+	if !orderEntity.TaxInclusive && orderEntity.TaxAmount > 0 {
+		productTax := money.Percent(subtotal-productDiscount, orderEntity.TaxRate)
+		if productTax > orderEntity.TaxAmount {
+			productTax = orderEntity.TaxAmount
+		}
+		taxes = money.Allocate(productTax, lineAmounts)
+	}
+
+	paidAmount := make(map[string]int64)
+	for i, oi := range orderEntity.Items {
+		paidAmount[oi.Id] = lineAmounts[i] + taxes[i]
+	}
+
+	return paidAmount
+}
+
+// getRefundedQuantity menghitung quantity per order item yang sudah / sedang direfund,
+// onlySucceeded true hanya menghitung refund yang sudah berhasil
+func getRefundedQuantity(refunds []*entity.OrderRefund, onlySucceeded bool) map[string]int64 {
+	refundedQuantity := make(map[string]int64)
+	for _, refund := range refunds {
+		if refund.Status == entity.RefundStatusRejected || refund.Status == entity.RefundStatusFailed {
+			continue
+		}
+		if onlySucceeded && refund.Status != entity.RefundStatusSucceeded {
+			continue
+		}
+
+		for _, item := range refund.Items {
+			refundedQuantity[item.OrderItemId] += item.Quantity
+		}
+	}
+
+	return refundedQuantity
+}
+
+type orderPricingRequest struct {
+	userId          string
+	products        []*order.CreateOrderRequestProductItem
+	rateRequest     *shipping.RateRequest
+	shippingCourier string
+	shippingService string
+	voucherCode     string
+	currency        string
+	lockVoucher     bool
+}
+
+// orderPricing adalah rincian harga checkout dalam minor unit currency, dipakai oleh CreateOrder dan ApplyVoucher
+type orderPricing struct {
+	productMap   map[string]*entity.Product
+	currency     string
+	rates        *currency.RateSnapshot
+	exchangeRate float64
+	unitPrices   map[string]int64
+	subtotal     int64
+	weightGram   int64
+	shippingRate *shipping.Rate
+	voucher      *entity.Voucher
+	discount     *promotion.Result
+	tax          *tax.Result
+	total        int64
+}
+
+// calculateOrderPricing menghitung subtotal, ongkir, diskon voucher dan pajak,
+// response yang tidak nil berarti checkout ditolak dengan pesan tersebut
+func (os *orderService) calculateOrderPricing(ctx context.Context, productRepo repository.IProductRepository, voucherRepo repository.IVoucherRepository, request *orderPricingRequest) (*orderPricing, *common.BaseResponse, error) {
+	var productIds = make([]string, len(request.products))
+	for i := range request.products {
+		productIds[i] = request.products[i].Id
+	}
+
+	products, err := productRepo.GetProductsByIds(ctx, productIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	productMap := make(map[string]*entity.Product)
+	for i := range products {
+		productMap[products[i].Id] = products[i]
+	}
+
+	// mata uang order dipilih customer, kosong berarti mata uang dasar toko.
+	// kurs dikunci sekali agar semua nominal checkout memakai kurs yang sama dengan exchange rate order
+	pricing := orderPricing{
+		productMap: productMap,
+		currency:   os.rateTable.BaseCurrency(),
+		rates:      os.rateTable.Snapshot(),
+		unitPrices: make(map[string]int64),
+	}
+	if request.currency != "" {
+		pricing.currency = money.NormalizeCurrency(request.currency)
+	}
+	pricing.exchangeRate, err = pricing.rates.GetRate(pricing.rates.Base, pricing.currency)
+	if err != nil {
+		if errors.Is(err, currency.ErrUnsupportedCurrency) {
+			return nil, utils.BadRequestResponse("Currency is not supported"), nil
+		}
+
+		return nil, nil, err
+	}
+
+	lines := make([]*promotion.Line, 0)
+	for _, p := range request.products {
+		if productMap[p.Id] == nil {
+			return nil, utils.NotFoundResponse(fmt.Sprintf("Product %s not found", p.Id)), nil
+		}
+		if !productMap[p.Id].HasStock(p.Quantity) {
+			return nil, utils.BadRequestResponse(fmt.Sprintf("Product %s is out of stock", productMap[p.Id].Name)), nil
+		}
+
+		// harga satuan dikonversi dulu baru dikali quantity, sama dengan harga yang ditampilkan di list dan cart
+		unitPrice, err := pricing.rates.Convert(money.New(productMap[p.Id].Price, productMap[p.Id].Currency), pricing.currency)
+		if err != nil {
+			return nil, nil, err
+		}
+		pricing.unitPrices[p.Id] = unitPrice.Amount
+
+		pricing.subtotal += unitPrice.Amount * p.Quantity
+		pricing.weightGram += productMap[p.Id].WeightGram * p.Quantity
+		lines = append(lines, &promotion.Line{
+			ProductId: p.Id,
+			Category:  productMap[p.Id].Category,
+			Price:     unitPrice.Amount,
+			Quantity:  p.Quantity,
+		})
+	}
+
+	request.rateRequest.WeightGram = pricing.weightGram
+	shippingRate, err := os.shippingRateCalculator.GetRate(ctx, request.rateRequest, request.shippingCourier, request.shippingService)
+	if err != nil {
+		if errors.Is(err, shipping.ErrRateNotFound) {
+			return nil, utils.BadRequestResponse("Shipping service is not available"), nil
+		}
+
+		return nil, nil, err
+	}
+	shippingCost, err := pricing.rates.Convert(money.New(shippingRate.Cost, shippingRate.Currency), pricing.currency)
+	if err != nil {
+		return nil, nil, err
+	}
+	pricing.shippingRate = &shipping.Rate{
+		Courier:  shippingRate.Courier,
+		Service:  shippingRate.Service,
+		Cost:     shippingCost.Amount,
+		Currency: shippingCost.Currency,
+		EtdDays:  shippingRate.EtdDays,
+	}
+
+	pricing.discount = &promotion.Result{}
+	if request.voucherCode != "" {
+		voucherEntity, err := voucherRepo.GetVoucherByCode(ctx, strings.ToUpper(strings.TrimSpace(request.voucherCode)), request.lockVoucher)
+		if err != nil {
+			return nil, nil, err
+		}
+		if voucherEntity == nil {
+			return nil, utils.NotFoundResponse("Voucher not found"), nil
+		}
+
+		globalUsage, userUsage, err := voucherRepo.CountVoucherUsage(ctx, voucherEntity.Id, request.userId)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = promotion.CheckUsage(voucherEntity, globalUsage, userUsage)
+		if err != nil {
+			return nil, utils.BadRequestResponse(fmt.Sprintf("Invalid voucher: %v", err)), nil
+		}
+
+		orderVoucher, err := convertVoucher(pricing.rates, voucherEntity, pricing.currency)
+		if err != nil {
+			return nil, nil, err
+		}
+		discount, err := promotion.Calculate(orderVoucher, lines, pricing.shippingRate.Cost, pricing.currency, time.Now())
+		if err != nil {
+			return nil, utils.BadRequestResponse(fmt.Sprintf("Invalid voucher: %v", err)), nil
+		}
+
+		pricing.voucher = voucherEntity
+		pricing.discount = discount
+	}
+
+	// pajak dihitung dari nilai setelah diskon, pajak inclusive sudah termasuk di harga
+	productAmount := pricing.subtotal - pricing.discount.ProductDiscount
+	shippingAmount := pricing.shippingRate.Cost - pricing.discount.ShippingDiscount
+	pricing.tax = os.taxCalculator.Calculate(productAmount, shippingAmount)
+	pricing.total = productAmount + shippingAmount
+	if !pricing.tax.Inclusive {
+		pricing.total += pricing.tax.TaxAmount
+	}
+
+	return &pricing, nil, nil
+}
+
+// convertVoucher menyalin voucher dengan nominal dalam mata uang order,
+// discount_value voucher percentage tidak berubah karena berupa persen
+func convertVoucher(rates *currency.RateSnapshot, voucherEntity *entity.Voucher, to string) (*entity.Voucher, error) {
+	converted := *voucherEntity
+	converted.Currency = to
+	if money.NormalizeCurrency(voucherEntity.Currency) == to {
+		return &converted, nil
+	}
+
+	minSpend, err := rates.Convert(money.New(voucherEntity.MinSpend, voucherEntity.Currency), to)
+	if err != nil {
+		return nil, err
+	}
+	converted.MinSpend = minSpend.Amount
+
+	if voucherEntity.MaxDiscount != nil {
+		maxDiscount, err := rates.Convert(money.New(*voucherEntity.MaxDiscount, voucherEntity.Currency), to)
+		if err != nil {
+			return nil, err
+		}
+		converted.MaxDiscount = &maxDiscount.Amount
+	}
+
+	if voucherEntity.DiscountType == entity.VoucherTypeFixedAmount {
+		discountValue, err := rates.Convert(money.New(int64(voucherEntity.DiscountValue), voucherEntity.Currency), to)
+		if err != nil {
+			return nil, err
+		}
+		converted.DiscountValue = float64(discountValue.Amount)
+	}
+
+	return &converted, nil
+}
+
+func (os *orderService) ApplyVoucher(ctx context.Context, request *order.ApplyVoucherRequest) (*order.ApplyVoucherResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rateRequest := shipping.RateRequest{}
+	if request.AddressId != "" {
+		addressEntity, err := os.addressRepository.GetAddressById(ctx, request.AddressId)
+		if err != nil {
+			return nil, err
+		}
+		if addressEntity == nil || addressEntity.UserId != claims.Subject {
+			return &order.ApplyVoucherResponse{
+				Base: utils.NotFoundResponse("Address not found"),
+			}, nil
+		}
+
+		rateRequest.Province = addressEntity.Province
+		rateRequest.City = addressEntity.City
+		rateRequest.PostalCode = addressEntity.PostalCode
+	}
+
+	pricing, failedResponse, err := os.calculateOrderPricing(ctx, os.productRepostory, os.voucherRepository, &orderPricingRequest{
+		userId:          claims.Subject,
+		products:        request.Products,
+		rateRequest:     &rateRequest,
+		shippingCourier: request.ShippingCourier,
+		shippingService: request.ShippingService,
+		voucherCode:     request.VoucherCode,
+		currency:        request.Currency,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if failedResponse != nil {
+		return &order.ApplyVoucherResponse{
+			Base: failedResponse,
+		}, nil
+	}
+
+	return &order.ApplyVoucherResponse{
+		Base:                  utils.SuccessResponse("Voucher is applicable"),
+		VoucherCode:           pricing.voucher.Code,
+		Subtotal:              utils.MajorAmount(pricing.subtotal, pricing.currency),
+		ShippingCost:          utils.MajorAmount(pricing.shippingRate.Cost, pricing.currency),
+		ProductDiscount:       utils.MajorAmount(pricing.discount.ProductDiscount, pricing.currency),
+		ShippingDiscount:      utils.MajorAmount(pricing.discount.ShippingDiscount, pricing.currency),
+		Discount:              utils.MajorAmount(pricing.discount.TotalDiscount(), pricing.currency),
+		Tax:                   utils.MajorAmount(pricing.tax.TaxAmount, pricing.currency),
+		Total:                 utils.MajorAmount(pricing.total, pricing.currency),
+		SubtotalMoney:         utils.MoneyResponse(pricing.subtotal, pricing.currency),
+		ShippingCostMoney:     utils.MoneyResponse(pricing.shippingRate.Cost, pricing.currency),
+		ProductDiscountMoney:  utils.MoneyResponse(pricing.discount.ProductDiscount, pricing.currency),
+		ShippingDiscountMoney: utils.MoneyResponse(pricing.discount.ShippingDiscount, pricing.currency),
+		DiscountMoney:         utils.MoneyResponse(pricing.discount.TotalDiscount(), pricing.currency),
+		TaxMoney:              utils.MoneyResponse(pricing.tax.TaxAmount, pricing.currency),
+		TotalMoney:            utils.MoneyResponse(pricing.total, pricing.currency),
+	}, nil
+}
+
+func NewOrderService(db *sql.DB, orderRepository repository.IOrderRepository, productRepository repository.IProductRepository, outboxRepository repository.IOutboxRepository, refundRepository repository.IRefundRepository, paymentGateway payment.IPaymentGateway, addressRepository repository.IAddressRepository, shippingRateCalculator shipping.IShippingRateCalculator, voucherRepository repository.IVoucherRepository, taxCalculator tax.ITaxCalculator, rateTable currency.IRateTable) IOrderService {
+	return &orderService{
+		db:               db,
+		orderRepository:  orderRepository,
+		productRepostory: productRepository,
+		outboxRepository: outboxRepository,
+		refundRepository: refundRepository,
+		paymentGateway:   paymentGateway,
+
+		addressRepository:      addressRepository,
+		shippingRateCalculator: shippingRateCalculator,
+		voucherRepository:      voucherRepository,
+		taxCalculator:          taxCalculator,
+		rateTable:              rateTable,
 	}
 }
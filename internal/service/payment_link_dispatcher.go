@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"runtime/debug"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/payment"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+)
+
+const (
+	paymentLinkDispatcherInterval    = 2 * time.Second
+	paymentLinkDispatcherBatchSize   = 10
+	paymentLinkDispatcherLease       = time.Minute
+	paymentLinkDispatcherMaxAttempts = 10
+	paymentLinkDispatcherBaseBackoff = 5 * time.Second
+	paymentLinkDispatcherMaxBackoff  = 10 * time.Minute
+)
+
+type IPaymentLinkDispatcher interface {
+	Run(ctx context.Context)
+	DispatchPending(ctx context.Context) error
+}
+
+type paymentLinkDispatcher struct {
+	db               *sql.DB
+	orderRepository  repository.IOrderRepository
+	outboxRepository repository.IOutboxRepository
+	paymentGateway   payment.IPaymentGateway
+}
+
+// Run memproses outbox create_invoice secara berkala sampai ctx dibatalkan
+func (pd *paymentLinkDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(paymentLinkDispatcherInterval)
+	defer ticker.Stop()
+
+	for {
+		err := pd.DispatchPending(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "payment link dispatcher error", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (pd *paymentLinkDispatcher) DispatchPending(ctx context.Context) error {
+	outboxes, err := pd.outboxRepository.ClaimPendingOutbox(
+		ctx,
+		entity.OutboxEventTypeCreateInvoice,
+		paymentLinkDispatcherBatchSize,
+		time.Now().Add(paymentLinkDispatcherLease),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, outbox := range outboxes {
+		err = pd.dispatch(ctx, outbox)
+		if err == nil {
+			err = pd.outboxRepository.MarkOutboxDone(ctx, outbox.Id)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		slog.WarnContext(ctx, "create invoice failed", "order_id", outbox.AggregateId, "attempt", outbox.AttemptCount, "error", err)
+		if outbox.AttemptCount >= paymentLinkDispatcherMaxAttempts {
+			err = pd.fail(ctx, outbox, err)
+		} else {
+			err = pd.outboxRepository.MarkOutboxRetry(ctx, outbox.Id, time.Now().Add(paymentLinkBackoff(outbox.AttemptCount)), err.Error())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (pd *paymentLinkDispatcher) dispatch(ctx context.Context, outbox *entity.Outbox) error {
+	var payload entity.CreateInvoicePayload
+	err := json.Unmarshal(outbox.Payload, &payload)
+	if err != nil {
+		return err
+	}
+
+	orderEntity, err := pd.orderRepository.GetOrderById(ctx, payload.OrderId)
+	if err != nil {
+		return err
+	}
+	if orderEntity == nil {
+		return errors.New("order not found")
+	}
+	// order sudah dibatalkan atau sudah punya invoice, tidak ada yang perlu dibuat
+	if orderEntity.OrderStatusCode != entity.OrderStatusCodePendingPaymentLink {
+		return nil
+	}
+
+	invoiceItems := make([]payment.InvoiceItem, 0)
+	for _, item := range orderEntity.Items {
+		invoiceItems = append(invoiceItems, payment.InvoiceItem{
+			Name:     item.ProductName,
+			Price:    item.ProductPrice,
+			Quantity: item.Quantity,
+		})
+	}
+	// ongkir ditampilkan sebagai item tersendiri agar jumlah item sama dengan total order
+	if orderEntity.ShippingCost > 0 {
+		shippingName := "Shipping"
+		if orderEntity.ShippingCourier != nil && orderEntity.ShippingService != nil {
+			shippingName = fmt.Sprintf("Shipping %s %s", *orderEntity.ShippingCourier, *orderEntity.ShippingService)
+		}
+		invoiceItems = append(invoiceItems, payment.InvoiceItem{
+			Name:     shippingName,
+			Price:    orderEntity.ShippingCost,
+			Quantity: 1,
+		})
+	}
+	// diskon voucher ditampilkan sebagai item bernilai negatif
+	if orderEntity.DiscountAmount > 0 {
+		discountName := "Discount"
+		if orderEntity.VoucherCode != nil {
+			discountName = fmt.Sprintf("Discount %s", *orderEntity.VoucherCode)
+		}
+		invoiceItems = append(invoiceItems, payment.InvoiceItem{
+			Name:     discountName,
+			Price:    -orderEntity.DiscountAmount,
+			Quantity: 1,
+		})
+	}
+	// pajak exclusive ditagihkan sebagai item tersendiri, pajak inclusive sudah termasuk di harga produk
+	if !orderEntity.TaxInclusive && orderEntity.TaxAmount > 0 {
+		taxName := "Tax"
+		if orderEntity.TaxName != nil {
+			taxName = fmt.Sprintf("%s %v%%", *orderEntity.TaxName, orderEntity.TaxRate)
+		}
+		invoiceItems = append(invoiceItems, payment.InvoiceItem{
+			Name:     taxName,
+			Price:    orderEntity.TaxAmount,
+			Quantity: 1,
+		})
+	}
+
+	// retry setelah invoice dibuat tetapi gagal disimpan memakai invoice yang sama agar order tidak punya dua link pembayaran
+	invoice, err := pd.paymentGateway.GetInvoiceByExternalId(ctx, orderEntity.Id)
+	if err != nil {
+		return err
+	}
+	if invoice == nil {
+		invoice, err = pd.paymentGateway.CreateInvoice(ctx, &payment.CreateInvoiceParams{
+			ExternalId:         orderEntity.Id,
+			Amount:             orderEntity.Total,
+			Currency:           orderEntity.Currency,
+			CustomerName:       payload.CustomerName,
+			SuccessRedirectUrl: fmt.Sprintf("%s/checkout/%s/success", os.Getenv("FRONTEND_BASE_URL"), orderEntity.Id),
+			Items:              invoiceItems,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	updatedBy := "System"
+	orderEntity.OrderStatusCode = entity.OrderStatusCodeUnpaid
+	orderEntity.XenditInvoiceId = &invoice.Id
+	orderEntity.XenditInvoiceUrl = &invoice.Url
+	orderEntity.UpdatedAt = &now
+	orderEntity.UpdatedBy = &updatedBy
+
+	updated, err := pd.orderRepository.UpdateOrderPaymentLink(ctx, orderEntity)
+	if err != nil {
+		return err
+	}
+	// order dibatalkan saat invoice sedang dibuat, invoice harus di-expire agar tidak bisa dibayar
+	if !updated {
+		slog.WarnContext(ctx, "order changed status while invoice was created, expiring invoice", "order_id", orderEntity.Id, "invoice_id", invoice.Id)
+		err = pd.paymentGateway.ExpireInvoice(ctx, invoice.Id)
+		if err != nil {
+			slog.ErrorContext(ctx, "expire invoice failed", "invoice_id", invoice.Id, "error", err)
+		}
+	}
+
+	return nil
+}
+
+// fail menandai outbox gagal permanen dan membatalkan order agar customer tidak menunggu selamanya.
+// Event order_canceled dikirim di transaksi yang sama agar customer mendapat email dan notifikasi pembatalan
+func (pd *paymentLinkDispatcher) fail(ctx context.Context, outbox *entity.Outbox, cause error) (err error) {
+	tx, err := pd.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	orderRepo := pd.orderRepository.WithTrancastion(tx)
+	outboxRepo := pd.outboxRepository.WithTrancastion(tx)
+
+	err = outboxRepo.MarkOutboxFailed(ctx, outbox.Id, cause.Error())
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	updatedBy := "System"
+	canceled, err := orderRepo.UpdateOrderPaymentLink(ctx, &entity.Order{
+		Id:              outbox.AggregateId,
+		OrderStatusCode: entity.OrderStatusCodeCanceled,
+		UpdatedAt:       &now,
+		UpdatedBy:       &updatedBy,
+	})
+	if err != nil {
+		return err
+	}
+	// order yang sudah berubah status (contoh: dibatalkan user) sudah mengirim event sendiri
+	if canceled {
+		err = orderRepo.ReleaseOrderStock(ctx, outbox.AggregateId)
+		if err != nil {
+			return err
+		}
+
+		err = publishOrderEvent(ctx, outboxRepo, outbox.AggregateId, entity.OrderEventCanceled, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func paymentLinkBackoff(attempt int) time.Duration {
+	backoff := paymentLinkDispatcherBaseBackoff
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if backoff >= paymentLinkDispatcherMaxBackoff {
+			return paymentLinkDispatcherMaxBackoff
+		}
+	}
+
+	return backoff
+}
+
+func NewPaymentLinkDispatcher(db *sql.DB, orderRepository repository.IOrderRepository, outboxRepository repository.IOutboxRepository, paymentGateway payment.IPaymentGateway) IPaymentLinkDispatcher {
+	return &paymentLinkDispatcher{
+		db:               db,
+		orderRepository:  orderRepository,
+		outboxRepository: outboxRepository,
+		paymentGateway:   paymentGateway,
+	}
+}
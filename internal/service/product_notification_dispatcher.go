@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/mailer"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
+)
+
+const (
+	productNotificationDispatcherInterval    = 5 * time.Second
+	productNotificationDispatcherBatchSize   = 10
+	productNotificationDispatcherLease       = 5 * time.Minute
+	productNotificationDispatcherMaxAttempts = 5
+	productNotificationDispatcherBackoff     = time.Minute
+
+	defaultProductNotificationUserLimit  = 3
+	defaultProductNotificationUserWindow = 24 * time.Hour
+)
+
+type IProductNotificationDispatcher interface {
+	Run(ctx context.Context)
+	DispatchPending(ctx context.Context) error
+}
+
+type productNotificationDispatcher struct {
+	productRepository             repository.IProductRepository
+	productSubscriptionRepository repository.IProductSubscriptionRepository
+	outboxRepository              repository.IOutboxRepository
+	mailer                        mailer.IMailer
+
+	// rate limit per user: maksimal userLimit email dalam userWindow
+	userLimit  int
+	userWindow time.Duration
+}
+
+// Run memproses outbox product_notification secara berkala sampai ctx dibatalkan
+func (nd *productNotificationDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(productNotificationDispatcherInterval)
+	defer ticker.Stop()
+
+	for {
+		err := nd.DispatchPending(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "product notification dispatcher error", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (nd *productNotificationDispatcher) DispatchPending(ctx context.Context) error {
+	outboxes, err := nd.outboxRepository.ClaimPendingOutbox(
+		ctx,
+		entity.OutboxEventTypeProductNotification,
+		productNotificationDispatcherBatchSize,
+		time.Now().Add(productNotificationDispatcherLease),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, outbox := range outboxes {
+		err = nd.dispatch(ctx, outbox)
+		if err == nil {
+			err = nd.outboxRepository.MarkOutboxDone(ctx, outbox.Id)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		slog.WarnContext(ctx, "product notification failed", "outbox_id", outbox.Id, "attempt", outbox.AttemptCount, "error", err)
+		if outbox.AttemptCount >= productNotificationDispatcherMaxAttempts {
+			err = nd.outboxRepository.MarkOutboxFailed(ctx, outbox.Id, err.Error())
+		} else {
+			err = nd.outboxRepository.MarkOutboxRetry(ctx, outbox.Id, time.Now().Add(productNotificationDispatcherBackoff*time.Duration(outbox.AttemptCount)), err.Error())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dispatch mengirim email ke setiap subscriber. Subscriber yang sudah tercatat di product_notification
+// dilewati sehingga retry tidak mengirim email dua kali
+func (nd *productNotificationDispatcher) dispatch(ctx context.Context, outbox *entity.Outbox) error {
+	var payload entity.ProductNotificationPayload
+	err := json.Unmarshal(outbox.Payload, &payload)
+	if err != nil {
+		return err
+	}
+
+	productEntity, err := nd.productRepository.GetProductById(ctx, payload.ProductId)
+	if err != nil {
+		return err
+	}
+	// produk sudah dihapus, notifikasi tidak relevan lagi
+	if productEntity == nil {
+		return nil
+	}
+
+	subscriptions, err := nd.productSubscriptionRepository.GetActiveSubscriptions(ctx, payload.ProductId, payload.EventType)
+	if err != nil {
+		return err
+	}
+
+	notified, err := nd.productSubscriptionRepository.GetNotifiedSubscriptionIds(ctx, outbox.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, subscription := range subscriptions {
+		if notified[subscription.Id] {
+			continue
+		}
+
+		status := entity.ProductNotificationStatusSent
+		sentCount, err := nd.productSubscriptionRepository.CountSentNotificationsSince(ctx, subscription.UserId, time.Now().Add(-nd.userWindow))
+		if err != nil {
+			return err
+		}
+		if sentCount >= nd.userLimit {
+			status = entity.ProductNotificationStatusRateLimited
+		} else {
+			err = nd.mailer.Send(ctx, productNotificationMessage(subscription, productEntity, &payload))
+			if err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		err = nd.productSubscriptionRepository.CreateNotification(ctx, &entity.ProductNotification{
+			Id:             uuid.NewString(),
+			OutboxId:       outbox.Id,
+			SubscriptionId: subscription.Id,
+			UserId:         subscription.UserId,
+			ProductId:      subscription.ProductId,
+			EventType:      subscription.EventType,
+			Status:         status,
+			CreatedAt:      now,
+		})
+		if err != nil {
+			return err
+		}
+
+		// langganan stok kembali tersedia cukup dikirim sekali
+		if status == entity.ProductNotificationStatusSent && subscription.EventType == entity.ProductEventBackInStock {
+			_, err = nd.productSubscriptionRepository.DeleteSubscriptionById(ctx, subscription.Id, now, "System")
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func productNotificationMessage(subscription *entity.ProductSubscription, productEntity *entity.Product, payload *entity.ProductNotificationPayload) *mailer.Message {
+	unsubscribeUrl := fmt.Sprintf(
+		"%s/product-subscription/unsubscribe?token=%s",
+		os.Getenv("REST_BASE_URL"),
+		url.QueryEscape(utils.NewSignedLinkToken(utils.SignedLinkPurposeProductUnsubscribe, subscription.Id)),
+	)
+	productUrl := fmt.Sprintf("%s/product/%s", os.Getenv("FRONTEND_BASE_URL"), productEntity.Id)
+
+	subject := fmt.Sprintf("%s is back in stock", productEntity.Name)
+	text := fmt.Sprintf("Hi %s,\n\n%s is available again.", subscription.UserFullName, productEntity.Name)
+	if payload.EventType == entity.ProductEventPriceDrop {
+		oldPrice := money.New(payload.OldPrice, payload.Currency)
+		newPrice := money.New(payload.NewPrice, payload.Currency)
+		subject = fmt.Sprintf("Price drop: %s", productEntity.Name)
+		text = fmt.Sprintf("Hi %s,\n\nThe price of %s dropped from %s to %s.", subscription.UserFullName, productEntity.Name, oldPrice, newPrice)
+	}
+
+	return &mailer.Message{
+		To:       subscription.UserEmail,
+		ToName:   subscription.UserFullName,
+		Subject:  subject,
+		TextBody: fmt.Sprintf("%s\n\n%s\n\nUnsubscribe: %s\n", text, productUrl, unsubscribeUrl),
+		HTMLBody: fmt.Sprintf(
+			"<p>%s</p><p><a href=\"%s\">View product</a></p><p><a href=\"%s\">Unsubscribe</a></p>",
+			html.EscapeString(text),
+			html.EscapeString(productUrl),
+			html.EscapeString(unsubscribeUrl),
+		),
+		Headers: map[string]string{
+			"List-Unsubscribe":      fmt.Sprintf("<%s>", unsubscribeUrl),
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+	}
+}
+
+// NewProductNotificationDispatcher membaca PRODUCT_NOTIFICATION_USER_LIMIT dan PRODUCT_NOTIFICATION_USER_WINDOW
+func NewProductNotificationDispatcher(productRepository repository.IProductRepository, productSubscriptionRepository repository.IProductSubscriptionRepository, outboxRepository repository.IOutboxRepository, mailer mailer.IMailer) IProductNotificationDispatcher {
+	userLimit := defaultProductNotificationUserLimit
+	if value := os.Getenv("PRODUCT_NOTIFICATION_USER_LIMIT"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			slog.Warn("invalid PRODUCT_NOTIFICATION_USER_LIMIT, using default", "value", value, "default", defaultProductNotificationUserLimit)
+		} else {
+			userLimit = limit
+		}
+	}
+
+	userWindow := defaultProductNotificationUserWindow
+	if value := os.Getenv("PRODUCT_NOTIFICATION_USER_WINDOW"); value != "" {
+		window, err := time.ParseDuration(value)
+		if err != nil || window <= 0 {
+			slog.Warn("invalid PRODUCT_NOTIFICATION_USER_WINDOW, using default", "value", value, "default", defaultProductNotificationUserWindow)
+		} else {
+			userWindow = window
+		}
+	}
+
+	return &productNotificationDispatcher{
+		productRepository:             productRepository,
+		productSubscriptionRepository: productSubscriptionRepository,
+		outboxRepository:              outboxRepository,
+		mailer:                        mailer,
+		userLimit:                     userLimit,
+		userWindow:                    userWindow,
+	}
+}
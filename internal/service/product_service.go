@@ -2,14 +2,20 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"runtime/debug"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/currency"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
 	jwtentity "github.com/luzmareto/go-grpc-ecommerce-be/internal/entity/jwt"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/product"
@@ -26,7 +32,10 @@ type IProductService interface {
 }
 
 type productService struct {
+	db                *sql.DB
 	productRepository repository.IProductRepository
+	outboxRepository  repository.IOutboxRepository
+	rateTable         currency.IRateTable
 }
 
 
@@ -37,7 +46,7 @@ func (ps *productService) CreateProduct(ctx context.Context, request *product.Cr
 	}
 	// hanya admin yang bisa create product
 	if claims.Role != entity.UserRoleAdmin {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, utils.PermissionDeniedResponse()
 	}
 
 	imagePath := filepath.Join("storage", "product", request.ImageFileName)
@@ -52,12 +61,29 @@ func (ps *productService) CreateProduct(ctx context.Context, request *product.Cr
 		return nil, err
 	}
 
+	// field double lama dianggap rupiah
+	price := utils.MoneyFromRequest(request.PriceMoney, request.Price, money.CurrencyIDR)
+	if price.Amount < 0 {
+		return &product.CreateProductResponse{
+			Base: utils.BadRequestResponse("Price cannot be negative"),
+		}, nil
+	}
+	if !ps.rateTable.IsSupported(price.Currency) {
+		return &product.CreateProductResponse{
+			Base: utils.BadRequestResponse("Currency is not supported"),
+		}, nil
+	}
+
 	productEntity := entity.Product{
 		Id:            uuid.NewString(),
 		Name:          request.Name,
 		Description:   request.Description,
-		Price:         request.Price,
+		Price:         price.Amount,
+		Currency:      price.Currency,
 		ImageFileName: request.ImageFileName,
+		WeightGram:    request.WeightGram,
+		Category:      request.Category,
+		Stock:         request.Stock,
 		CreatedAt:     time.Now(),
 		CreatedBy:     claims.FullName,
 	}
@@ -73,6 +99,12 @@ func (ps *productService) CreateProduct(ctx context.Context, request *product.Cr
 }
 
 func (ps *productService) DetailProduct(ctx context.Context, request *product.DetailProductRequest) (*product.DetailProductResponse, error) {
+	if request.Currency != "" && !ps.rateTable.IsSupported(request.Currency) {
+		return &product.DetailProductResponse{
+			Base: utils.BadRequestResponse("Currency is not supported"),
+		}, nil
+	}
+
 	productEntity, err := ps.productRepository.GetProductById(ctx, request.Id)
 	if err != nil {
 		return nil, err
@@ -82,13 +114,22 @@ func (ps *productService) DetailProduct(ctx context.Context, request *product.De
 			Base: utils.NotFoundResponse("Product not found"),
 		}, nil
 	}
+	price, err := ps.rateTable.Convert(money.New(productEntity.Price, productEntity.Currency), request.Currency)
+	if err != nil {
+		return nil, err
+	}
+
 	return &product.DetailProductResponse{
 		Base:        utils.SuccessResponse("Get product detail success"),
 		Id:          productEntity.Id,
 		Name:        productEntity.Name,
 		Description: productEntity.Description,
-		Price:       productEntity.Price,
+		Price:       utils.MajorAmount(price.Amount, price.Currency),
+		PriceMoney:  utils.MoneyResponse(price.Amount, price.Currency),
 		ImageUrl:    fmt.Sprintf("%s/product/%s", os.Getenv("STORAGE_SERVICE_URL"), productEntity.ImageFileName),
+		WeightGram:  productEntity.WeightGram,
+		Category:    productEntity.Category,
+		Stock:       productEntity.Stock,
 	}, nil
 }
 
@@ -99,7 +140,7 @@ func (ps *productService) EditProduct(ctx context.Context, request *product.Edit
 	}
 	// hanya admin yang bisa create product
 	if claims.Role != entity.UserRoleAdmin {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, utils.PermissionDeniedResponse()
 	}
 
 	productEntity, err := ps.productRepository.GetProductById(ctx, request.Id)
@@ -132,17 +173,88 @@ func (ps *productService) EditProduct(ctx context.Context, request *product.Edit
 		}
 	}
 
+	price := utils.MoneyFromRequest(request.PriceMoney, request.Price, productEntity.Currency)
+	if price.Amount < 0 {
+		return &product.EditProductResponse{
+			Base: utils.BadRequestResponse("Price cannot be negative"),
+		}, nil
+	}
+	if !ps.rateTable.IsSupported(price.Currency) {
+		return &product.EditProductResponse{
+			Base: utils.BadRequestResponse("Currency is not supported"),
+		}, nil
+	}
+
+	// stok tidak dikirim berarti stok tidak diubah
+	stock := productEntity.Stock
+	if request.Stock != nil {
+		stock = request.Stock
+	}
+
 	newProduct := entity.Product{
 		Id:            request.Id,
 		Name:          request.Name,
 		Description:   request.Description,
-		Price:         request.Price,
+		Price:         price.Amount,
+		Currency:      price.Currency,
 		ImageFileName: request.ImageFileName,
+		WeightGram:    request.WeightGram,
+		Category:      request.Category,
+		Stock:         stock,
 		UpdatedAt:     time.Now(),
 		UpdatedBy:     &claims.FullName,
 	}
 
-	err = ps.productRepository.UpdateProduct(ctx, &newProduct)
+	tx, err := ps.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	err = ps.productRepository.WithTrancastion(tx).UpdateProduct(ctx, &newProduct)
+	if err != nil {
+		return nil, err
+	}
+
+	// notifikasi ke subscriber dikirim dispatcher setelah transaksi commit
+	outboxRepo := ps.outboxRepository.WithTrancastion(tx)
+	for _, payload := range ps.productEvents(productEntity, &newProduct) {
+		var payloadJson []byte
+		payloadJson, err = json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		err = outboxRepo.CreateOutbox(ctx, &entity.Outbox{
+			Id:            uuid.NewString(),
+			EventType:     entity.OutboxEventTypeProductNotification,
+			AggregateId:   newProduct.Id,
+			Payload:       payloadJson,
+			Status:        entity.OutboxStatusPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
@@ -152,6 +264,38 @@ func (ps *productService) EditProduct(ctx context.Context, request *product.Edit
 		Id:   request.Id,
 	}, nil
 }
+
+// productEvents menentukan event harga turun dan stok kembali tersedia dari perubahan produk
+func (ps *productService) productEvents(oldProduct *entity.Product, newProduct *entity.Product) []*entity.ProductNotificationPayload {
+	events := make([]*entity.ProductNotificationPayload, 0)
+
+	// harga lama dikonversi ke mata uang baru agar perubahan mata uang tidak dianggap harga turun
+	oldPrice, err := ps.rateTable.Convert(money.New(oldProduct.Price, oldProduct.Currency), newProduct.Currency)
+	if err != nil {
+		slog.Warn("skip price drop check", "product_id", newProduct.Id, "error", err)
+	} else if newProduct.Price < oldPrice.Amount {
+		events = append(events, &entity.ProductNotificationPayload{
+			ProductId: newProduct.Id,
+			EventType: entity.ProductEventPriceDrop,
+			OldPrice:  oldPrice.Amount,
+			NewPrice:  newProduct.Price,
+			Currency:  newProduct.Currency,
+		})
+	}
+
+	if oldProduct.Stock != nil && *oldProduct.Stock == 0 && newProduct.Stock != nil && *newProduct.Stock > 0 {
+		events = append(events, &entity.ProductNotificationPayload{
+			ProductId: newProduct.Id,
+			EventType: entity.ProductEventBackInStock,
+			OldPrice:  oldPrice.Amount,
+			NewPrice:  newProduct.Price,
+			Currency:  newProduct.Currency,
+		})
+	}
+
+	return events
+}
+
 func (ps *productService) DeleteProduct(ctx context.Context, request *product.DeleteProductRequest) (*product.DeleteProductResponse, error) {
 	claims, err := jwtentity.GetClaimsFromContext(ctx)
 	if err != nil {
@@ -159,7 +303,7 @@ func (ps *productService) DeleteProduct(ctx context.Context, request *product.De
 	}
 	// hanya admin yang bisa create product
 	if claims.Role != entity.UserRoleAdmin {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, utils.PermissionDeniedResponse()
 	}
 
 	productEntity, err := ps.productRepository.GetProductById(ctx, request.Id)
@@ -183,6 +327,12 @@ func (ps *productService) DeleteProduct(ctx context.Context, request *product.De
 }
 
 func (ps *productService) ListProduct(ctx context.Context, request *product.ListProductRequest) (*product.ListProductResponse, error) {
+	if request.Currency != "" && !ps.rateTable.IsSupported(request.Currency) {
+		return &product.ListProductResponse{
+			Base: utils.BadRequestResponse("Currency is not supported"),
+		}, nil
+	}
+
 	products, paginationResponse, err := ps.productRepository.GetProductsPagination(ctx, request.Pagination)
 	if err != nil {
 		return nil, err
@@ -190,11 +340,17 @@ func (ps *productService) ListProduct(ctx context.Context, request *product.List
 
 	var data []*product.ListProductResponseItem = make([]*product.ListProductResponseItem, 0)
 	for _, prod := range products {
+		price, err := ps.rateTable.Convert(money.New(prod.Price, prod.Currency), request.Currency)
+		if err != nil {
+			return nil, err
+		}
+
 		data = append(data, &product.ListProductResponseItem{
 			Id:          prod.Id,
 			Name:        prod.Name,
 			Description: prod.Description,
-			Price:       prod.Price,
+			Price:       utils.MajorAmount(price.Amount, price.Currency),
+			PriceMoney:  utils.MoneyResponse(price.Amount, price.Currency),
 			ImageUrl:    fmt.Sprintf("%s/products/%s", os.Getenv("STORAGE_SERVICE_URL"), prod.ImageFileName),
 		})
 	}
@@ -213,7 +369,7 @@ func (ps *productService) ListProductAdmin(ctx context.Context, request *product
 	}
 	// hanya admin yang bisa create product
 	if claims.Role != entity.UserRoleAdmin {
-		return nil, utils.UnauthenticatedResponse()
+		return nil, utils.PermissionDeniedResponse()
 	}
 
 	products, paginationResponse, err := ps.productRepository.GetProductsPaginationAdmin(ctx, request.Pagination)
@@ -227,7 +383,8 @@ func (ps *productService) ListProductAdmin(ctx context.Context, request *product
 			Id:          prod.Id,
 			Name:        prod.Name,
 			Description: prod.Description,
-			Price:       prod.Price,
+			Price:       utils.MajorAmount(prod.Price, prod.Currency),
+			PriceMoney:  utils.MoneyResponse(prod.Price, prod.Currency),
 			ImageUrl:    fmt.Sprintf("%s/products/%s", os.Getenv("STORAGE_SERVICE_URL"), prod.ImageFileName),
 		})
 	}
@@ -241,6 +398,12 @@ func (ps *productService) ListProductAdmin(ctx context.Context, request *product
 
 
 func (ps *productService) HighlightProducts(ctx context.Context,request *product.HighlightProductsRequest) (*product.HighlightProductsResponse, error) {
+	if request.Currency != "" && !ps.rateTable.IsSupported(request.Currency) {
+		return &product.HighlightProductsResponse{
+			Base: utils.BadRequestResponse("Currency is not supported"),
+		}, nil
+	}
+
 	products,err := ps.productRepository.GetProductHighlight(ctx)
 	if err != nil {
 		return nil, err
@@ -248,11 +411,17 @@ func (ps *productService) HighlightProducts(ctx context.Context,request *product
 
 	var data []*product.HighlightProductsResponseItem = make([]*product.HighlightProductsResponseItem, 0)
 	for _, prod := range products {
+		price, err := ps.rateTable.Convert(money.New(prod.Price, prod.Currency), request.Currency)
+		if err != nil {
+			return nil, err
+		}
+
 		data = append(data, &product.HighlightProductsResponseItem{
 			Id:          prod.Id,
 			Name:        prod.Name,
 			Description: prod.Description,
-			Price:       prod.Price,
+			Price:       utils.MajorAmount(price.Amount, price.Currency),
+			PriceMoney:  utils.MoneyResponse(price.Amount, price.Currency),
 			ImageUrl:    fmt.Sprintf("%s/products/%s", os.Getenv("STORAGE_SERVICE_URL"), prod.ImageFileName),
 		})
 	}
@@ -263,8 +432,11 @@ func (ps *productService) HighlightProducts(ctx context.Context,request *product
 	}, nil
 }
 
-func NewProductService(productRepository repository.IProductRepository) IProductService {
+func NewProductService(db *sql.DB, productRepository repository.IProductRepository, outboxRepository repository.IOutboxRepository, rateTable currency.IRateTable) IProductService {
 	return &productService{
+		db:                db,
 		productRepository: productRepository,
+		outboxRepository:  outboxRepository,
+		rateTable:         rateTable,
 	}
 }
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	jwtentity "github.com/luzmareto/go-grpc-ecommerce-be/internal/entity/jwt"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/product"
+)
+
+type IProductSubscriptionService interface {
+	SubscribeProductEvent(ctx context.Context, request *product.SubscribeProductEventRequest) (*product.SubscribeProductEventResponse, error)
+	UnsubscribeProductEvent(ctx context.Context, request *product.UnsubscribeProductEventRequest) (*product.UnsubscribeProductEventResponse, error)
+	UnsubscribeByToken(ctx context.Context, token string) error
+}
+
+type productSubscriptionService struct {
+	productRepository             repository.IProductRepository
+	productSubscriptionRepository repository.IProductSubscriptionRepository
+}
+
+func (pss *productSubscriptionService) SubscribeProductEvent(ctx context.Context, request *product.SubscribeProductEventRequest) (*product.SubscribeProductEventResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	productEntity, err := pss.productRepository.GetProductById(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productEntity == nil {
+		return &product.SubscribeProductEventResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	subscription := entity.ProductSubscription{
+		Id:        uuid.NewString(),
+		UserId:    claims.Subject,
+		ProductId: productEntity.Id,
+		EventType: request.EventType,
+		CreatedAt: time.Now(),
+		CreatedBy: claims.FullName,
+	}
+	err = pss.productSubscriptionRepository.UpsertSubscription(ctx, &subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product.SubscribeProductEventResponse{
+		Base: utils.SuccessResponse("Subscribe product event success"),
+		Id:   subscription.Id,
+	}, nil
+}
+
+func (pss *productSubscriptionService) UnsubscribeProductEvent(ctx context.Context, request *product.UnsubscribeProductEventRequest) (*product.UnsubscribeProductEventResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := pss.productSubscriptionRepository.DeleteSubscription(ctx, claims.Subject, request.ProductId, request.EventType, time.Now(), claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return &product.UnsubscribeProductEventResponse{
+			Base: utils.NotFoundResponse("Subscription not found"),
+		}, nil
+	}
+
+	return &product.UnsubscribeProductEventResponse{
+		Base: utils.SuccessResponse("Unsubscribe product event success"),
+	}, nil
+}
+
+// UnsubscribeByToken dipakai link unsubscribe di email, langganan yang sudah berhenti dianggap berhasil
+func (pss *productSubscriptionService) UnsubscribeByToken(ctx context.Context, token string) error {
+	subscriptionId, err := utils.ParseSignedLinkToken(utils.SignedLinkPurposeProductUnsubscribe, token)
+	if err != nil {
+		return err
+	}
+
+	_, err = pss.productSubscriptionRepository.DeleteSubscriptionById(ctx, subscriptionId, time.Now(), "Unsubscribe Link")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewProductSubscriptionService(productRepository repository.IProductRepository, productSubscriptionRepository repository.IProductSubscriptionRepository) IProductSubscriptionService {
+	return &productSubscriptionService{
+		productRepository:             productRepository,
+		productSubscriptionRepository: productSubscriptionRepository,
+	}
+}
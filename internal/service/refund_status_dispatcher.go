@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/payment"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+)
+
+const (
+	refundStatusDispatcherInterval  = time.Minute
+	refundStatusDispatcherBatchSize = 20
+	// refund yang baru di-approve diberi jeda sebelum status ditanyakan ulang ke provider
+	refundStatusDispatcherMinAge = time.Minute
+)
+
+type IRefundStatusDispatcher interface {
+	Run(ctx context.Context)
+	DispatchPending(ctx context.Context) error
+}
+
+// refundStatusDispatcher menyelesaikan refund yang dijawab PENDING oleh provider saat approve
+// dan refund yang gagal disimpan setelah dikirim ke provider
+type refundStatusDispatcher struct {
+	db               *sql.DB
+	orderRepository  repository.IOrderRepository
+	refundRepository repository.IRefundRepository
+	paymentGateway   payment.IPaymentGateway
+}
+
+// Run mengecek refund processing secara berkala sampai ctx dibatalkan
+func (rd *refundStatusDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(refundStatusDispatcherInterval)
+	defer ticker.Stop()
+
+	for {
+		err := rd.DispatchPending(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "refund status dispatcher error", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (rd *refundStatusDispatcher) DispatchPending(ctx context.Context) error {
+	refunds, err := rd.refundRepository.GetProcessingRefunds(ctx, time.Now().Add(-refundStatusDispatcherMinAge), refundStatusDispatcherBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, refund := range refunds {
+		var providerRefund *payment.Refund
+		if refund.ProviderRefundId == nil {
+			providerRefund, err = rd.resend(ctx, refund)
+		} else {
+			providerRefund, err = rd.paymentGateway.GetRefund(ctx, *refund.ProviderRefundId)
+		}
+		if err != nil {
+			// refund lain tetap dicek, refund ini dicoba lagi di putaran berikutnya
+			slog.WarnContext(ctx, "get refund status failed", "refund_id", refund.Id, "error", err)
+			continue
+		}
+		if providerRefund.Status != payment.RefundStatusSucceeded && providerRefund.Status != payment.RefundStatusFailed {
+			continue
+		}
+
+		err = rd.complete(ctx, refund, providerRefund.Status)
+		if err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "refund completed", "refund_id", refund.Id, "order_id", refund.OrderId, "status", refund.Status)
+	}
+
+	return nil
+}
+
+// resend mengirim ulang refund yang provider_refund_id-nya belum tersimpan. Reference id sama dengan saat approve
+// sehingga provider mengembalikan refund yang sudah dibuat, bukan membuat refund baru
+func (rd *refundStatusDispatcher) resend(ctx context.Context, refund *entity.OrderRefund) (*payment.Refund, error) {
+	orderEntity, err := rd.orderRepository.GetOrderById(ctx, refund.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if orderEntity == nil || orderEntity.XenditInvoiceId == nil {
+		return nil, fmt.Errorf("order %s has no payment to refund", refund.OrderId)
+	}
+
+	providerRefund, err := rd.paymentGateway.Refund(ctx, &payment.RefundParams{
+		InvoiceId:   *orderEntity.XenditInvoiceId,
+		ReferenceId: refund.Id,
+		Amount:      refund.Amount,
+		Currency:    orderEntity.Currency,
+		Reason:      refund.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	updatedBy := "System"
+	refund.ProviderRefundId = &providerRefund.Id
+	refund.UpdatedAt = &now
+	refund.UpdatedBy = &updatedBy
+	_, err = rd.refundRepository.UpdateRefundStatus(ctx, refund, entity.RefundStatusProcessing)
+	if err != nil {
+		return nil, err
+	}
+
+	return providerRefund, nil
+}
+
+func (rd *refundStatusDispatcher) complete(ctx context.Context, refund *entity.OrderRefund, providerStatus string) (err error) {
+	tx, err := rd.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	orderRepo := rd.orderRepository.WithTrancastion(tx)
+	refundRepo := rd.refundRepository.WithTrancastion(tx)
+
+	orderEntity, err := orderRepo.GetOrderById(ctx, refund.OrderId)
+	if err != nil {
+		return err
+	}
+	if orderEntity == nil {
+		err = fmt.Errorf("order %s not found", refund.OrderId)
+		return err
+	}
+
+	_, err = applyRefundStatus(ctx, orderRepo, refundRepo, orderEntity, refund, providerStatus, time.Now(), "System")
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewRefundStatusDispatcher(db *sql.DB, orderRepository repository.IOrderRepository, refundRepository repository.IRefundRepository, paymentGateway payment.IPaymentGateway) IRefundStatusDispatcher {
+	return &refundStatusDispatcher{
+		db:               db,
+		orderRepository:  orderRepository,
+		refundRepository: refundRepository,
+		paymentGateway:   paymentGateway,
+	}
+}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	jwtentity "github.com/luzmareto/go-grpc-ecommerce-be/internal/entity/jwt"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/shipping"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
+	pbshipping "github.com/luzmareto/go-grpc-ecommerce-be/pb/shipping"
+)
+
+type IShippingService interface {
+	CreateAddress(ctx context.Context, request *pbshipping.CreateAddressRequest) (*pbshipping.CreateAddressResponse, error)
+	ListAddress(ctx context.Context, request *pbshipping.ListAddressRequest) (*pbshipping.ListAddressResponse, error)
+	UpdateAddress(ctx context.Context, request *pbshipping.UpdateAddressRequest) (*pbshipping.UpdateAddressResponse, error)
+	DeleteAddress(ctx context.Context, request *pbshipping.DeleteAddressRequest) (*pbshipping.DeleteAddressResponse, error)
+	GetShippingRates(ctx context.Context, request *pbshipping.GetShippingRatesRequest) (*pbshipping.GetShippingRatesResponse, error)
+}
+
+type shippingService struct {
+	db                     *sql.DB
+	addressRepository      repository.IAddressRepository
+	productRepository      repository.IProductRepository
+	shippingRateCalculator shipping.IShippingRateCalculator
+}
+
+func (ss *shippingService) CreateAddress(ctx context.Context, request *pbshipping.CreateAddressRequest) (*pbshipping.CreateAddressResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := ss.addressRepository.GetAddressesByUserId(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	addressEntity := entity.UserAddress{
+		Id:            uuid.NewString(),
+		UserId:        claims.Subject,
+		Label:         request.Label,
+		RecipientName: request.RecipientName,
+		PhoneNumber:   request.PhoneNumber,
+		Address:       request.Address,
+		City:          request.City,
+		Province:      request.Province,
+		PostalCode:    request.PostalCode,
+		// alamat pertama otomatis menjadi alamat utama
+		IsDefault: request.IsDefault || len(addresses) == 0,
+		CreatedAt: time.Now(),
+		CreatedBy: claims.FullName,
+	}
+
+	err = ss.saveAddress(ctx, &addressEntity, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbshipping.CreateAddressResponse{
+		Base: utils.SuccessResponse("Create address success"),
+		Id:   addressEntity.Id,
+	}, nil
+}
+
+func (ss *shippingService) ListAddress(ctx context.Context, request *pbshipping.ListAddressRequest) (*pbshipping.ListAddressResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := ss.addressRepository.GetAddressesByUserId(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*pbshipping.ListAddressResponseItem, 0)
+	for _, address := range addresses {
+		items = append(items, &pbshipping.ListAddressResponseItem{
+			Id:            address.Id,
+			Label:         address.Label,
+			RecipientName: address.RecipientName,
+			PhoneNumber:   address.PhoneNumber,
+			Address:       address.Address,
+			City:          address.City,
+			Province:      address.Province,
+			PostalCode:    address.PostalCode,
+			IsDefault:     address.IsDefault,
+		})
+	}
+
+	return &pbshipping.ListAddressResponse{
+		Base:  utils.SuccessResponse("Get list address success"),
+		Items: items,
+	}, nil
+}
+
+func (ss *shippingService) UpdateAddress(ctx context.Context, request *pbshipping.UpdateAddressRequest) (*pbshipping.UpdateAddressResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addressEntity, err := ss.addressRepository.GetAddressById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if addressEntity == nil || addressEntity.UserId != claims.Subject {
+		return &pbshipping.UpdateAddressResponse{
+			Base: utils.NotFoundResponse("Address not found"),
+		}, nil
+	}
+
+	now := time.Now()
+	addressEntity.Label = request.Label
+	addressEntity.RecipientName = request.RecipientName
+	addressEntity.PhoneNumber = request.PhoneNumber
+	addressEntity.Address = request.Address
+	addressEntity.City = request.City
+	addressEntity.Province = request.Province
+	addressEntity.PostalCode = request.PostalCode
+	// alamat utama hanya berpindah saat alamat lain dijadikan utama
+	addressEntity.IsDefault = addressEntity.IsDefault || request.IsDefault
+	addressEntity.UpdatedAt = &now
+	addressEntity.UpdatedBy = &claims.FullName
+
+	err = ss.saveAddress(ctx, addressEntity, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbshipping.UpdateAddressResponse{
+		Base: utils.SuccessResponse("Update address success"),
+	}, nil
+}
+
+// saveAddress menyimpan alamat, jika alamat dijadikan utama alamat utama sebelumnya dilepas di transaksi yang sama
+func (ss *shippingService) saveAddress(ctx context.Context, addressEntity *entity.UserAddress, isNew bool) error {
+	tx, err := ss.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	addressRepo := ss.addressRepository.WithTrancastion(tx)
+
+	if addressEntity.IsDefault {
+		err = addressRepo.UnsetDefaultAddress(ctx, addressEntity.UserId)
+		if err != nil {
+			return err
+		}
+	}
+
+	if isNew {
+		err = addressRepo.CreateAddress(ctx, addressEntity)
+	} else {
+		err = addressRepo.UpdateAddress(ctx, addressEntity)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ss *shippingService) DeleteAddress(ctx context.Context, request *pbshipping.DeleteAddressRequest) (*pbshipping.DeleteAddressResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addressEntity, err := ss.addressRepository.GetAddressById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if addressEntity == nil || addressEntity.UserId != claims.Subject {
+		return &pbshipping.DeleteAddressResponse{
+			Base: utils.NotFoundResponse("Address not found"),
+		}, nil
+	}
+
+	err = ss.addressRepository.DeleteAddress(ctx, addressEntity.Id, time.Now(), claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbshipping.DeleteAddressResponse{
+		Base: utils.SuccessResponse("Delete address success"),
+	}, nil
+}
+
+func (ss *shippingService) GetShippingRates(ctx context.Context, request *pbshipping.GetShippingRatesRequest) (*pbshipping.GetShippingRatesResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addressEntity, err := ss.addressRepository.GetAddressById(ctx, request.AddressId)
+	if err != nil {
+		return nil, err
+	}
+	if addressEntity == nil || addressEntity.UserId != claims.Subject {
+		return &pbshipping.GetShippingRatesResponse{
+			Base: utils.NotFoundResponse("Address not found"),
+		}, nil
+	}
+
+	productIds := make([]string, len(request.Products))
+	for i := range request.Products {
+		productIds[i] = request.Products[i].Id
+	}
+
+	products, err := ss.productRepository.GetProductsByIds(ctx, productIds)
+	if err != nil {
+		return nil, err
+	}
+
+	productMap := make(map[string]*entity.Product)
+	for i := range products {
+		productMap[products[i].Id] = products[i]
+	}
+
+	var weightGram int64 = 0
+	for _, p := range request.Products {
+		if productMap[p.Id] == nil {
+			return &pbshipping.GetShippingRatesResponse{
+				Base: utils.NotFoundResponse(fmt.Sprintf("Product %s not found", p.Id)),
+			}, nil
+		}
+		weightGram += productMap[p.Id].WeightGram * p.Quantity
+	}
+
+	rates, err := ss.shippingRateCalculator.GetRates(ctx, &shipping.RateRequest{
+		Province:   addressEntity.Province,
+		City:       addressEntity.City,
+		PostalCode: addressEntity.PostalCode,
+		WeightGram: weightGram,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*pbshipping.GetShippingRatesResponseItem, 0)
+	for _, rate := range rates {
+		items = append(items, &pbshipping.GetShippingRatesResponseItem{
+			Courier:   rate.Courier,
+			Service:   rate.Service,
+			Cost:      utils.MajorAmount(rate.Cost, rate.Currency),
+			EtdDays:   rate.EtdDays,
+			CostMoney: utils.MoneyResponse(rate.Cost, rate.Currency),
+		})
+	}
+
+	return &pbshipping.GetShippingRatesResponse{
+		Base:       utils.SuccessResponse("Get shipping rates success"),
+		WeightGram: weightGram,
+		Items:      items,
+	}, nil
+}
+
+func NewShippingService(db *sql.DB, addressRepository repository.IAddressRepository, productRepository repository.IProductRepository, shippingRateCalculator shipping.IShippingRateCalculator) IShippingService {
+	return &shippingService{
+		db:                     db,
+		addressRepository:      addressRepository,
+		productRepository:      productRepository,
+		shippingRateCalculator: shippingRateCalculator,
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+	gocache "github.com/patrickmn/go-cache"
+)
+
+const tokenRevocationCleanupInterval = time.Hour
+
+// ITokenRevocationService menyimpan token yang sudah logout. Daftar token ada di database sehingga
+// logout di satu server langsung berlaku di server grpc dan rest lain
+type ITokenRevocationService interface {
+	Revoke(ctx context.Context, tokenStr string, expiresAt time.Time) error
+	IsRevoked(ctx context.Context, tokenStr string) (bool, error)
+	Run(ctx context.Context)
+}
+
+type tokenRevocationService struct {
+	revokedTokenRepository repository.IRevokedTokenRepository
+	// cache lokal hanya untuk token yang sudah pasti dicabut, token yang masih valid selalu dicek ke database
+	cacheService *gocache.Cache
+}
+
+func (ts *tokenRevocationService) Revoke(ctx context.Context, tokenStr string, expiresAt time.Time) error {
+	tokenHash := hashToken(tokenStr)
+	err := ts.revokedTokenRepository.CreateRevokedToken(ctx, tokenHash, expiresAt, time.Now())
+	if err != nil {
+		return err
+	}
+	ts.cacheService.Set(tokenHash, "", time.Until(expiresAt))
+
+	return nil
+}
+
+func (ts *tokenRevocationService) IsRevoked(ctx context.Context, tokenStr string) (bool, error) {
+	tokenHash := hashToken(tokenStr)
+	if _, ok := ts.cacheService.Get(tokenHash); ok {
+		return true, nil
+	}
+
+	return ts.revokedTokenRepository.IsTokenRevoked(ctx, tokenHash)
+}
+
+// Run menghapus token expired dari database secara berkala sampai ctx dibatalkan
+func (ts *tokenRevocationService) Run(ctx context.Context) {
+	ticker := time.NewTicker(tokenRevocationCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		_, err := ts.revokedTokenRepository.DeleteExpiredRevokedTokens(ctx, time.Now())
+		if err != nil {
+			slog.ErrorContext(ctx, "delete expired revoked tokens failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func hashToken(tokenStr string) string {
+	hash := sha256.Sum256([]byte(tokenStr))
+	return hex.EncodeToString(hash[:])
+}
+
+func NewTokenRevocationService(revokedTokenRepository repository.IRevokedTokenRepository, cacheService *gocache.Cache) ITokenRevocationService {
+	return &tokenRevocationService{
+		revokedTokenRepository: revokedTokenRepository,
+		cacheService:           cacheService,
+	}
+}
@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	jwtentity "github.com/luzmareto/go-grpc-ecommerce-be/internal/entity/jwt"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/voucher"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type IVoucherService interface {
+	CreateVoucher(ctx context.Context, request *voucher.CreateVoucherRequest) (*voucher.CreateVoucherResponse, error)
+	EditVoucher(ctx context.Context, request *voucher.EditVoucherRequest) (*voucher.EditVoucherResponse, error)
+	DeleteVoucher(ctx context.Context, request *voucher.DeleteVoucherRequest) (*voucher.DeleteVoucherResponse, error)
+	ListVoucherAdmin(ctx context.Context, request *voucher.ListVoucherAdminRequest) (*voucher.ListVoucherAdminResponse, error)
+}
+
+type voucherService struct {
+	voucherRepository repository.IVoucherRepository
+}
+
+func (vs *voucherService) CreateVoucher(ctx context.Context, request *voucher.CreateVoucherRequest) (*voucher.CreateVoucherResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// hanya admin yang bisa mengelola voucher
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(request.Code))
+	existingVoucher, err := vs.voucherRepository.GetVoucherByCode(ctx, code, false)
+	if err != nil {
+		return nil, err
+	}
+	if existingVoucher != nil {
+		return &voucher.CreateVoucherResponse{
+			Base: utils.BadRequestResponse("Voucher code already exists"),
+		}, nil
+	}
+
+	message := validateVoucherRule(request.DiscountType, request.DiscountValue, request.StartsAt, request.EndsAt)
+	if message != "" {
+		return &voucher.CreateVoucherResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
+
+	rule, message := voucherMoneyRule(request.Currency, request.DiscountType, request.DiscountValue, request.MaxDiscountMoney, request.MaxDiscount, request.MinSpendMoney, request.MinSpend)
+	if message != "" {
+		return &voucher.CreateVoucherResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
+
+	voucherEntity := entity.Voucher{
+		Id:                uuid.NewString(),
+		Code:              code,
+		Description:       request.Description,
+		DiscountType:      request.DiscountType,
+		DiscountValue:     rule.discountValue,
+		MaxDiscount:       optionalInt(rule.maxDiscount),
+		MinSpend:          rule.minSpend,
+		Currency:          rule.currency,
+		UsageLimit:        optionalInt(request.UsageLimit),
+		PerUserUsageLimit: optionalInt(request.PerUserUsageLimit),
+		StartsAt:          request.StartsAt.AsTime(),
+		EndsAt:            request.EndsAt.AsTime(),
+		ProductIds:        request.ProductIds,
+		Categories:        request.Categories,
+		IsActive:          request.IsActive,
+		CreatedAt:         time.Now(),
+		CreatedBy:         claims.FullName,
+	}
+
+	err = vs.voucherRepository.CreateVoucher(ctx, &voucherEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &voucher.CreateVoucherResponse{
+		Base: utils.SuccessResponse("Voucher is created"),
+		Id:   voucherEntity.Id,
+	}, nil
+}
+
+func (vs *voucherService) EditVoucher(ctx context.Context, request *voucher.EditVoucherRequest) (*voucher.EditVoucherResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	voucherEntity, err := vs.voucherRepository.GetVoucherById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if voucherEntity == nil {
+		return &voucher.EditVoucherResponse{
+			Base: utils.NotFoundResponse("Voucher not found"),
+		}, nil
+	}
+
+	message := validateVoucherRule(request.DiscountType, request.DiscountValue, request.StartsAt, request.EndsAt)
+	if message != "" {
+		return &voucher.EditVoucherResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
+
+	rule, message := voucherMoneyRule(request.Currency, request.DiscountType, request.DiscountValue, request.MaxDiscountMoney, request.MaxDiscount, request.MinSpendMoney, request.MinSpend)
+	if message != "" {
+		return &voucher.EditVoucherResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
+
+	now := time.Now()
+	voucherEntity.Description = request.Description
+	voucherEntity.DiscountType = request.DiscountType
+	voucherEntity.DiscountValue = rule.discountValue
+	voucherEntity.MaxDiscount = optionalInt(rule.maxDiscount)
+	voucherEntity.MinSpend = rule.minSpend
+	voucherEntity.Currency = rule.currency
+	voucherEntity.UsageLimit = optionalInt(request.UsageLimit)
+	voucherEntity.PerUserUsageLimit = optionalInt(request.PerUserUsageLimit)
+	voucherEntity.StartsAt = request.StartsAt.AsTime()
+	voucherEntity.EndsAt = request.EndsAt.AsTime()
+	voucherEntity.ProductIds = request.ProductIds
+	voucherEntity.Categories = request.Categories
+	voucherEntity.IsActive = request.IsActive
+	voucherEntity.UpdatedAt = &now
+	voucherEntity.UpdatedBy = &claims.FullName
+
+	err = vs.voucherRepository.UpdateVoucher(ctx, voucherEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &voucher.EditVoucherResponse{
+		Base: utils.SuccessResponse("Voucher is updated"),
+	}, nil
+}
+
+func (vs *voucherService) DeleteVoucher(ctx context.Context, request *voucher.DeleteVoucherRequest) (*voucher.DeleteVoucherResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	voucherEntity, err := vs.voucherRepository.GetVoucherById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if voucherEntity == nil {
+		return &voucher.DeleteVoucherResponse{
+			Base: utils.NotFoundResponse("Voucher not found"),
+		}, nil
+	}
+
+	err = vs.voucherRepository.DeleteVoucher(ctx, voucherEntity.Id, time.Now(), claims.FullName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &voucher.DeleteVoucherResponse{
+		Base: utils.SuccessResponse("Voucher is deleted"),
+	}, nil
+}
+
+func (vs *voucherService) ListVoucherAdmin(ctx context.Context, request *voucher.ListVoucherAdminRequest) (*voucher.ListVoucherAdminResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.PermissionDeniedResponse()
+	}
+
+	vouchers, paginationResponse, err := vs.voucherRepository.GetVouchersPaginationAdmin(ctx, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*voucher.ListVoucherAdminResponseItem, 0)
+	for _, v := range vouchers {
+		item := &voucher.ListVoucherAdminResponseItem{
+			Id:            v.Id,
+			Code:          v.Code,
+			Description:   v.Description,
+			DiscountType:  v.DiscountType,
+			DiscountValue: v.DiscountValue,
+			MinSpend:      utils.MajorAmount(v.MinSpend, v.Currency),
+			StartsAt:      timestamppb.New(v.StartsAt),
+			EndsAt:        timestamppb.New(v.EndsAt),
+			ProductIds:    v.ProductIds,
+			Categories:    v.Categories,
+			IsActive:      v.IsActive,
+			MinSpendMoney: utils.MoneyResponse(v.MinSpend, v.Currency),
+			Currency:      money.NormalizeCurrency(v.Currency),
+		}
+		// discount_value fixed_amount di response tetap dalam satuan mayor seperti request
+		if v.DiscountType == entity.VoucherTypeFixedAmount {
+			item.DiscountValue = utils.MajorAmount(int64(v.DiscountValue), v.Currency)
+		}
+		if v.MaxDiscount != nil {
+			item.MaxDiscount = utils.MajorAmount(*v.MaxDiscount, v.Currency)
+			item.MaxDiscountMoney = utils.MoneyResponse(*v.MaxDiscount, v.Currency)
+		}
+		if v.UsageLimit != nil {
+			item.UsageLimit = *v.UsageLimit
+		}
+		if v.PerUserUsageLimit != nil {
+			item.PerUserUsageLimit = *v.PerUserUsageLimit
+		}
+
+		items = append(items, item)
+	}
+
+	return &voucher.ListVoucherAdminResponse{
+		Base:       utils.SuccessResponse("Get list voucher success"),
+		Pagination: paginationResponse,
+		Items:      items,
+	}, nil
+}
+
+// validateVoucherRule mengembalikan pesan error jika aturan voucher tidak valid
+func validateVoucherRule(discountType string, discountValue float64, startsAt *timestamppb.Timestamp, endsAt *timestamppb.Timestamp) string {
+	if discountType == entity.VoucherTypePercentage && discountValue > 100 {
+		return "Percentage discount cannot be more than 100"
+	}
+	if discountType != entity.VoucherTypeFreeShipping && discountValue <= 0 {
+		return "Discount value must be greater than 0"
+	}
+	if !endsAt.AsTime().After(startsAt.AsTime()) {
+		return "Voucher end time must be after start time"
+	}
+
+	return ""
+}
+
+type voucherMoney struct {
+	currency      string
+	discountValue float64
+	maxDiscount   int64
+	minSpend      int64
+}
+
+// voucherMoneyRule mengubah nominal voucher ke minor unit currency voucher,
+// discount_value fixed_amount dikirim dalam satuan mayor dan disimpan dalam minor unit
+func voucherMoneyRule(currency string, discountType string, discountValue float64, maxDiscountMoney *common.Money, maxDiscount float64, minSpendMoney *common.Money, minSpend float64) (*voucherMoney, string) {
+	rule := voucherMoney{
+		currency:      money.NormalizeCurrency(currency),
+		discountValue: discountValue,
+	}
+	if discountType == entity.VoucherTypeFixedAmount {
+		rule.discountValue = float64(money.FromMajor(discountValue, rule.currency).Amount)
+	}
+
+	maxDiscountAmount := utils.MoneyFromRequest(maxDiscountMoney, maxDiscount, rule.currency)
+	minSpendAmount := utils.MoneyFromRequest(minSpendMoney, minSpend, rule.currency)
+	if maxDiscountAmount.Currency != rule.currency || minSpendAmount.Currency != rule.currency {
+		return nil, "Voucher amounts must use the voucher currency"
+	}
+	if maxDiscountAmount.Amount < 0 || minSpendAmount.Amount < 0 {
+		return nil, "Voucher amounts cannot be negative"
+	}
+	rule.maxDiscount = maxDiscountAmount.Amount
+	rule.minSpend = minSpendAmount.Amount
+
+	return &rule, ""
+}
+
+// nilai 0 dari request berarti tanpa batas
+func optionalInt(value int64) *int64 {
+	if value <= 0 {
+		return nil
+	}
+
+	return &value
+}
+
+func NewVoucherService(voucherRepository repository.IVoucherRepository) IVoucherService {
+	return &voucherService{
+		voucherRepository: voucherRepository,
+	}
+}
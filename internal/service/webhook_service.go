@@ -2,50 +2,216 @@ package service
 
 import (
 	"context"
-	"errors"
+	"database/sql"
+	"log/slog"
+	"runtime/debug"
 	"time"
 
-	"github.com/luzmareto/go-grpc-ecommerce-be/internal/dto"
+	"github.com/google/uuid"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/metrics"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/payment"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
 )
 
 type IWebhookService interface {
-	ReceiveInvoice(ctx context.Context, request *dto.XenditInvoiceRequest) error
+	ReceiveInvoice(ctx context.Context, event *payment.WebhookEvent) error
 }
 
 type webhookService struct {
-	orderRepository repository.IOrderRepository
+	db                     *sql.DB
+	orderRepository        repository.IOrderRepository
+	webhookEventRepository repository.IWebhookEventRepository
+	outboxRepository       repository.IOutboxRepository
 }
 
-func (ws *webhookService) ReceiveInvoice(ctx context.Context, request *dto.XenditInvoiceRequest) error {
-	orderEntity, err := ws.orderRepository.GetOrderById(ctx, request.ExternalID)
+func (ws *webhookService) ReceiveInvoice(ctx context.Context, event *payment.WebhookEvent) error {
+	tx, err := ws.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	orderRepo := ws.orderRepository.WithTrancastion(tx)
+	webhookEventRepo := ws.webhookEventRepository.WithTrancastion(tx)
+	outboxRepo := ws.outboxRepository.WithTrancastion(tx)
+
+	// event disimpan di transaksi yang sama dengan update order, jika update gagal event bisa diproses ulang
+	isNew, err := webhookEventRepo.CreateWebhookEvent(ctx, &entity.WebhookEvent{
+		Id:         uuid.NewString(),
+		Provider:   event.Provider,
+		EventId:    event.Id,
+		InvoiceId:  event.InvoiceId,
+		ExternalId: event.ExternalId,
+		Status:     event.Status,
+		ReceivedAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	if !isNew {
+		slog.InfoContext(ctx, "duplicate webhook acknowledged", "provider", event.Provider, "event_id", event.Id, "order_id", event.ExternalId)
+		err = tx.Rollback()
+		tx = nil
+		return err
+	}
+
+	// order dikunci sampai transaksi selesai agar pembatalan yang bersamaan tidak menimpa status dari webhook
+	orderEntity, err := orderRepo.GetOrderByIdForUpdate(ctx, event.ExternalId)
 	if err != nil {
 		return err
 	} //logic jika order tidak ditemukan
+	// event tetap dicatat dan dijawab 2xx agar provider tidak mengirim ulang webhook yang tidak akan pernah berhasil
 	if orderEntity == nil {
-		return errors.New("order not found")
+		slog.WarnContext(ctx, "webhook for unknown order acknowledged", "provider", event.Provider, "event_id", event.Id, "order_id", event.ExternalId)
+		err = tx.Commit()
+		return err
+	}
+
+	previousStatusCode := orderEntity.OrderStatusCode
+	paymentReceived := false
+	switch event.Status {
+	case payment.InvoiceStatusPaid, payment.InvoiceStatusSettled:
+		applied := applyInvoicePaid(ctx, orderEntity, event)
+		if !applied {
+			err = tx.Commit()
+			return err
+		}
+		paymentReceived = true
+	case payment.InvoiceStatusExpired:
+		// hanya order yang belum dibayar yang boleh menjadi expired
+		if orderEntity.OrderStatusCode != entity.OrderStatusCodeUnpaid {
+			slog.InfoContext(ctx, "ignoring expired webhook", "order_id", orderEntity.Id, "order_status_code", orderEntity.OrderStatusCode)
+			err = tx.Commit()
+			return err
+		}
+		orderEntity.OrderStatusCode = entity.OrderStatusCodeExpired
+	default:
+		slog.InfoContext(ctx, "ignoring webhook status", "provider", event.Provider, "status", event.Status, "order_id", orderEntity.Id)
+		err = tx.Commit()
+		return err
 	}
 
 	now := time.Now()
 	updatedBy := "System"
-	orderEntity.OrderStatusCode = entity.OrderStatusCodePaid
 	orderEntity.UpdatedAt = &now
 	orderEntity.UpdatedBy = &updatedBy
-	orderEntity.XenditPaidAt = &now
-	orderEntity.XenditPaymentChannel = &request.PaymentChannel
-	orderEntity.XenditPaymentMethod = &request.PaymentMethod
 
-	err = ws.orderRepository.UpdateOrder(ctx, orderEntity)
+	err = orderRepo.UpdateOrder(ctx, orderEntity)
 	if err != nil {
 		return err
 	}
 
+	// stok yang dipesan dikembalikan karena invoice tidak dibayar
+	if orderEntity.OrderStatusCode == entity.OrderStatusCodeExpired {
+		err = orderRepo.ReleaseOrderStock(ctx, orderEntity.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	// event hanya dikirim saat status order berubah, bukti pembayaran tidak dikirim untuk pembayaran yang perlu dicek admin
+	orderEvents := map[string]string{
+		entity.OrderStatusCodePaid:          entity.OrderEventPaid,
+		entity.OrderStatusCodePaymentReview: entity.OrderEventPaymentReview,
+		entity.OrderStatusCodeExpired:       entity.OrderEventExpired,
+	}
+	if event, ok := orderEvents[orderEntity.OrderStatusCode]; ok && previousStatusCode != orderEntity.OrderStatusCode {
+		err = publishOrderEvent(ctx, outboxRepo, orderEntity.Id, event, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	if paymentReceived {
+		metrics.IncWebhookPaymentsReceived(event.Provider, orderEntity.OrderStatusCode)
+	}
+
 	return nil
 }
 
-func NewWebhookService(orderRepository repository.IOrderRepository) IWebhookService {
+// applyInvoicePaid mengubah order sesuai pembayaran yang diterima, return false jika tidak ada perubahan
+func applyInvoicePaid(ctx context.Context, orderEntity *entity.Order, event *payment.WebhookEvent) bool {
+	// SETTLED dikirim setelah PAID untuk invoice yang sama, order sudah diproses saat PAID
+	if orderEntity.XenditPaidAt != nil {
+		return false
+	}
+
+	paidAt := time.Now()
+	if event.PaidAt != nil {
+		paidAt = *event.PaidAt
+	}
+	paidAmount := event.PaidAmount
+	orderEntity.XenditPaidAt = &paidAt
+	orderEntity.XenditPaidAmount = &paidAmount
+	orderEntity.XenditPaymentChannel = &event.PaymentChannel
+	orderEntity.XenditPaymentMethod = &event.PaymentMethod
+
+	// order sudah ditandai paid manual oleh admin, cukup catat detail pembayarannya
+	if orderEntity.OrderStatusCode == entity.OrderStatusCodePaid {
+		return true
+	}
+
+	// pembayaran untuk order yang sudah dibatalkan / expired tetap dicatat dan ditandai untuk dicek admin
+	if orderEntity.OrderStatusCode != entity.OrderStatusCodeUnpaid {
+		reason := entity.PaymentReviewReasonUnexpectedPayment
+		orderEntity.PaymentReviewReason = &reason
+		slog.WarnContext(ctx, "payment received for inactive order", "order_id", orderEntity.Id, "order_status_code", orderEntity.OrderStatusCode, "paid_amount", paidAmount)
+		return true
+	}
+
+	// nominal hanya bisa dibandingkan jika mata uangnya sama
+	if money.NormalizeCurrency(event.Currency) != money.NormalizeCurrency(orderEntity.Currency) {
+		reason := entity.PaymentReviewReasonCurrencyMismatch
+		orderEntity.PaymentReviewReason = &reason
+		orderEntity.OrderStatusCode = entity.OrderStatusCodePaymentReview
+		slog.WarnContext(ctx, "order paid in different currency", "order_id", orderEntity.Id, "paid_currency", event.Currency, "order_currency", orderEntity.Currency)
+		return true
+	}
+
+	if paidAmount < orderEntity.Total {
+		reason := entity.PaymentReviewReasonUnderpaid
+		orderEntity.PaymentReviewReason = &reason
+		orderEntity.OrderStatusCode = entity.OrderStatusCodePaymentReview
+		slog.WarnContext(ctx, "order underpaid", "order_id", orderEntity.Id, "paid_amount", paidAmount, "total", orderEntity.Total)
+		return true
+	}
+
+	if paidAmount > orderEntity.Total {
+		reason := entity.PaymentReviewReasonOverpaid
+		orderEntity.PaymentReviewReason = &reason
+		slog.WarnContext(ctx, "order overpaid", "order_id", orderEntity.Id, "paid_amount", paidAmount, "total", orderEntity.Total)
+	}
+	orderEntity.OrderStatusCode = entity.OrderStatusCodePaid
+
+	return true
+}
+
+func NewWebhookService(db *sql.DB, orderRepository repository.IOrderRepository, webhookEventRepository repository.IWebhookEventRepository, outboxRepository repository.IOutboxRepository) IWebhookService {
 	return &webhookService{
-		orderRepository: orderRepository,
+		db:                     db,
+		orderRepository:        orderRepository,
+		webhookEventRepository: webhookEventRepository,
+		outboxRepository:       outboxRepository,
 	}
 }
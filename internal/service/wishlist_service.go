@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/currency"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/entity"
+	jwtentity "github.com/luzmareto/go-grpc-ecommerce-be/internal/entity/jwt"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/wishlist"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type IWishlistService interface {
+	AddToWishlist(ctx context.Context, request *wishlist.AddToWishlistRequest) (*wishlist.AddToWishlistResponse, error)
+	RemoveFromWishlist(ctx context.Context, request *wishlist.RemoveFromWishlistRequest) (*wishlist.RemoveFromWishlistResponse, error)
+	ListWishlist(ctx context.Context, request *wishlist.ListWishlistRequest) (*wishlist.ListWishlistResponse, error)
+	MoveWishlistToCart(ctx context.Context, request *wishlist.MoveWishlistToCartRequest) (*wishlist.MoveWishlistToCartResponse, error)
+}
+
+type wishlistService struct {
+	db                 *sql.DB
+	wishlistRepository repository.IWishlistRepository
+	productRepository  repository.IProductRepository
+	cartRepository     repository.ICartRepository
+	rateTable          currency.IRateTable
+}
+
+func (ws *wishlistService) AddToWishlist(ctx context.Context, request *wishlist.AddToWishlistRequest) (*wishlist.AddToWishlistResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	productEntity, err := ws.productRepository.GetProductById(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productEntity == nil {
+		return &wishlist.AddToWishlistResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	wishlistEntity := entity.UserWishlist{
+		Id:        uuid.NewString(),
+		UserId:    claims.Subject,
+		ProductId: productEntity.Id,
+		CreatedAt: time.Now(),
+		CreatedBy: claims.FullName,
+	}
+	err = ws.wishlistRepository.AddWishlist(ctx, &wishlistEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wishlist.AddToWishlistResponse{
+		Base: utils.SuccessResponse("Add product to wishlist success"),
+		Id:   wishlistEntity.Id,
+	}, nil
+}
+
+func (ws *wishlistService) RemoveFromWishlist(ctx context.Context, request *wishlist.RemoveFromWishlistRequest) (*wishlist.RemoveFromWishlistResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := ws.wishlistRepository.DeleteWishlist(ctx, claims.Subject, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return &wishlist.RemoveFromWishlistResponse{
+			Base: utils.NotFoundResponse("Product is not in wishlist"),
+		}, nil
+	}
+
+	return &wishlist.RemoveFromWishlistResponse{
+		Base: utils.SuccessResponse("Remove product from wishlist success"),
+	}, nil
+}
+
+func (ws *wishlistService) ListWishlist(ctx context.Context, request *wishlist.ListWishlistRequest) (*wishlist.ListWishlistResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Currency != "" && !ws.rateTable.IsSupported(request.Currency) {
+		return &wishlist.ListWishlistResponse{
+			Base: utils.BadRequestResponse("Currency is not supported"),
+		}, nil
+	}
+
+	wishlists, paginationResponse, err := ws.wishlistRepository.GetWishlistPagination(ctx, request.Pagination, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*wishlist.ListWishlistResponseItem, 0)
+	for _, wishlistEntity := range wishlists {
+		item := wishlist.ListWishlistResponseItem{
+			Id:        wishlistEntity.Id,
+			ProductId: wishlistEntity.ProductId,
+			Available: true,
+			CreatedAt: timestamppb.New(wishlistEntity.CreatedAt),
+		}
+
+		if wishlistEntity.Product == nil {
+			item.Available = false
+			item.UnavailableReason = entity.ProductUnavailableReasonNotFound
+			items = append(items, &item)
+			continue
+		}
+
+		price, err := ws.rateTable.Convert(money.New(wishlistEntity.Product.Price, wishlistEntity.Product.Currency), request.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		item.ProductName = wishlistEntity.Product.Name
+		item.ProductImageUrl = fmt.Sprintf("%s/product/%s", os.Getenv("STORAGE_SERVICE_URL"), wishlistEntity.Product.ImageFileName)
+		item.ProductPrice = utils.MoneyResponse(price.Amount, price.Currency)
+		if wishlistEntity.Product.IsDeleted {
+			item.Available = false
+			item.UnavailableReason = entity.ProductUnavailableReasonDeleted
+		}
+
+		items = append(items, &item)
+	}
+
+	return &wishlist.ListWishlistResponse{
+		Base:       utils.SuccessResponse("Get list wishlist success"),
+		Pagination: paginationResponse,
+		Items:      items,
+	}, nil
+}
+
+// MoveWishlistToCart menambahkan produk ke cart dan menghapusnya dari wishlist dalam satu transaksi
+func (ws *wishlistService) MoveWishlistToCart(ctx context.Context, request *wishlist.MoveWishlistToCartRequest) (*wishlist.MoveWishlistToCartResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	productEntity, err := ws.productRepository.GetProductById(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productEntity == nil {
+		return &wishlist.MoveWishlistToCartResponse{
+			Base: utils.BadRequestResponse("Product is no longer available"),
+		}, nil
+	}
+
+	quantity := request.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	tx, err := ws.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			if tx != nil {
+				tx.Rollback()
+			}
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+	defer func() {
+		if err != nil && tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	deleted, err := ws.wishlistRepository.WithTrancastion(tx).DeleteWishlist(ctx, claims.Subject, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		err = tx.Rollback()
+		tx = nil
+		if err != nil {
+			return nil, err
+		}
+
+		return &wishlist.MoveWishlistToCartResponse{
+			Base: utils.NotFoundResponse("Product is not in wishlist"),
+		}, nil
+	}
+
+	owner := &entity.CartOwner{
+		UserId: claims.Subject,
+		Actor:  claims.FullName,
+	}
+	cartEntity := newCartEntity(owner, productEntity, quantity)
+	err = ws.cartRepository.WithTrancastion(tx).UpsertCart(ctx, cartEntity, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &wishlist.MoveWishlistToCartResponse{
+		Base:   utils.SuccessResponse("Move wishlist to cart success"),
+		CartId: cartEntity.Id,
+	}, nil
+}
+
+func NewWishlistService(db *sql.DB, wishlistRepository repository.IWishlistRepository, productRepository repository.IProductRepository, cartRepository repository.ICartRepository, rateTable currency.IRateTable) IWishlistService {
+	return &wishlistService{
+		db:                 db,
+		wishlistRepository: wishlistRepository,
+		productRepository:  productRepository,
+		cartRepository:     cartRepository,
+		rateTable:          rateTable,
+	}
+}
@@ -0,0 +1,127 @@
+package shipping
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	CourierTable = "TABLE"
+	CourierStub  = "STUB"
+
+	ServiceRegular = "REG"
+	ServiceExpress = "EXPRESS"
+)
+
+var ErrRateNotFound = errors.New("shipping rate not found")
+
+type RateRequest struct {
+	Province   string
+	City       string
+	PostalCode string
+	WeightGram int64
+}
+
+type Rate struct {
+	Courier string
+	Service string
+	// Cost dalam minor unit Currency
+	Cost     int64
+	Currency string
+	EtdDays  int64
+}
+
+// ICourierClient adalah sumber tarif satu kurir, implementasi API kurir asli cukup memenuhi interface ini
+type ICourierClient interface {
+	Name() string
+	GetRates(ctx context.Context, request *RateRequest) ([]*Rate, error)
+}
+
+type IShippingRateCalculator interface {
+	GetRates(ctx context.Context, request *RateRequest) ([]*Rate, error)
+	GetRate(ctx context.Context, request *RateRequest, courier string, service string) (*Rate, error)
+}
+
+type shippingRateCalculator struct {
+	couriers []ICourierClient
+}
+
+// GetRates mengembalikan tarif dari semua kurir, diurutkan dari yang termurah
+func (sc *shippingRateCalculator) GetRates(ctx context.Context, request *RateRequest) ([]*Rate, error) {
+	rates := make([]*Rate, 0)
+	for _, courier := range sc.couriers {
+		courierRates, err := courier.GetRates(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, courierRates...)
+	}
+
+	sort.SliceStable(rates, func(i, j int) bool {
+		return rates[i].Cost < rates[j].Cost
+	})
+
+	return rates, nil
+}
+
+// GetRate mencari tarif untuk kurir dan service tertentu, jika courier kosong tarif termurah yang dipakai
+func (sc *shippingRateCalculator) GetRate(ctx context.Context, request *RateRequest, courier string, service string) (*Rate, error) {
+	rates, err := sc.GetRates(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rate := range rates {
+		if courier == "" {
+			return rate, nil
+		}
+		if strings.EqualFold(rate.Courier, courier) && (service == "" || strings.EqualFold(rate.Service, service)) {
+			return rate, nil
+		}
+	}
+
+	return nil, ErrRateNotFound
+}
+
+func NewShippingRateCalculator(couriers ...ICourierClient) IShippingRateCalculator {
+	return &shippingRateCalculator{
+		couriers: couriers,
+	}
+}
+
+// NewShippingRateCalculatorFromEnv membaca SHIPPING_COURIERS, contoh: table,stub
+func NewShippingRateCalculatorFromEnv() IShippingRateCalculator {
+	couriers := make([]ICourierClient, 0)
+	for _, name := range strings.Split(os.Getenv("SHIPPING_COURIERS"), ",") {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "table":
+			couriers = append(couriers, NewTableCourierClient())
+		case "stub":
+			couriers = append(couriers, NewStubCourierClient())
+		case "":
+		default:
+			slog.Warn("unknown shipping courier is ignored", "courier", name)
+		}
+	}
+
+	if len(couriers) == 0 {
+		couriers = append(couriers, NewTableCourierClient())
+	}
+
+	return NewShippingRateCalculator(couriers...)
+}
+
+// chargeableKilogram membulatkan berat ke atas per kilogram dengan minimal 1 kg
+func chargeableKilogram(weightGram int64) int64 {
+	kilogram := (weightGram + 999) / 1000
+	if kilogram < 1 {
+		kilogram = 1
+	}
+
+	return kilogram
+}
@@ -0,0 +1,37 @@
+package shipping
+
+import (
+	"context"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
+)
+
+const (
+	stubBaseCost        = 15000
+	stubCostPerKilogram = 5000
+)
+
+// stubCourierClient mensimulasikan API kurir eksternal untuk development lokal
+type stubCourierClient struct{}
+
+func (sc *stubCourierClient) Name() string {
+	return CourierStub
+}
+
+func (sc *stubCourierClient) GetRates(ctx context.Context, request *RateRequest) ([]*Rate, error) {
+	kilogram := chargeableKilogram(request.WeightGram)
+
+	return []*Rate{
+		{
+			Courier:  CourierStub,
+			Service:  ServiceRegular,
+			Cost:     stubBaseCost + stubCostPerKilogram*kilogram,
+			Currency: money.CurrencyIDR,
+			EtdDays:  2,
+		},
+	}, nil
+}
+
+func NewStubCourierClient() ICourierClient {
+	return &stubCourierClient{}
+}
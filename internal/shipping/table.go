@@ -0,0 +1,107 @@
+package shipping
+
+import (
+	"context"
+	"strings"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
+)
+
+const (
+	zoneJawa   = "zone_1"
+	zoneNear   = "zone_2"
+	zoneMiddle = "zone_3"
+	zoneFar    = "zone_4"
+)
+
+// provinsi yang tidak dikenal (contoh: alamat free text) masuk zona terjauh agar ongkir tidak kurang
+var provinceZones = map[string]string{
+	"dki jakarta":               zoneJawa,
+	"jawa barat":                zoneJawa,
+	"jawa tengah":               zoneJawa,
+	"di yogyakarta":             zoneJawa,
+	"jawa timur":                zoneJawa,
+	"banten":                    zoneJawa,
+	"bali":                      zoneNear,
+	"lampung":                   zoneNear,
+	"sumatera selatan":          zoneNear,
+	"bengkulu":                  zoneNear,
+	"jambi":                     zoneNear,
+	"kepulauan bangka belitung": zoneNear,
+	"nusa tenggara barat":       zoneNear,
+	"sumatera barat":            zoneMiddle,
+	"sumatera utara":            zoneMiddle,
+	"riau":                      zoneMiddle,
+	"kepulauan riau":            zoneMiddle,
+	"aceh":                      zoneMiddle,
+	"nusa tenggara timur":       zoneMiddle,
+	"kalimantan barat":          zoneMiddle,
+	"kalimantan tengah":         zoneMiddle,
+	"kalimantan selatan":        zoneMiddle,
+	"kalimantan timur":          zoneMiddle,
+	"kalimantan utara":          zoneMiddle,
+	"sulawesi utara":            zoneMiddle,
+	"sulawesi tengah":           zoneMiddle,
+	"sulawesi selatan":          zoneMiddle,
+	"sulawesi tenggara":         zoneMiddle,
+	"sulawesi barat":            zoneMiddle,
+	"gorontalo":                 zoneMiddle,
+}
+
+type tableRate struct {
+	costPerKilogram int64
+	etdDays         int64
+}
+
+// tarif per kilogram untuk setiap zona dan service
+var zoneRates = map[string]map[string]tableRate{
+	zoneJawa: {
+		ServiceRegular: {costPerKilogram: 10000, etdDays: 3},
+		ServiceExpress: {costPerKilogram: 18000, etdDays: 1},
+	},
+	zoneNear: {
+		ServiceRegular: {costPerKilogram: 18000, etdDays: 4},
+		ServiceExpress: {costPerKilogram: 30000, etdDays: 2},
+	},
+	zoneMiddle: {
+		ServiceRegular: {costPerKilogram: 25000, etdDays: 5},
+		ServiceExpress: {costPerKilogram: 42000, etdDays: 3},
+	},
+	zoneFar: {
+		ServiceRegular: {costPerKilogram: 40000, etdDays: 7},
+		ServiceExpress: {costPerKilogram: 65000, etdDays: 4},
+	},
+}
+
+// tableCourierClient menghitung ongkir dari tabel berat dan zona tanpa memanggil API luar
+type tableCourierClient struct{}
+
+func (tc *tableCourierClient) Name() string {
+	return CourierTable
+}
+
+func (tc *tableCourierClient) GetRates(ctx context.Context, request *RateRequest) ([]*Rate, error) {
+	zone, ok := provinceZones[strings.ToLower(strings.TrimSpace(request.Province))]
+	if !ok {
+		zone = zoneFar
+	}
+
+	kilogram := chargeableKilogram(request.WeightGram)
+	rates := make([]*Rate, 0)
+	for _, service := range []string{ServiceRegular, ServiceExpress} {
+		rate := zoneRates[zone][service]
+		rates = append(rates, &Rate{
+			Courier:  CourierTable,
+			Service:  service,
+			Cost:     rate.costPerKilogram * kilogram,
+			Currency: money.CurrencyIDR,
+			EtdDays:  rate.etdDays,
+		})
+	}
+
+	return rates, nil
+}
+
+func NewTableCourierClient() ICourierClient {
+	return &tableCourierClient{}
+}
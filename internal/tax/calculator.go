@@ -0,0 +1,96 @@
+package tax
+
+import (
+	"log/slog"
+	"os"
+	"strconv"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
+)
+
+const (
+	defaultTaxName = "PPN"
+	defaultTaxRate = 11
+)
+
+// Rule adalah aturan pajak yang berlaku, Rate dalam persen (contoh: 11 untuk PPN 11%)
+type Rule struct {
+	Name            string
+	Rate            float64
+	Inclusive       bool
+	ApplyToShipping bool
+}
+
+type Result struct {
+	Name          string
+	Rate          float64
+	Inclusive     bool
+	TaxableAmount int64
+	TaxAmount     int64
+}
+
+type ITaxCalculator interface {
+	Calculate(productAmount int64, shippingAmount int64) *Result
+}
+
+type taxCalculator struct {
+	rule Rule
+}
+
+// Calculate menghitung pajak dari nilai produk (dan ongkir jika dikenakan pajak) setelah diskon.
+// Untuk harga inclusive pajak sudah termasuk di harga sehingga tidak menambah total order.
+// Semua nominal dalam minor unit
+func (tc *taxCalculator) Calculate(productAmount int64, shippingAmount int64) *Result {
+	taxableAmount := productAmount
+	if tc.rule.ApplyToShipping {
+		taxableAmount += shippingAmount
+	}
+	if taxableAmount < 0 {
+		taxableAmount = 0
+	}
+
+	var taxAmount int64
+	if tc.rule.Inclusive {
+		taxAmount = money.IncludedPercent(taxableAmount, tc.rule.Rate)
+	} else {
+		taxAmount = money.Percent(taxableAmount, tc.rule.Rate)
+	}
+
+	return &Result{
+		Name:          tc.rule.Name,
+		Rate:          tc.rule.Rate,
+		Inclusive:     tc.rule.Inclusive,
+		TaxableAmount: taxableAmount,
+		TaxAmount:     taxAmount,
+	}
+}
+
+func NewTaxCalculator(rule Rule) ITaxCalculator {
+	return &taxCalculator{
+		rule: rule,
+	}
+}
+
+// NewTaxCalculatorFromEnv membaca TAX_NAME, TAX_RATE, TAX_INCLUSIVE dan TAX_APPLY_TO_SHIPPING
+func NewTaxCalculatorFromEnv() ITaxCalculator {
+	rule := Rule{
+		Name: os.Getenv("TAX_NAME"),
+		Rate: defaultTaxRate,
+	}
+	if rule.Name == "" {
+		rule.Name = defaultTaxName
+	}
+
+	if value := os.Getenv("TAX_RATE"); value != "" {
+		rate, err := strconv.ParseFloat(value, 64)
+		if err != nil || rate < 0 {
+			slog.Warn("invalid TAX_RATE, using default", "value", value, "default", defaultTaxRate)
+		} else {
+			rule.Rate = rate
+		}
+	}
+	rule.Inclusive, _ = strconv.ParseBool(os.Getenv("TAX_INCLUSIVE"))
+	rule.ApplyToShipping, _ = strconv.ParseBool(os.Getenv("TAX_APPLY_TO_SHIPPING"))
+
+	return NewTaxCalculator(rule)
+}
@@ -0,0 +1,111 @@
+package tax
+
+import "testing"
+
+func TestCalculate(t *testing.T) {
+	tests := []struct {
+		name           string
+		rule           Rule
+		productAmount  int64
+		shippingAmount int64
+		want           Result
+	}{
+		{
+			name:           "exclusive tax without shipping",
+			rule:           Rule{Name: "PPN", Rate: 11},
+			productAmount:  100000,
+			shippingAmount: 20000,
+			want:           Result{Name: "PPN", Rate: 11, TaxableAmount: 100000, TaxAmount: 11000},
+		},
+		{
+			name:           "exclusive tax with shipping",
+			rule:           Rule{Name: "PPN", Rate: 11, ApplyToShipping: true},
+			productAmount:  100000,
+			shippingAmount: 20000,
+			want:           Result{Name: "PPN", Rate: 11, TaxableAmount: 120000, TaxAmount: 13200},
+		},
+		{
+			name:           "inclusive tax",
+			rule:           Rule{Name: "PPN", Rate: 11, Inclusive: true},
+			productAmount:  111000,
+			shippingAmount: 20000,
+			want:           Result{Name: "PPN", Rate: 11, Inclusive: true, TaxableAmount: 111000, TaxAmount: 11000},
+		},
+		{
+			name:           "inclusive tax with shipping",
+			rule:           Rule{Name: "PPN", Rate: 11, Inclusive: true, ApplyToShipping: true},
+			productAmount:  100000,
+			shippingAmount: 11000,
+			want:           Result{Name: "PPN", Rate: 11, Inclusive: true, TaxableAmount: 111000, TaxAmount: 11000},
+		},
+		{
+			name:          "decimal rate is rounded",
+			rule:          Rule{Name: "VAT", Rate: 12.5},
+			productAmount: 999,
+			want:          Result{Name: "VAT", Rate: 12.5, TaxableAmount: 999, TaxAmount: 125},
+		},
+		{
+			name:          "negative amount after discount is not taxed",
+			rule:          Rule{Name: "PPN", Rate: 11},
+			productAmount: -500,
+			want:          Result{Name: "PPN", Rate: 11, TaxableAmount: 0, TaxAmount: 0},
+		},
+		{
+			name:          "zero rate",
+			rule:          Rule{Name: "PPN", Rate: 0},
+			productAmount: 100000,
+			want:          Result{Name: "PPN", Rate: 0, TaxableAmount: 100000, TaxAmount: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewTaxCalculator(tt.rule).Calculate(tt.productAmount, tt.shippingAmount)
+			if *got != tt.want {
+				t.Errorf("Calculate(%d, %d) = %+v, want %+v", tt.productAmount, tt.shippingAmount, *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTaxCalculatorFromEnv(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want Result
+	}{
+		{
+			name: "default rule",
+			env:  map[string]string{},
+			want: Result{Name: "PPN", Rate: 11, TaxableAmount: 100000, TaxAmount: 11000},
+		},
+		{
+			name: "invalid rate uses default",
+			env:  map[string]string{"TAX_RATE": "-1"},
+			want: Result{Name: "PPN", Rate: 11, TaxableAmount: 100000, TaxAmount: 11000},
+		},
+		{
+			name: "custom rule",
+			env: map[string]string{
+				"TAX_NAME":              "VAT",
+				"TAX_RATE":              "7.5",
+				"TAX_INCLUSIVE":         "true",
+				"TAX_APPLY_TO_SHIPPING": "true",
+			},
+			want: Result{Name: "VAT", Rate: 7.5, Inclusive: true, TaxableAmount: 110000, TaxAmount: 7674},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"TAX_NAME", "TAX_RATE", "TAX_INCLUSIVE", "TAX_APPLY_TO_SHIPPING"} {
+				t.Setenv(key, tt.env[key])
+			}
+
+			got := NewTaxCalculatorFromEnv().Calculate(100000, 10000)
+			if *got != tt.want {
+				t.Errorf("Calculate() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadata berisi cart token milik pengunjung yang belum login
+const CartTokenMetadataKey = "x-cart-token"
+
+var ErrCartTokenSecretNotConfigured = errors.New("CART_TOKEN_SECRET or JWT_SECRET must be set")
+
+// CheckCartTokenSecret dipanggil saat start agar server tidak berjalan dengan kunci signature yang bisa ditebak
+func CheckCartTokenSecret() error {
+	if os.Getenv("CART_TOKEN_SECRET") == "" && os.Getenv("JWT_SECRET") == "" {
+		return ErrCartTokenSecretNotConfigured
+	}
+
+	return nil
+}
+
+// NewCartToken membuat token "<guest cart id>.<signature>", format ini sengaja bukan jwt
+// sehingga cart token tidak bisa dipakai sebagai access token
+func NewCartToken(guestCartId string) string {
+	return guestCartId + "." + signCartToken(guestCartId)
+}
+
+// ParseCartToken memverifikasi signature dan mengembalikan guest cart id
+func ParseCartToken(token string) (string, error) {
+	guestCartId, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return "", UnauthenticatedResponse()
+	}
+	if _, err := uuid.Parse(guestCartId); err != nil {
+		return "", UnauthenticatedResponse()
+	}
+	if CheckCartTokenSecret() != nil {
+		return "", UnauthenticatedResponse()
+	}
+	if !hmac.Equal([]byte(signature), []byte(signCartToken(guestCartId))) {
+		return "", UnauthenticatedResponse()
+	}
+
+	return guestCartId, nil
+}
+
+// GetGuestCartIdFromContext membaca cart token dari metadata, string kosong jika token tidak dikirim
+func GetGuestCartIdFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+
+	tokens := md.Get(CartTokenMetadataKey)
+	if len(tokens) == 0 || tokens[0] == "" {
+		return "", nil
+	}
+
+	return ParseCartToken(tokens[0])
+}
+
+// CART_TOKEN_SECRET opsional, jika kosong kunci diturunkan dari JWT_SECRET
+func signCartToken(guestCartId string) string {
+	secret := os.Getenv("CART_TOKEN_SECRET")
+	if secret == "" {
+		secret = "guest_cart:" + os.Getenv("JWT_SECRET")
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(guestCartId))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// GetAcceptLanguageFromContext membaca header Accept-Language yang diteruskan grpc-web sebagai metadata
+func GetAcceptLanguageFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get("accept-language")
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
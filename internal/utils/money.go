@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/money"
+	"github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
+)
+
+// MoneyResponse mengubah nominal minor unit menjadi common.Money untuk response
+func MoneyResponse(amount int64, currency string) *common.Money {
+	m := money.New(amount, currency)
+	return &common.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+// MajorAmount mengisi field double lama yang dipertahankan selama masa transisi ke common.Money
+func MajorAmount(amount int64, currency string) float64 {
+	return money.New(amount, currency).Major()
+}
+
+// MoneyFromRequest memakai field common.Money jika dikirim client, jika tidak field double lama dibulatkan ke minor unit
+func MoneyFromRequest(value *common.Money, legacyValue float64, legacyCurrency string) money.Money {
+	if value != nil {
+		return money.New(value.Amount, value.Currency)
+	}
+
+	return money.FromMajor(legacyValue, legacyCurrency)
+}
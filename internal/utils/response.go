@@ -1,9 +1,9 @@
 package utils
 
 import (
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/apperror"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func SuccessResponse(message string) *common.BaseResponse {
@@ -30,7 +30,12 @@ func NotFoundResponse(message string) *common.BaseResponse {
 }
 
 func UnauthenticatedResponse() error {
-	return status.Error(codes.Unauthenticated, "Unauthenticated")
+	return apperror.New(codes.Unauthenticated, apperror.ReasonUnauthenticated, "Unauthenticated")
+}
+
+// PermissionDeniedResponse untuk user yang sudah login tetapi tidak memiliki akses, contoh bukan admin
+func PermissionDeniedResponse() error {
+	return apperror.PermissionDenied("Permission denied")
 }
 
 func ValidationErrorResponse(validationError []*common.ValidationError) *common.BaseResponse {
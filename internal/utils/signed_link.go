@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+	"strings"
+)
+
+// tujuan signed link, token untuk satu tujuan tidak bisa dipakai untuk tujuan lain
+const (
+	SignedLinkPurposeProductUnsubscribe    = "product_unsubscribe"
+	SignedLinkPurposeNewsletterUnsubscribe = "newsletter_unsubscribe"
+	SignedLinkPurposeNewsletterOpen        = "newsletter_open"
+	SignedLinkPurposeNewsletterClick       = "newsletter_click"
+)
+
+// NewSignedLinkToken membuat token "<value>.<signature>" untuk link yang dibuka tanpa login, contoh link unsubscribe.
+// value tidak boleh mengandung titik, gunakan SignLinkValue untuk value bebas seperti url
+func NewSignedLinkToken(purpose string, value string) string {
+	return value + "." + SignLinkValue(purpose, value)
+}
+
+// ParseSignedLinkToken memverifikasi signature dan mengembalikan value
+func ParseSignedLinkToken(purpose string, token string) (string, error) {
+	value, signature, ok := strings.Cut(token, ".")
+	if !ok || value == "" {
+		return "", UnauthenticatedResponse()
+	}
+	if !VerifyLinkValue(purpose, value, signature) {
+		return "", UnauthenticatedResponse()
+	}
+
+	return value, nil
+}
+
+// SignLinkValue membuat signature untuk value yang dikirim terpisah di query string
+func SignLinkValue(purpose string, value string) string {
+	mac := hmac.New(sha256.New, linkSigningSecret())
+	mac.Write([]byte(purpose + ":" + value))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func VerifyLinkValue(purpose string, value string, signature string) bool {
+	return hmac.Equal([]byte(signature), []byte(SignLinkValue(purpose, value)))
+}
+
+// LINK_SIGNING_SECRET opsional, jika kosong kunci diturunkan dari JWT_SECRET
+func linkSigningSecret() []byte {
+	secret := os.Getenv("LINK_SIGNING_SECRET")
+	if secret == "" {
+		secret = "signed_link:" + os.Getenv("JWT_SECRET")
+	}
+
+	return []byte(secret)
+}
@@ -15,9 +15,10 @@ func CheckValidation(req proto.Message) ([]*common.ValidationError, error) {
 
 			var validationErrorResponse []*common.ValidationError = make([]*common.ValidationError, 0)
 			for _, violation := range validationError.Violations {
+				// path lengkap untuk field nested dan repeated, contoh items[0].product_id
 				validationErrorResponse = append(validationErrorResponse, &common.ValidationError{
-					Field:   *violation.Proto.Field.Elements[0].FieldName,
-					Message: *violation.Proto.Message,
+					Field:   protovalidate.FieldPathString(violation.Proto.GetField()),
+					Message: violation.Proto.GetMessage(),
 				})
 			}
 			return validationErrorResponse, nil
@@ -4,16 +4,20 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"net"
 	"os"
 	"time"
 
 	"github.com/joho/godotenv" //import manual
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/currency"
 	grpcmiddleware "github.com/luzmareto/go-grpc-ecommerce-be/internal/grpcMiddleware"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/handler"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/logger"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/metrics"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/repository"
 	"github.com/luzmareto/go-grpc-ecommerce-be/internal/service"
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/utils"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/auth"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pb/product"
 	"github.com/luzmareto/go-grpc-ecommerce-be/pkg/database"
@@ -25,30 +29,57 @@ import (
 func main() {
 	ctx := context.Background()
 	godotenv.Load()
+	logger.Setup()
+	grpcmiddleware.LoadErrorModeFromEnv()
+	err := utils.CheckCartTokenSecret()
+	if err != nil {
+		slog.Error("error when load cart token secret", "error", err)
+		os.Exit(1)
+	}
+
 	lis, err := net.Listen("tcp", ":50052")
 	if err != nil {
-		log.Panicf("error when listen %v", err)
+		slog.Error("error when listen", "error", err)
+		os.Exit(1)
 	}
 
 	db := database.ConnectDB(ctx, os.Getenv("DB_URI"))
-	log.Println("Connected to database")
+	slog.Info("Connected to database")
 
 	cacheService := gocache.New(time.Hour*24, time.Hour)
 
-	authMiddleware := grpcmiddleware.NewAuthMiddleware(cacheService)
+	revokedTokenRepository := repository.NewRevokedTokenRepository(db)
+	tokenRevocationService := service.NewTokenRevocationService(revokedTokenRepository, cacheService)
+
+	authMiddleware := grpcmiddleware.NewAuthMiddleware(tokenRevocationService)
 
 	authRepository := repository.NewAuthRepository(db)
-	authService := service.NewAuthService(authRepository, cacheService)
+	cartRepository := repository.NewCartRepository(db)
+	authService := service.NewAuthService(db, authRepository, cartRepository, tokenRevocationService)
 	authHandler := handler.NewAuthHandler(authService)
 
 	productRepository := repository.NewProductRepository(db)
-	productService := service.NewProductService(productRepository)
+	rateTable := currency.NewRateTableFromEnv(ctx)
+	go rateTable.Run(ctx)
+
+	outboxRepository := repository.NewOutboxRepository(db)
+	productService := service.NewProductService(db, productRepository, outboxRepository, rateTable)
 	productHandler := handler.NewProductHandler(productService)
 
 	serv := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
+			grpcmiddleware.LoggingMiddleware,
+			grpcmiddleware.MetricsMiddleware,
 			grpcmiddleware.ErrorMiddleware,
 			authMiddleware.Middleware,
+			grpcmiddleware.ValidationMiddleware,
+		),
+		// sama dengan cmd/grpc agar stream yang ditambahkan nanti tetap melewati auth, logging dan error middleware
+		grpc.ChainStreamInterceptor(
+			grpcmiddleware.StreamLoggingMiddleware,
+			grpcmiddleware.StreamMetricsMiddleware,
+			grpcmiddleware.StreamErrorMiddleware,
+			authMiddleware.StreamMiddleware,
 		),
 	)
 
@@ -57,11 +88,19 @@ func main() {
 
 	if os.Getenv("ENVIRONMENT") == "dev" {
 		reflection.Register(serv)
-		log.Println("Reflection is registered.")
+		slog.Info("Reflection is registered.")
+	}
+
+	// endpoint /metrics untuk prometheus dijalankan di port terpisah dari grpc
+	metricsAddr := os.Getenv("METRICS_ADDR")
+	if metricsAddr == "" {
+		metricsAddr = ":9090"
 	}
+	go metrics.Serve(metricsAddr)
 
-	log.Println("Server is runing on :50052 port.")
+	slog.Info("Server is runing on :50052 port.")
 	if err := serv.Serve(lis); err != nil {
-		log.Panicf("Server is error %v", err)
+		slog.Error("Server is error", "error", err)
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,11 @@
+-- webhook yang sudah diproses, dipakai untuk menolak replay / retry callback yang sama
+CREATE TABLE IF NOT EXISTS webhook_event (
+    id UUID PRIMARY KEY,
+    provider VARCHAR(50) NOT NULL,
+    event_id VARCHAR(255) NOT NULL,
+    invoice_id VARCHAR(255) NOT NULL,
+    external_id VARCHAR(255) NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (provider, event_id)
+);
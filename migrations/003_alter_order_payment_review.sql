@@ -0,0 +1,3 @@
+-- jumlah yang benar-benar dibayar dan alasan order perlu dicek admin (underpaid, overpaid, unexpected_payment)
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS xendit_paid_amount NUMERIC;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS payment_review_reason VARCHAR(100);
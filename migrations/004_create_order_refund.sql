@@ -0,0 +1,28 @@
+-- pengajuan return / refund per order, satu refund dapat berisi sebagian item order
+CREATE TABLE IF NOT EXISTS order_refund (
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES "order" (id),
+    status VARCHAR(50) NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    reject_reason VARCHAR(255),
+    amount NUMERIC NOT NULL,
+    previous_order_status_code VARCHAR(50) NOT NULL,
+    provider_refund_id VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(255)
+);
+
+CREATE INDEX IF NOT EXISTS order_refund_order_id_idx ON order_refund (order_id);
+
+CREATE TABLE IF NOT EXISTS order_refund_item (
+    id UUID PRIMARY KEY,
+    refund_id UUID NOT NULL REFERENCES order_refund (id),
+    order_item_id UUID NOT NULL REFERENCES order_item (id),
+    product_id UUID NOT NULL,
+    quantity BIGINT NOT NULL,
+    amount NUMERIC NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL
+);
@@ -0,0 +1,33 @@
+-- buku alamat user, ongkir dan nomor resi order
+CREATE TABLE IF NOT EXISTS user_address (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    label VARCHAR(255) NOT NULL,
+    recipient_name VARCHAR(255) NOT NULL,
+    phone_number VARCHAR(255) NOT NULL,
+    address VARCHAR(255) NOT NULL,
+    city VARCHAR(255) NOT NULL,
+    province VARCHAR(255) NOT NULL,
+    postal_code VARCHAR(20) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(255),
+    deleted_at TIMESTAMPTZ,
+    deleted_by VARCHAR(255),
+    is_deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS user_address_user_id_idx ON user_address (user_id) WHERE is_deleted = false;
+
+ALTER TABLE product ADD COLUMN IF NOT EXISTS weight_gram BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS shipping_address_id UUID;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS shipping_courier VARCHAR(50);
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS shipping_service VARCHAR(50);
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS shipping_cost NUMERIC NOT NULL DEFAULT 0;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS shipping_weight_gram BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS tracking_courier VARCHAR(50);
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS tracking_number VARCHAR(255);
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS shipped_at TIMESTAMPTZ;
@@ -0,0 +1,43 @@
+-- voucher promo dan pemakaiannya per order
+CREATE TABLE IF NOT EXISTS voucher (
+    id UUID PRIMARY KEY,
+    code VARCHAR(50) NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    discount_type VARCHAR(50) NOT NULL,
+    discount_value NUMERIC NOT NULL,
+    max_discount NUMERIC,
+    min_spend NUMERIC NOT NULL DEFAULT 0,
+    usage_limit BIGINT,
+    per_user_usage_limit BIGINT,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    product_ids TEXT[] NOT NULL DEFAULT '{}',
+    categories TEXT[] NOT NULL DEFAULT '{}',
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(255),
+    deleted_at TIMESTAMPTZ,
+    deleted_by VARCHAR(255),
+    is_deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS voucher_code_idx ON voucher (code) WHERE is_deleted = false;
+
+CREATE TABLE IF NOT EXISTS voucher_usage (
+    id UUID PRIMARY KEY,
+    voucher_id UUID NOT NULL REFERENCES voucher (id),
+    user_id UUID NOT NULL,
+    order_id UUID NOT NULL REFERENCES "order" (id),
+    discount_amount NUMERIC NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS voucher_usage_voucher_id_idx ON voucher_usage (voucher_id, user_id);
+
+ALTER TABLE product ADD COLUMN IF NOT EXISTS category VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS voucher_id UUID;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS voucher_code VARCHAR(50);
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS discount_amount NUMERIC NOT NULL DEFAULT 0;
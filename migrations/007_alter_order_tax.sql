@@ -0,0 +1,9 @@
+-- rincian subtotal dan pajak order
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS subtotal NUMERIC NOT NULL DEFAULT 0;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS tax_name VARCHAR(50);
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS tax_rate NUMERIC NOT NULL DEFAULT 0;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS tax_inclusive BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS tax_amount NUMERIC NOT NULL DEFAULT 0;
+
+-- order lama belum punya rincian, subtotal diambil dari total
+UPDATE "order" SET subtotal = total - shipping_cost + discount_amount WHERE subtotal = 0;
@@ -0,0 +1,24 @@
+-- nominal uang disimpan sebagai integer minor unit beserta mata uangnya.
+-- IDR memakai 0 digit desimal sehingga nilai rupiah yang sudah ada tidak berubah
+ALTER TABLE product ALTER COLUMN price TYPE BIGINT USING ROUND(price)::BIGINT;
+ALTER TABLE product ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE "order" ALTER COLUMN total TYPE BIGINT USING ROUND(total)::BIGINT;
+ALTER TABLE "order" ALTER COLUMN xendit_paid_amount TYPE BIGINT USING ROUND(xendit_paid_amount)::BIGINT;
+ALTER TABLE "order" ALTER COLUMN shipping_cost TYPE BIGINT USING ROUND(shipping_cost)::BIGINT;
+ALTER TABLE "order" ALTER COLUMN discount_amount TYPE BIGINT USING ROUND(discount_amount)::BIGINT;
+ALTER TABLE "order" ALTER COLUMN subtotal TYPE BIGINT USING ROUND(subtotal)::BIGINT;
+ALTER TABLE "order" ALTER COLUMN tax_amount TYPE BIGINT USING ROUND(tax_amount)::BIGINT;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE order_item ALTER COLUMN product_price TYPE BIGINT USING ROUND(product_price)::BIGINT;
+
+ALTER TABLE order_refund ALTER COLUMN amount TYPE BIGINT USING ROUND(amount)::BIGINT;
+ALTER TABLE order_refund_item ALTER COLUMN amount TYPE BIGINT USING ROUND(amount)::BIGINT;
+
+-- discount_value tetap NUMERIC karena berisi persen untuk voucher percentage
+ALTER TABLE voucher ALTER COLUMN max_discount TYPE BIGINT USING ROUND(max_discount)::BIGINT;
+ALTER TABLE voucher ALTER COLUMN min_spend TYPE BIGINT USING ROUND(min_spend)::BIGINT;
+ALTER TABLE voucher ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE voucher_usage ALTER COLUMN discount_amount TYPE BIGINT USING ROUND(discount_amount)::BIGINT;
@@ -0,0 +1,3 @@
+-- kurs dikunci per order: 1 base_currency = exchange_rate currency order
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS base_currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS exchange_rate NUMERIC NOT NULL DEFAULT 1;
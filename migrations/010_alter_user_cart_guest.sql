@@ -0,0 +1,5 @@
+-- cart pengunjung yang belum login disimpan dengan guest_cart_id dari cart token,
+-- saat login baris guest dipindahkan ke user_id
+ALTER TABLE user_cart ALTER COLUMN user_id DROP NOT NULL;
+ALTER TABLE user_cart ADD COLUMN IF NOT EXISTS guest_cart_id UUID;
+CREATE INDEX IF NOT EXISTS idx_user_cart_guest_cart_id ON user_cart (guest_cart_id);
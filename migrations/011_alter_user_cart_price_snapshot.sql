@@ -0,0 +1,3 @@
+-- harga produk dicatat saat ditambahkan ke cart agar perubahan harga bisa ditandai
+ALTER TABLE user_cart ADD COLUMN IF NOT EXISTS price_at_add BIGINT;
+ALTER TABLE user_cart ADD COLUMN IF NOT EXISTS currency_at_add VARCHAR(3);
@@ -0,0 +1,44 @@
+-- satu baris cart per produk per pemilik agar penambahan produk bisa memakai upsert.
+-- duplikat yang sudah ada digabung ke baris paling lama (created_at, lalu id), quantity dibatasi 1000
+WITH ranked AS (
+    SELECT id,
+        ROW_NUMBER() OVER (PARTITION BY user_id, product_id ORDER BY created_at, id) AS row_number,
+        COUNT(*) OVER (PARTITION BY user_id, product_id) AS row_count,
+        SUM(quantity) OVER (PARTITION BY user_id, product_id) AS total_quantity
+    FROM user_cart
+    WHERE user_id IS NOT NULL
+)
+UPDATE user_cart uc SET quantity = LEAST(r.total_quantity, 1000)
+FROM ranked r
+WHERE uc.id = r.id AND r.row_number = 1 AND r.row_count > 1;
+
+DELETE FROM user_cart uc
+USING (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY user_id, product_id ORDER BY created_at, id) AS row_number
+    FROM user_cart
+    WHERE user_id IS NOT NULL
+) r
+WHERE uc.id = r.id AND r.row_number > 1;
+
+WITH ranked AS (
+    SELECT id,
+        ROW_NUMBER() OVER (PARTITION BY guest_cart_id, product_id ORDER BY created_at, id) AS row_number,
+        COUNT(*) OVER (PARTITION BY guest_cart_id, product_id) AS row_count,
+        SUM(quantity) OVER (PARTITION BY guest_cart_id, product_id) AS total_quantity
+    FROM user_cart
+    WHERE guest_cart_id IS NOT NULL
+)
+UPDATE user_cart uc SET quantity = LEAST(r.total_quantity, 1000)
+FROM ranked r
+WHERE uc.id = r.id AND r.row_number = 1 AND r.row_count > 1;
+
+DELETE FROM user_cart uc
+USING (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY guest_cart_id, product_id ORDER BY created_at, id) AS row_number
+    FROM user_cart
+    WHERE guest_cart_id IS NOT NULL
+) r
+WHERE uc.id = r.id AND r.row_number > 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_user_cart_user_product ON user_cart (user_id, product_id) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_user_cart_guest_product ON user_cart (guest_cart_id, product_id) WHERE guest_cart_id IS NOT NULL;
@@ -0,0 +1,10 @@
+-- produk yang disimpan user untuk dibeli nanti, terpisah dari cart
+CREATE TABLE IF NOT EXISTS user_wishlist (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    product_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_user_wishlist_user_product ON user_wishlist (user_id, product_id);
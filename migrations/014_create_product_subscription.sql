@@ -0,0 +1,35 @@
+-- stok produk, NULL berarti stok tidak dicatat
+ALTER TABLE product ADD COLUMN IF NOT EXISTS stock BIGINT;
+
+-- langganan notifikasi produk kembali tersedia / harga turun
+CREATE TABLE IF NOT EXISTS product_subscription (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    product_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(255),
+    deleted_at TIMESTAMPTZ,
+    deleted_by VARCHAR(255),
+    is_deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_product_subscription_active ON product_subscription (user_id, product_id, event_type) WHERE is_deleted = false;
+CREATE INDEX IF NOT EXISTS product_subscription_product_idx ON product_subscription (product_id, event_type) WHERE is_deleted = false;
+
+-- riwayat notifikasi per penerima, dipakai untuk rate limit dan agar retry outbox tidak mengirim ulang
+CREATE TABLE IF NOT EXISTS product_notification (
+    id UUID PRIMARY KEY,
+    outbox_id UUID NOT NULL,
+    subscription_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    product_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_product_notification_outbox_subscription ON product_notification (outbox_id, subscription_id);
+CREATE INDEX IF NOT EXISTS product_notification_user_idx ON product_notification (user_id, created_at);
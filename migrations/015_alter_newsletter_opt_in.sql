@@ -0,0 +1,12 @@
+-- double opt-in newsletter: subscriber baru berstatus pending sampai link konfirmasi dibuka.
+-- subscriber lama dianggap sudah konfirmasi, unsubscribe memakai is_deleted
+ALTER TABLE newsletter ADD COLUMN IF NOT EXISTS status VARCHAR(50) NOT NULL DEFAULT 'confirmed';
+ALTER TABLE newsletter ADD COLUMN IF NOT EXISTS topics TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE newsletter ADD COLUMN IF NOT EXISTS confirm_token_hash VARCHAR(64);
+ALTER TABLE newsletter ADD COLUMN IF NOT EXISTS confirm_token_expires_at TIMESTAMPTZ;
+ALTER TABLE newsletter ADD COLUMN IF NOT EXISTS confirmed_at TIMESTAMPTZ;
+
+UPDATE newsletter SET topics = ARRAY['promotions', 'new_arrivals', 'articles'] WHERE topics = '{}';
+
+CREATE INDEX IF NOT EXISTS newsletter_email_idx ON newsletter (email) WHERE is_deleted = false;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_newsletter_confirm_token_hash ON newsletter (confirm_token_hash) WHERE confirm_token_hash IS NOT NULL;
@@ -0,0 +1,47 @@
+-- campaign newsletter yang dikirim admin ke segmen subscriber
+CREATE TABLE IF NOT EXISTS newsletter_campaign (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    template_code VARCHAR(50) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    cta_text VARCHAR(255),
+    cta_url TEXT,
+    segment VARCHAR(50) NOT NULL,
+    topic VARCHAR(50),
+    status VARCHAR(50) NOT NULL,
+    scheduled_at TIMESTAMPTZ,
+    started_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    total_recipients BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(255),
+    deleted_at TIMESTAMPTZ,
+    deleted_by VARCHAR(255),
+    is_deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS newsletter_campaign_status_idx ON newsletter_campaign (status, scheduled_at) WHERE is_deleted = false;
+
+-- penerima campaign, diisi saat campaign mulai dikirim agar subscriber baru tidak ikut di tengah pengiriman
+CREATE TABLE IF NOT EXISTS newsletter_campaign_recipient (
+    id UUID PRIMARY KEY,
+    campaign_id UUID NOT NULL,
+    newsletter_id UUID NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    full_name VARCHAR(255) NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    attempt_count INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_error TEXT,
+    sent_at TIMESTAMPTZ,
+    opened_at TIMESTAMPTZ,
+    clicked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_newsletter_campaign_recipient ON newsletter_campaign_recipient (campaign_id, newsletter_id);
+CREATE INDEX IF NOT EXISTS newsletter_campaign_recipient_pending_idx ON newsletter_campaign_recipient (next_attempt_at) WHERE status = 'pending';
@@ -0,0 +1,2 @@
+-- bahasa email transaksional order, diambil dari Accept-Language saat checkout
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS locale VARCHAR(10);
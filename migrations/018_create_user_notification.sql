@@ -0,0 +1,19 @@
+-- inbox notifikasi in-app, dibuat dari domain event order lewat outbox
+CREATE TABLE IF NOT EXISTS user_notification (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    event VARCHAR(50) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    order_id UUID,
+    order_status_code VARCHAR(50),
+    outbox_id UUID,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS user_notification_user_idx ON user_notification (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS user_notification_unread_idx ON user_notification (user_id) WHERE read_at IS NULL;
+-- retry outbox tidak membuat notifikasi ganda
+CREATE UNIQUE INDEX IF NOT EXISTS uq_user_notification_outbox ON user_notification (outbox_id) WHERE outbox_id IS NOT NULL;
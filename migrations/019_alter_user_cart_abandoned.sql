@@ -0,0 +1,2 @@
+-- waktu cart ditandai ditinggalkan oleh job abandoned cart, direset saat cart diubah lagi
+ALTER TABLE user_cart ADD COLUMN IF NOT EXISTS abandoned_at TIMESTAMPTZ;
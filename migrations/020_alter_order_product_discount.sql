@@ -0,0 +1,7 @@
+-- bagian diskon voucher untuk produk (tanpa diskon ongkir), dipakai untuk menghitung nominal refund per item.
+-- order lama diisi dari discount_amount kecuali voucher gratis ongkir
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS product_discount_amount NUMERIC NOT NULL DEFAULT 0;
+
+UPDATE "order" o SET product_discount_amount = o.discount_amount
+FROM voucher v
+WHERE o.voucher_id = v.id AND v.discount_type <> 'free_shipping' AND o.product_discount_amount = 0;
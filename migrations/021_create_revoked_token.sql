@@ -0,0 +1,9 @@
+-- token jwt yang sudah logout, disimpan di database agar server grpc dan rest memakai daftar yang sama.
+-- hanya hash sha256 token yang disimpan
+CREATE TABLE IF NOT EXISTS revoked_token (
+    token_hash VARCHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS revoked_token_expires_at_idx ON revoked_token (expires_at);
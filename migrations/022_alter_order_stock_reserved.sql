@@ -0,0 +1,3 @@
+-- order yang stok produknya sudah dikurangi saat checkout, stok dikembalikan sekali saat order dibatalkan atau expired.
+-- order lama bernilai false karena dibuat sebelum stok dikurangi
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS stock_reserved BOOLEAN NOT NULL DEFAULT false;
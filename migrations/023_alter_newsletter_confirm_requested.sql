@@ -0,0 +1,2 @@
+-- waktu email konfirmasi newsletter terakhir diminta, dipakai sebagai cooldown kirim ulang per alamat email
+ALTER TABLE newsletter ADD COLUMN IF NOT EXISTS confirm_requested_at TIMESTAMPTZ;
@@ -0,0 +1,2 @@
+-- waktu terakhir item lain milik pemilik cart dihapus, dihitung sebagai aktivitas oleh job abandoned cart
+ALTER TABLE user_cart ADD COLUMN IF NOT EXISTS owner_activity_at TIMESTAMPTZ;
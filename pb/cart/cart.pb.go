@@ -24,8 +24,10 @@ const (
 )
 
 type AddProductToCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// 0 diperlakukan sebagai 1 untuk client lama
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -67,10 +69,19 @@ func (x *AddProductToCartRequest) GetProductId() string {
 	return ""
 }
 
+func (x *AddProductToCartRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 type AddProductToCartResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Base  *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id    string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// diisi jika guest cart baru dibuat, kirim kembali di metadata x-cart-token
+	CartToken     string `protobuf:"bytes,3,opt,name=cart_token,json=cartToken,proto3" json:"cart_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -119,8 +130,16 @@ func (x *AddProductToCartResponse) GetId() string {
 	return ""
 }
 
+func (x *AddProductToCartResponse) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
 type ListCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -155,16 +174,32 @@ func (*ListCartRequest) Descriptor() ([]byte, []int) {
 	return file_cart_cart_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *ListCartRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type ListCartResponseItem struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	CartId          string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
 	ProductId       string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	ProductName     string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
 	ProductImageUrl string                 `protobuf:"bytes,4,opt,name=product_image_url,json=productImageUrl,proto3" json:"product_image_url,omitempty"`
-	ProductPrice    float64                `protobuf:"fixed64,5,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"`
-	Quantity        int64                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Deprecated: Marked as deprecated in cart/cart.proto.
+	ProductPrice      float64       `protobuf:"fixed64,5,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"`
+	Quantity          int64         `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ProductPriceMoney *common.Money `protobuf:"bytes,7,opt,name=product_price_money,json=productPriceMoney,proto3" json:"product_price_money,omitempty"`
+	// harga saat produk ditambahkan ke cart, kosong untuk cart lama
+	PriceAtAdd   *common.Money `protobuf:"bytes,8,opt,name=price_at_add,json=priceAtAdd,proto3" json:"price_at_add,omitempty"`
+	PriceChanged bool          `protobuf:"varint,9,opt,name=price_changed,json=priceChanged,proto3" json:"price_changed,omitempty"`
+	Subtotal     *common.Money `protobuf:"bytes,10,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Available    bool          `protobuf:"varint,11,opt,name=available,proto3" json:"available,omitempty"`
+	// product_deleted atau product_not_found jika available false
+	UnavailableReason string `protobuf:"bytes,12,opt,name=unavailable_reason,json=unavailableReason,proto3" json:"unavailable_reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ListCartResponseItem) Reset() {
@@ -225,6 +260,7 @@ func (x *ListCartResponseItem) GetProductImageUrl() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in cart/cart.proto.
 func (x *ListCartResponseItem) GetProductPrice() float64 {
 	if x != nil {
 		return x.ProductPrice
@@ -239,12 +275,59 @@ func (x *ListCartResponseItem) GetQuantity() int64 {
 	return 0
 }
 
+func (x *ListCartResponseItem) GetProductPriceMoney() *common.Money {
+	if x != nil {
+		return x.ProductPriceMoney
+	}
+	return nil
+}
+
+func (x *ListCartResponseItem) GetPriceAtAdd() *common.Money {
+	if x != nil {
+		return x.PriceAtAdd
+	}
+	return nil
+}
+
+func (x *ListCartResponseItem) GetPriceChanged() bool {
+	if x != nil {
+		return x.PriceChanged
+	}
+	return false
+}
+
+func (x *ListCartResponseItem) GetSubtotal() *common.Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *ListCartResponseItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *ListCartResponseItem) GetUnavailableReason() string {
+	if x != nil {
+		return x.UnavailableReason
+	}
+	return ""
+}
+
 type ListCartResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Base          *common.BaseResponse    `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Items         []*ListCartResponseItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState  `protogen:"open.v1"`
+	Base  *common.BaseResponse    `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items []*ListCartResponseItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// total hanya menghitung item yang available
+	Total               *common.Money `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	TotalQuantity       int64         `protobuf:"varint,4,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	HasPriceChanges     bool          `protobuf:"varint,5,opt,name=has_price_changes,json=hasPriceChanges,proto3" json:"has_price_changes,omitempty"`
+	HasUnavailableItems bool          `protobuf:"varint,6,opt,name=has_unavailable_items,json=hasUnavailableItems,proto3" json:"has_unavailable_items,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *ListCartResponse) Reset() {
@@ -291,6 +374,34 @@ func (x *ListCartResponse) GetItems() []*ListCartResponseItem {
 	return nil
 }
 
+func (x *ListCartResponse) GetTotal() *common.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *ListCartResponse) GetTotalQuantity() int64 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *ListCartResponse) GetHasPriceChanges() bool {
+	if x != nil {
+		return x.HasPriceChanges
+	}
+	return false
+}
+
+func (x *ListCartResponse) GetHasUnavailableItems() bool {
+	if x != nil {
+		return x.HasUnavailableItems
+	}
+	return false
+}
+
 type DeleteCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
@@ -475,30 +586,286 @@ func (x *UpdateCartQuantityResponse) GetBase() *common.BaseResponse {
 	return nil
 }
 
+type BulkUpdateCartItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// quantity 0 menghapus produk dari cart
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateCartItem) Reset() {
+	*x = BulkUpdateCartItem{}
+	mi := &file_cart_cart_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateCartItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateCartItem) ProtoMessage() {}
+
+func (x *BulkUpdateCartItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateCartItem.ProtoReflect.Descriptor instead.
+func (*BulkUpdateCartItem) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BulkUpdateCartItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *BulkUpdateCartItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type BulkUpdateCartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*BulkUpdateCartItem  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// replace menghapus produk di cart yang tidak ada di items
+	Replace       bool `protobuf:"varint,2,opt,name=replace,proto3" json:"replace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateCartRequest) Reset() {
+	*x = BulkUpdateCartRequest{}
+	mi := &file_cart_cart_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateCartRequest) ProtoMessage() {}
+
+func (x *BulkUpdateCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateCartRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BulkUpdateCartRequest) GetItems() []*BulkUpdateCartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BulkUpdateCartRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type BulkUpdateCartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Base  *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// diisi jika guest cart baru dibuat
+	CartToken     string `protobuf:"bytes,2,opt,name=cart_token,json=cartToken,proto3" json:"cart_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateCartResponse) Reset() {
+	*x = BulkUpdateCartResponse{}
+	mi := &file_cart_cart_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateCartResponse) ProtoMessage() {}
+
+func (x *BulkUpdateCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateCartResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BulkUpdateCartResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *BulkUpdateCartResponse) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
+type ClearCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearCartRequest) Reset() {
+	*x = ClearCartRequest{}
+	mi := &file_cart_cart_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartRequest) ProtoMessage() {}
+
+func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
+func (*ClearCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{12}
+}
+
+type ClearCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearCartResponse) Reset() {
+	*x = ClearCartResponse{}
+	mi := &file_cart_cart_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartResponse) ProtoMessage() {}
+
+func (x *ClearCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartResponse.ProtoReflect.Descriptor instead.
+func (*ClearCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ClearCartResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
 var File_cart_cart_proto protoreflect.FileDescriptor
 
 const file_cart_cart_proto_rawDesc = "" +
 	"\n" +
-	"\x0fcart/cart.proto\x12\x04cart\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\"D\n" +
+	"\x0fcart/cart.proto\x12\x04cart\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x1bbuf/validate/validate.proto\"l\n" +
 	"\x17AddProductToCartRequest\x12)\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\"T\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\x12&\n" +
+	"\bquantity\x18\x02 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xe8\a(\x00R\bquantity\"s\n" +
 	"\x18AddProductToCartResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"\x11\n" +
-	"\x0fListCartRequest\"\xde\x01\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"cart_token\x18\x03 \x01(\tR\tcartToken\"6\n" +
+	"\x0fListCartRequest\x12#\n" +
+	"\bcurrency\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18\x03R\bcurrency\"\xef\x03\n" +
 	"\x14ListCartResponseItem\x12\x17\n" +
 	"\acart_id\x18\x01 \x01(\tR\x06cartId\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
 	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12*\n" +
-	"\x11product_image_url\x18\x04 \x01(\tR\x0fproductImageUrl\x12#\n" +
-	"\rproduct_price\x18\x05 \x01(\x01R\fproductPrice\x12\x1a\n" +
-	"\bquantity\x18\x06 \x01(\x03R\bquantity\"n\n" +
+	"\x11product_image_url\x18\x04 \x01(\tR\x0fproductImageUrl\x12'\n" +
+	"\rproduct_price\x18\x05 \x01(\x01B\x02\x18\x01R\fproductPrice\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x03R\bquantity\x12=\n" +
+	"\x13product_price_money\x18\a \x01(\v2\r.common.MoneyR\x11productPriceMoney\x12/\n" +
+	"\fprice_at_add\x18\b \x01(\v2\r.common.MoneyR\n" +
+	"priceAtAdd\x12#\n" +
+	"\rprice_changed\x18\t \x01(\bR\fpriceChanged\x12)\n" +
+	"\bsubtotal\x18\n" +
+	" \x01(\v2\r.common.MoneyR\bsubtotal\x12\x1c\n" +
+	"\tavailable\x18\v \x01(\bR\tavailable\x12-\n" +
+	"\x12unavailable_reason\x18\f \x01(\tR\x11unavailableReason\"\x9a\x02\n" +
 	"\x10ListCartResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x120\n" +
-	"\x05items\x18\x02 \x03(\v2\x1a.cart.ListCartResponseItemR\x05items\"8\n" +
+	"\x05items\x18\x02 \x03(\v2\x1a.cart.ListCartResponseItemR\x05items\x12#\n" +
+	"\x05total\x18\x03 \x01(\v2\r.common.MoneyR\x05total\x12%\n" +
+	"\x0etotal_quantity\x18\x04 \x01(\x03R\rtotalQuantity\x12*\n" +
+	"\x11has_price_changes\x18\x05 \x01(\bR\x0fhasPriceChanges\x122\n" +
+	"\x15has_unavailable_items\x18\x06 \x01(\bR\x13hasUnavailableItems\"8\n" +
 	"\x11DeleteCartRequest\x12#\n" +
 	"\acart_id\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06cartId\">\n" +
@@ -509,13 +876,30 @@ const file_cart_cart_proto_rawDesc = "" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06cartId\x12*\n" +
 	"\fnew_quantity\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\vnewQuantity\"F\n" +
 	"\x1aUpdateCartQuantityResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xb5\x02\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"e\n" +
+	"\x12BulkUpdateCartItem\x12'\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tproductId\x12&\n" +
+	"\bquantity\x18\x02 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xe8\a(\x00R\bquantity\"k\n" +
+	"\x15BulkUpdateCartRequest\x128\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.cart.BulkUpdateCartItemB\b\xbaH\x05\x92\x01\x02\x10dR\x05items\x12\x18\n" +
+	"\areplace\x18\x02 \x01(\bR\areplace\"a\n" +
+	"\x16BulkUpdateCartResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x1d\n" +
+	"\n" +
+	"cart_token\x18\x02 \x01(\tR\tcartToken\"\x12\n" +
+	"\x10ClearCartRequest\"=\n" +
+	"\x11ClearCartResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xc0\x03\n" +
 	"\vCartService\x12Q\n" +
 	"\x10AddProductToCart\x12\x1d.cart.AddProductToCartRequest\x1a\x1e.cart.AddProductToCartResponse\x129\n" +
 	"\bListCart\x12\x15.cart.ListCartRequest\x1a\x16.cart.ListCartResponse\x12?\n" +
 	"\n" +
 	"DeleteCart\x12\x17.cart.DeleteCartRequest\x1a\x18.cart.DeleteCartResponse\x12W\n" +
-	"\x12UpdateCartQuantity\x12\x1f.cart.UpdateCartQuantityRequest\x1a .cart.UpdateCartQuantityResponseB3Z1github.com/luzmareto/go-grpc-ecommerce-be/pb/cartb\x06proto3"
+	"\x12UpdateCartQuantity\x12\x1f.cart.UpdateCartQuantityRequest\x1a .cart.UpdateCartQuantityResponse\x12K\n" +
+	"\x0eBulkUpdateCart\x12\x1b.cart.BulkUpdateCartRequest\x1a\x1c.cart.BulkUpdateCartResponse\x12<\n" +
+	"\tClearCart\x12\x16.cart.ClearCartRequest\x1a\x17.cart.ClearCartResponseB3Z1github.com/luzmareto/go-grpc-ecommerce-be/pb/cartb\x06proto3"
 
 var (
 	file_cart_cart_proto_rawDescOnce sync.Once
@@ -529,7 +913,7 @@ func file_cart_cart_proto_rawDescGZIP() []byte {
 	return file_cart_cart_proto_rawDescData
 }
 
-var file_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_cart_cart_proto_goTypes = []any{
 	(*AddProductToCartRequest)(nil),    // 0: cart.AddProductToCartRequest
 	(*AddProductToCartResponse)(nil),   // 1: cart.AddProductToCartResponse
@@ -540,27 +924,44 @@ var file_cart_cart_proto_goTypes = []any{
 	(*DeleteCartResponse)(nil),         // 6: cart.DeleteCartResponse
 	(*UpdateCartQuantityRequest)(nil),  // 7: cart.UpdateCartQuantityRequest
 	(*UpdateCartQuantityResponse)(nil), // 8: cart.UpdateCartQuantityResponse
-	(*common.BaseResponse)(nil),        // 9: common.BaseResponse
+	(*BulkUpdateCartItem)(nil),         // 9: cart.BulkUpdateCartItem
+	(*BulkUpdateCartRequest)(nil),      // 10: cart.BulkUpdateCartRequest
+	(*BulkUpdateCartResponse)(nil),     // 11: cart.BulkUpdateCartResponse
+	(*ClearCartRequest)(nil),           // 12: cart.ClearCartRequest
+	(*ClearCartResponse)(nil),          // 13: cart.ClearCartResponse
+	(*common.BaseResponse)(nil),        // 14: common.BaseResponse
+	(*common.Money)(nil),               // 15: common.Money
 }
 var file_cart_cart_proto_depIdxs = []int32{
-	9, // 0: cart.AddProductToCartResponse.base:type_name -> common.BaseResponse
-	9, // 1: cart.ListCartResponse.base:type_name -> common.BaseResponse
-	3, // 2: cart.ListCartResponse.items:type_name -> cart.ListCartResponseItem
-	9, // 3: cart.DeleteCartResponse.base:type_name -> common.BaseResponse
-	9, // 4: cart.UpdateCartQuantityResponse.base:type_name -> common.BaseResponse
-	0, // 5: cart.CartService.AddProductToCart:input_type -> cart.AddProductToCartRequest
-	2, // 6: cart.CartService.ListCart:input_type -> cart.ListCartRequest
-	5, // 7: cart.CartService.DeleteCart:input_type -> cart.DeleteCartRequest
-	7, // 8: cart.CartService.UpdateCartQuantity:input_type -> cart.UpdateCartQuantityRequest
-	1, // 9: cart.CartService.AddProductToCart:output_type -> cart.AddProductToCartResponse
-	4, // 10: cart.CartService.ListCart:output_type -> cart.ListCartResponse
-	6, // 11: cart.CartService.DeleteCart:output_type -> cart.DeleteCartResponse
-	8, // 12: cart.CartService.UpdateCartQuantity:output_type -> cart.UpdateCartQuantityResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	14, // 0: cart.AddProductToCartResponse.base:type_name -> common.BaseResponse
+	15, // 1: cart.ListCartResponseItem.product_price_money:type_name -> common.Money
+	15, // 2: cart.ListCartResponseItem.price_at_add:type_name -> common.Money
+	15, // 3: cart.ListCartResponseItem.subtotal:type_name -> common.Money
+	14, // 4: cart.ListCartResponse.base:type_name -> common.BaseResponse
+	3,  // 5: cart.ListCartResponse.items:type_name -> cart.ListCartResponseItem
+	15, // 6: cart.ListCartResponse.total:type_name -> common.Money
+	14, // 7: cart.DeleteCartResponse.base:type_name -> common.BaseResponse
+	14, // 8: cart.UpdateCartQuantityResponse.base:type_name -> common.BaseResponse
+	9,  // 9: cart.BulkUpdateCartRequest.items:type_name -> cart.BulkUpdateCartItem
+	14, // 10: cart.BulkUpdateCartResponse.base:type_name -> common.BaseResponse
+	14, // 11: cart.ClearCartResponse.base:type_name -> common.BaseResponse
+	0,  // 12: cart.CartService.AddProductToCart:input_type -> cart.AddProductToCartRequest
+	2,  // 13: cart.CartService.ListCart:input_type -> cart.ListCartRequest
+	5,  // 14: cart.CartService.DeleteCart:input_type -> cart.DeleteCartRequest
+	7,  // 15: cart.CartService.UpdateCartQuantity:input_type -> cart.UpdateCartQuantityRequest
+	10, // 16: cart.CartService.BulkUpdateCart:input_type -> cart.BulkUpdateCartRequest
+	12, // 17: cart.CartService.ClearCart:input_type -> cart.ClearCartRequest
+	1,  // 18: cart.CartService.AddProductToCart:output_type -> cart.AddProductToCartResponse
+	4,  // 19: cart.CartService.ListCart:output_type -> cart.ListCartResponse
+	6,  // 20: cart.CartService.DeleteCart:output_type -> cart.DeleteCartResponse
+	8,  // 21: cart.CartService.UpdateCartQuantity:output_type -> cart.UpdateCartQuantityResponse
+	11, // 22: cart.CartService.BulkUpdateCart:output_type -> cart.BulkUpdateCartResponse
+	13, // 23: cart.CartService.ClearCart:output_type -> cart.ClearCartResponse
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_cart_cart_proto_init() }
@@ -574,7 +975,7 @@ func file_cart_cart_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cart_cart_proto_rawDesc), len(file_cart_cart_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -9,6 +9,7 @@
 package common
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -76,7 +77,8 @@ func (x *PaginationSortRequest) GetDirection() string {
 }
 
 type PaginationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// offset dan jumlah halaman dihitung dari dua field ini, nilai 0 membuat pembagian dengan nol
 	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
 	ItemPerPage   int32                  `protobuf:"varint,2,opt,name=item_per_page,json=itemPerPage,proto3" json:"item_per_page,omitempty"`
 	Sort          *PaginationSortRequest `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
@@ -207,13 +209,13 @@ var File_common_pagination_proto protoreflect.FileDescriptor
 
 const file_common_pagination_proto_rawDesc = "" +
 	"\n" +
-	"\x17common/pagination.proto\x12\x06common\"K\n" +
+	"\x17common/pagination.proto\x12\x06common\x1a\x1bbuf/validate/validate.proto\"K\n" +
 	"\x15PaginationSortRequest\x12\x14\n" +
 	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1c\n" +
-	"\tdirection\x18\x02 \x01(\tR\tdirection\"\x8d\x01\n" +
-	"\x11PaginationRequest\x12!\n" +
-	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12\"\n" +
-	"\ritem_per_page\x18\x02 \x01(\x05R\vitemPerPage\x121\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\"\x9f\x01\n" +
+	"\x11PaginationRequest\x12*\n" +
+	"\fcurrent_page\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\vcurrentPage\x12+\n" +
+	"\ritem_per_page\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\vitemPerPage\x121\n" +
 	"\x04sort\x18\x03 \x01(\v2\x1d.common.PaginationSortRequestR\x04sort\"\xaf\x01\n" +
 	"\x12PaginationResponse\x12!\n" +
 	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12(\n" +
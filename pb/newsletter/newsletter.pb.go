@@ -11,6 +11,7 @@ import (
 	common "github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -24,9 +25,11 @@ const (
 )
 
 type SubcribeNewsletterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Email    string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	FullName string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	// kosong berarti berlangganan semua topik
+	Topics        []string `protobuf:"bytes,3,rep,name=topics,proto3" json:"topics,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -75,6 +78,13 @@ func (x *SubcribeNewsletterRequest) GetFullName() string {
 	return ""
 }
 
+func (x *SubcribeNewsletterRequest) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
 type SubcribeNewsletterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -119,20 +129,735 @@ func (x *SubcribeNewsletterResponse) GetBase() *common.BaseResponse {
 	return nil
 }
 
+type ConfirmNewsletterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmNewsletterRequest) Reset() {
+	*x = ConfirmNewsletterRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmNewsletterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmNewsletterRequest) ProtoMessage() {}
+
+func (x *ConfirmNewsletterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmNewsletterRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmNewsletterRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConfirmNewsletterRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ConfirmNewsletterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmNewsletterResponse) Reset() {
+	*x = ConfirmNewsletterResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmNewsletterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmNewsletterResponse) ProtoMessage() {}
+
+func (x *ConfirmNewsletterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmNewsletterResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmNewsletterResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ConfirmNewsletterResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type GetNewsletterSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNewsletterSubscriptionRequest) Reset() {
+	*x = GetNewsletterSubscriptionRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewsletterSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewsletterSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetNewsletterSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewsletterSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetNewsletterSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{4}
+}
+
+type GetNewsletterSubscriptionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Base  *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// not_subscribed, pending atau confirmed
+	Status          string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Topics          []string `protobuf:"bytes,3,rep,name=topics,proto3" json:"topics,omitempty"`
+	AvailableTopics []string `protobuf:"bytes,4,rep,name=available_topics,json=availableTopics,proto3" json:"available_topics,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetNewsletterSubscriptionResponse) Reset() {
+	*x = GetNewsletterSubscriptionResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewsletterSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewsletterSubscriptionResponse) ProtoMessage() {}
+
+func (x *GetNewsletterSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewsletterSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*GetNewsletterSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetNewsletterSubscriptionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetNewsletterSubscriptionResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetNewsletterSubscriptionResponse) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *GetNewsletterSubscriptionResponse) GetAvailableTopics() []string {
+	if x != nil {
+		return x.AvailableTopics
+	}
+	return nil
+}
+
+type UpdateNewsletterPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topics        []string               `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNewsletterPreferencesRequest) Reset() {
+	*x = UpdateNewsletterPreferencesRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNewsletterPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNewsletterPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNewsletterPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNewsletterPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNewsletterPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateNewsletterPreferencesRequest) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type UpdateNewsletterPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNewsletterPreferencesResponse) Reset() {
+	*x = UpdateNewsletterPreferencesResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNewsletterPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNewsletterPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateNewsletterPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNewsletterPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNewsletterPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateNewsletterPreferencesResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type UnsubscribeNewsletterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeNewsletterRequest) Reset() {
+	*x = UnsubscribeNewsletterRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeNewsletterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeNewsletterRequest) ProtoMessage() {}
+
+func (x *UnsubscribeNewsletterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeNewsletterRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeNewsletterRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{8}
+}
+
+type UnsubscribeNewsletterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeNewsletterResponse) Reset() {
+	*x = UnsubscribeNewsletterResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeNewsletterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeNewsletterResponse) ProtoMessage() {}
+
+func (x *UnsubscribeNewsletterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeNewsletterResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeNewsletterResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnsubscribeNewsletterResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListSubscribersRequest struct {
+	state      protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// dicocokkan ke email atau nama
+	Search string `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`
+	// kosong berarti semua status
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscribersRequest) Reset() {
+	*x = ListSubscribersRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscribersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribersRequest) ProtoMessage() {}
+
+func (x *ListSubscribersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribersRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscribersRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSubscribersRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListSubscribersRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListSubscribersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListSubscribersResponseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	FullName      string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Topics        []string               `protobuf:"bytes,5,rep,name=topics,proto3" json:"topics,omitempty"`
+	ConfirmedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscribersResponseItem) Reset() {
+	*x = ListSubscribersResponseItem{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscribersResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribersResponseItem) ProtoMessage() {}
+
+func (x *ListSubscribersResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribersResponseItem.ProtoReflect.Descriptor instead.
+func (*ListSubscribersResponseItem) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListSubscribersResponseItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListSubscribersResponseItem) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ListSubscribersResponseItem) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *ListSubscribersResponseItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListSubscribersResponseItem) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *ListSubscribersResponseItem) GetConfirmedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfirmedAt
+	}
+	return nil
+}
+
+func (x *ListSubscribersResponseItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSubscribersResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Base          *common.BaseResponse           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Items         []*ListSubscribersResponseItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscribersResponse) Reset() {
+	*x = ListSubscribersResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscribersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribersResponse) ProtoMessage() {}
+
+func (x *ListSubscribersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribersResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscribersResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListSubscribersResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListSubscribersResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListSubscribersResponse) GetItems() []*ListSubscribersResponseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RemoveSubscriberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSubscriberRequest) Reset() {
+	*x = RemoveSubscriberRequest{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSubscriberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSubscriberRequest) ProtoMessage() {}
+
+func (x *RemoveSubscriberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSubscriberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSubscriberRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveSubscriberRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveSubscriberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSubscriberResponse) Reset() {
+	*x = RemoveSubscriberResponse{}
+	mi := &file_newsletter_newsletter_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSubscriberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSubscriberResponse) ProtoMessage() {}
+
+func (x *RemoveSubscriberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSubscriberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSubscriberResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveSubscriberResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
 var File_newsletter_newsletter_proto protoreflect.FileDescriptor
 
 const file_newsletter_newsletter_proto_rawDesc = "" +
 	"\n" +
 	"\x1bnewsletter/newsletter.proto\x12\n" +
-	"newsletter\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\"h\n" +
+	"newsletter\x1a\x1acommon/base_response.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb2\x01\n" +
 	"\x19SubcribeNewsletterRequest\x12\"\n" +
 	"\x05email\x18\x01 \x01(\tB\f\xbaH\tr\a\x10\x01\x18\xff\x01`\x01R\x05email\x12'\n" +
 	"\tfull_name\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\bfullName\"F\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\bfullName\x12H\n" +
+	"\x06topics\x18\x03 \x03(\tB0\xbaH-\x92\x01*\x18\x01\"&r$R\n" +
+	"promotionsR\fnew_arrivalsR\barticlesR\x06topics\"F\n" +
 	"\x1aSubcribeNewsletterResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2x\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"<\n" +
+	"\x18ConfirmNewsletterRequest\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05token\"E\n" +
+	"\x19ConfirmNewsletterResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\"\n" +
+	" GetNewsletterSubscriptionRequest\"\xa8\x01\n" +
+	"!GetNewsletterSubscriptionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06topics\x18\x03 \x03(\tR\x06topics\x12)\n" +
+	"\x10available_topics\x18\x04 \x03(\tR\x0favailableTopics\"p\n" +
+	"\"UpdateNewsletterPreferencesRequest\x12J\n" +
+	"\x06topics\x18\x01 \x03(\tB2\xbaH/\x92\x01,\b\x01\x18\x01\"&r$R\n" +
+	"promotionsR\fnew_arrivalsR\barticlesR\x06topics\"O\n" +
+	"#UpdateNewsletterPreferencesResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\x1e\n" +
+	"\x1cUnsubscribeNewsletterRequest\"I\n" +
+	"\x1dUnsubscribeNewsletterResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\xb2\x01\n" +
+	"\x16ListSubscribersRequest\x12A\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"pagination\x12 \n" +
+	"\x06search\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x06search\x123\n" +
+	"\x06status\x18\x03 \x01(\tB\x1b\xbaH\x18r\x16R\x00R\apendingR\tconfirmedR\x06status\"\x8a\x02\n" +
+	"\x1bListSubscribersResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
+	"\tfull_name\x18\x03 \x01(\tR\bfullName\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06topics\x18\x05 \x03(\tR\x06topics\x12=\n" +
+	"\fconfirmed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vconfirmedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbe\x01\n" +
+	"\x17ListSubscribersResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\x12=\n" +
+	"\x05items\x18\x03 \x03(\v2'.newsletter.ListSubscribersResponseItemR\x05items\"3\n" +
+	"\x17RemoveSubscriberRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"D\n" +
+	"\x18RemoveSubscriberResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xfd\x05\n" +
 	"\x11NewsletterService\x12c\n" +
-	"\x12SubcribeNewsletter\x12%.newsletter.SubcribeNewsletterRequest\x1a&.newsletter.SubcribeNewsletterResponseB9Z7github.com/luzmareto/go-grpc-ecommerce-be/pb/newsletterb\x06proto3"
+	"\x12SubcribeNewsletter\x12%.newsletter.SubcribeNewsletterRequest\x1a&.newsletter.SubcribeNewsletterResponse\x12`\n" +
+	"\x11ConfirmNewsletter\x12$.newsletter.ConfirmNewsletterRequest\x1a%.newsletter.ConfirmNewsletterResponse\x12x\n" +
+	"\x19GetNewsletterSubscription\x12,.newsletter.GetNewsletterSubscriptionRequest\x1a-.newsletter.GetNewsletterSubscriptionResponse\x12~\n" +
+	"\x1bUpdateNewsletterPreferences\x12..newsletter.UpdateNewsletterPreferencesRequest\x1a/.newsletter.UpdateNewsletterPreferencesResponse\x12l\n" +
+	"\x15UnsubscribeNewsletter\x12(.newsletter.UnsubscribeNewsletterRequest\x1a).newsletter.UnsubscribeNewsletterResponse\x12Z\n" +
+	"\x0fListSubscribers\x12\".newsletter.ListSubscribersRequest\x1a#.newsletter.ListSubscribersResponse\x12]\n" +
+	"\x10RemoveSubscriber\x12#.newsletter.RemoveSubscriberRequest\x1a$.newsletter.RemoveSubscriberResponseB9Z7github.com/luzmareto/go-grpc-ecommerce-be/pb/newsletterb\x06proto3"
 
 var (
 	file_newsletter_newsletter_proto_rawDescOnce sync.Once
@@ -146,21 +871,60 @@ func file_newsletter_newsletter_proto_rawDescGZIP() []byte {
 	return file_newsletter_newsletter_proto_rawDescData
 }
 
-var file_newsletter_newsletter_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_newsletter_newsletter_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_newsletter_newsletter_proto_goTypes = []any{
-	(*SubcribeNewsletterRequest)(nil),  // 0: newsletter.SubcribeNewsletterRequest
-	(*SubcribeNewsletterResponse)(nil), // 1: newsletter.SubcribeNewsletterResponse
-	(*common.BaseResponse)(nil),        // 2: common.BaseResponse
+	(*SubcribeNewsletterRequest)(nil),           // 0: newsletter.SubcribeNewsletterRequest
+	(*SubcribeNewsletterResponse)(nil),          // 1: newsletter.SubcribeNewsletterResponse
+	(*ConfirmNewsletterRequest)(nil),            // 2: newsletter.ConfirmNewsletterRequest
+	(*ConfirmNewsletterResponse)(nil),           // 3: newsletter.ConfirmNewsletterResponse
+	(*GetNewsletterSubscriptionRequest)(nil),    // 4: newsletter.GetNewsletterSubscriptionRequest
+	(*GetNewsletterSubscriptionResponse)(nil),   // 5: newsletter.GetNewsletterSubscriptionResponse
+	(*UpdateNewsletterPreferencesRequest)(nil),  // 6: newsletter.UpdateNewsletterPreferencesRequest
+	(*UpdateNewsletterPreferencesResponse)(nil), // 7: newsletter.UpdateNewsletterPreferencesResponse
+	(*UnsubscribeNewsletterRequest)(nil),        // 8: newsletter.UnsubscribeNewsletterRequest
+	(*UnsubscribeNewsletterResponse)(nil),       // 9: newsletter.UnsubscribeNewsletterResponse
+	(*ListSubscribersRequest)(nil),              // 10: newsletter.ListSubscribersRequest
+	(*ListSubscribersResponseItem)(nil),         // 11: newsletter.ListSubscribersResponseItem
+	(*ListSubscribersResponse)(nil),             // 12: newsletter.ListSubscribersResponse
+	(*RemoveSubscriberRequest)(nil),             // 13: newsletter.RemoveSubscriberRequest
+	(*RemoveSubscriberResponse)(nil),            // 14: newsletter.RemoveSubscriberResponse
+	(*common.BaseResponse)(nil),                 // 15: common.BaseResponse
+	(*common.PaginationRequest)(nil),            // 16: common.PaginationRequest
+	(*timestamppb.Timestamp)(nil),               // 17: google.protobuf.Timestamp
+	(*common.PaginationResponse)(nil),           // 18: common.PaginationResponse
 }
 var file_newsletter_newsletter_proto_depIdxs = []int32{
-	2, // 0: newsletter.SubcribeNewsletterResponse.base:type_name -> common.BaseResponse
-	0, // 1: newsletter.NewsletterService.SubcribeNewsletter:input_type -> newsletter.SubcribeNewsletterRequest
-	1, // 2: newsletter.NewsletterService.SubcribeNewsletter:output_type -> newsletter.SubcribeNewsletterResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	15, // 0: newsletter.SubcribeNewsletterResponse.base:type_name -> common.BaseResponse
+	15, // 1: newsletter.ConfirmNewsletterResponse.base:type_name -> common.BaseResponse
+	15, // 2: newsletter.GetNewsletterSubscriptionResponse.base:type_name -> common.BaseResponse
+	15, // 3: newsletter.UpdateNewsletterPreferencesResponse.base:type_name -> common.BaseResponse
+	15, // 4: newsletter.UnsubscribeNewsletterResponse.base:type_name -> common.BaseResponse
+	16, // 5: newsletter.ListSubscribersRequest.pagination:type_name -> common.PaginationRequest
+	17, // 6: newsletter.ListSubscribersResponseItem.confirmed_at:type_name -> google.protobuf.Timestamp
+	17, // 7: newsletter.ListSubscribersResponseItem.created_at:type_name -> google.protobuf.Timestamp
+	15, // 8: newsletter.ListSubscribersResponse.base:type_name -> common.BaseResponse
+	18, // 9: newsletter.ListSubscribersResponse.pagination:type_name -> common.PaginationResponse
+	11, // 10: newsletter.ListSubscribersResponse.items:type_name -> newsletter.ListSubscribersResponseItem
+	15, // 11: newsletter.RemoveSubscriberResponse.base:type_name -> common.BaseResponse
+	0,  // 12: newsletter.NewsletterService.SubcribeNewsletter:input_type -> newsletter.SubcribeNewsletterRequest
+	2,  // 13: newsletter.NewsletterService.ConfirmNewsletter:input_type -> newsletter.ConfirmNewsletterRequest
+	4,  // 14: newsletter.NewsletterService.GetNewsletterSubscription:input_type -> newsletter.GetNewsletterSubscriptionRequest
+	6,  // 15: newsletter.NewsletterService.UpdateNewsletterPreferences:input_type -> newsletter.UpdateNewsletterPreferencesRequest
+	8,  // 16: newsletter.NewsletterService.UnsubscribeNewsletter:input_type -> newsletter.UnsubscribeNewsletterRequest
+	10, // 17: newsletter.NewsletterService.ListSubscribers:input_type -> newsletter.ListSubscribersRequest
+	13, // 18: newsletter.NewsletterService.RemoveSubscriber:input_type -> newsletter.RemoveSubscriberRequest
+	1,  // 19: newsletter.NewsletterService.SubcribeNewsletter:output_type -> newsletter.SubcribeNewsletterResponse
+	3,  // 20: newsletter.NewsletterService.ConfirmNewsletter:output_type -> newsletter.ConfirmNewsletterResponse
+	5,  // 21: newsletter.NewsletterService.GetNewsletterSubscription:output_type -> newsletter.GetNewsletterSubscriptionResponse
+	7,  // 22: newsletter.NewsletterService.UpdateNewsletterPreferences:output_type -> newsletter.UpdateNewsletterPreferencesResponse
+	9,  // 23: newsletter.NewsletterService.UnsubscribeNewsletter:output_type -> newsletter.UnsubscribeNewsletterResponse
+	12, // 24: newsletter.NewsletterService.ListSubscribers:output_type -> newsletter.ListSubscribersResponse
+	14, // 25: newsletter.NewsletterService.RemoveSubscriber:output_type -> newsletter.RemoveSubscriberResponse
+	19, // [19:26] is the sub-list for method output_type
+	12, // [12:19] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_newsletter_newsletter_proto_init() }
@@ -174,7 +938,7 @@ func file_newsletter_newsletter_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_newsletter_newsletter_proto_rawDesc), len(file_newsletter_newsletter_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
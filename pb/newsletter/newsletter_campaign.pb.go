@@ -0,0 +1,1200 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: newsletter/newsletter_campaign.proto
+
+package newsletter
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListCampaignTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignTemplatesRequest) Reset() {
+	*x = ListCampaignTemplatesRequest{}
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignTemplatesRequest) ProtoMessage() {}
+
+func (x *ListCampaignTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListCampaignTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_campaign_proto_rawDescGZIP(), []int{0}
+}
+
+type ListCampaignTemplatesResponseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignTemplatesResponseItem) Reset() {
+	*x = ListCampaignTemplatesResponseItem{}
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignTemplatesResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignTemplatesResponseItem) ProtoMessage() {}
+
+func (x *ListCampaignTemplatesResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignTemplatesResponseItem.ProtoReflect.Descriptor instead.
+func (*ListCampaignTemplatesResponseItem) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_campaign_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListCampaignTemplatesResponseItem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListCampaignTemplatesResponseItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListCampaignTemplatesResponse struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Base          *common.BaseResponse                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items         []*ListCampaignTemplatesResponseItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignTemplatesResponse) Reset() {
+	*x = ListCampaignTemplatesResponse{}
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignTemplatesResponse) ProtoMessage() {}
+
+func (x *ListCampaignTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListCampaignTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_campaign_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCampaignTemplatesResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListCampaignTemplatesResponse) GetItems() []*ListCampaignTemplatesResponseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateCampaignRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TemplateCode string                 `protobuf:"bytes,2,opt,name=template_code,json=templateCode,proto3" json:"template_code,omitempty"`
+	Subject      string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Title        string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// paragraf dipisah dengan baris kosong
+	Body    string `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	CtaText string `protobuf:"bytes,6,opt,name=cta_text,json=ctaText,proto3" json:"cta_text,omitempty"`
+	CtaUrl  string `protobuf:"bytes,7,opt,name=cta_url,json=ctaUrl,proto3" json:"cta_url,omitempty"`
+	Segment string `protobuf:"bytes,8,opt,name=segment,proto3" json:"segment,omitempty"`
+	// wajib diisi jika segment topic
+	Topic         string `protobuf:"bytes,9,opt,name=topic,proto3" json:"topic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampaignRequest) Reset() {
+	*x = CreateCampaignRequest{}
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignRequest) ProtoMessage() {}
+
+func (x *CreateCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignRequest.ProtoReflect.Descriptor instead.
+func (*CreateCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_campaign_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateCampaignRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetTemplateCode() string {
+	if x != nil {
+		return x.TemplateCode
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetCtaText() string {
+	if x != nil {
+		return x.CtaText
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetCtaUrl() string {
+	if x != nil {
+		return x.CtaUrl
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+type CreateCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampaignResponse) Reset() {
+	*x = CreateCampaignResponse{}
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignResponse) ProtoMessage() {}
+
+func (x *CreateCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignResponse.ProtoReflect.Descriptor instead.
+func (*CreateCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_campaign_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateCampaignResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateCampaignResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListCampaignsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignsRequest) Reset() {
+	*x = ListCampaignsRequest{}
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignsRequest) ProtoMessage() {}
+
+func (x *ListCampaignsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignsRequest.ProtoReflect.Descriptor instead.
+func (*ListCampaignsRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_campaign_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCampaignsRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListCampaignsResponseItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TemplateCode    string                 `protobuf:"bytes,3,opt,name=template_code,json=templateCode,proto3" json:"template_code,omitempty"`
+	Subject         string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Segment         string                 `protobuf:"bytes,5,opt,name=segment,proto3" json:"segment,omitempty"`
+	Topic           string                 `protobuf:"bytes,6,opt,name=topic,proto3" json:"topic,omitempty"`
+	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ScheduledAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	TotalRecipients int64                  `protobuf:"varint,10,opt,name=total_recipients,json=totalRecipients,proto3" json:"total_recipients,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCampaignsResponseItem) Reset() {
+	*x = ListCampaignsResponseItem{}
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignsResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignsResponseItem) ProtoMessage() {}
+
+func (x *ListCampaignsResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignsResponseItem.ProtoReflect.Descriptor instead.
+func (*ListCampaignsResponseItem) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_campaign_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListCampaignsResponseItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListCampaignsResponseItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListCampaignsResponseItem) GetTemplateCode() string {
+	if x != nil {
+		return x.TemplateCode
+	}
+	return ""
+}
+
+func (x *ListCampaignsResponseItem) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ListCampaignsResponseItem) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *ListCampaignsResponseItem) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ListCampaignsResponseItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListCampaignsResponseItem) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *ListCampaignsResponseItem) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *ListCampaignsResponseItem) GetTotalRecipients() int64 {
+	if x != nil {
+		return x.TotalRecipients
+	}
+	return 0
+}
+
+func (x *ListCampaignsResponseItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListCampaignsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Base          *common.BaseResponse         `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Items         []*ListCampaignsResponseItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignsResponse) Reset() {
+	*x = ListCampaignsResponse{}
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignsResponse) ProtoMessage() {}
+
+func (x *ListCampaignsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignsResponse.ProtoReflect.Descriptor instead.
+func (*ListCampaignsResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_campaign_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListCampaignsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListCampaignsResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListCampaignsResponse) GetItems() []*ListCampaignsResponseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DetailCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailCampaignRequest) Reset() {
+	*x = DetailCampaignRequest{}
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailCampaignRequest) ProtoMessage() {}
+
+func (x *DetailCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailCampaignRequest.ProtoReflect.Descriptor instead.
+func (*DetailCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_campaign_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DetailCampaignRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DetailCampaignResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Base            *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id              string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TemplateCode    string                 `protobuf:"bytes,4,opt,name=template_code,json=templateCode,proto3" json:"template_code,omitempty"`
+	Subject         string                 `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	Title           string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Body            string                 `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	CtaText         string                 `protobuf:"bytes,8,opt,name=cta_text,json=ctaText,proto3" json:"cta_text,omitempty"`
+	CtaUrl          string                 `protobuf:"bytes,9,opt,name=cta_url,json=ctaUrl,proto3" json:"cta_url,omitempty"`
+	Segment         string                 `protobuf:"bytes,10,opt,name=segment,proto3" json:"segment,omitempty"`
+	Topic           string                 `protobuf:"bytes,11,opt,name=topic,proto3" json:"topic,omitempty"`
+	Status          string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	ScheduledAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	TotalRecipients int64                  `protobuf:"varint,16,opt,name=total_recipients,json=totalRecipients,proto3" json:"total_recipients,omitempty"`
+	PendingCount    int64                  `protobuf:"varint,17,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
+	SentCount       int64                  `protobuf:"varint,18,opt,name=sent_count,json=sentCount,proto3" json:"sent_count,omitempty"`
+	FailedCount     int64                  `protobuf:"varint,19,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	OpenedCount     int64                  `protobuf:"varint,20,opt,name=opened_count,json=openedCount,proto3" json:"opened_count,omitempty"`
+	ClickedCount    int64                  `protobuf:"varint,21,opt,name=clicked_count,json=clickedCount,proto3" json:"clicked_count,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// penerima yang unsubscribe atau dihapus sebelum email dikirim
+	SkippedCount  int64 `protobuf:"varint,23,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailCampaignResponse) Reset() {
+	*x = DetailCampaignResponse{}
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailCampaignResponse) ProtoMessage() {}
+
+func (x *DetailCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailCampaignResponse.ProtoReflect.Descriptor instead.
+func (*DetailCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_campaign_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DetailCampaignResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DetailCampaignResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DetailCampaignResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DetailCampaignResponse) GetTemplateCode() string {
+	if x != nil {
+		return x.TemplateCode
+	}
+	return ""
+}
+
+func (x *DetailCampaignResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *DetailCampaignResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DetailCampaignResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *DetailCampaignResponse) GetCtaText() string {
+	if x != nil {
+		return x.CtaText
+	}
+	return ""
+}
+
+func (x *DetailCampaignResponse) GetCtaUrl() string {
+	if x != nil {
+		return x.CtaUrl
+	}
+	return ""
+}
+
+func (x *DetailCampaignResponse) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *DetailCampaignResponse) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *DetailCampaignResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DetailCampaignResponse) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *DetailCampaignResponse) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *DetailCampaignResponse) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *DetailCampaignResponse) GetTotalRecipients() int64 {
+	if x != nil {
+		return x.TotalRecipients
+	}
+	return 0
+}
+
+func (x *DetailCampaignResponse) GetPendingCount() int64 {
+	if x != nil {
+		return x.PendingCount
+	}
+	return 0
+}
+
+func (x *DetailCampaignResponse) GetSentCount() int64 {
+	if x != nil {
+		return x.SentCount
+	}
+	return 0
+}
+
+func (x *DetailCampaignResponse) GetFailedCount() int64 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *DetailCampaignResponse) GetOpenedCount() int64 {
+	if x != nil {
+		return x.OpenedCount
+	}
+	return 0
+}
+
+func (x *DetailCampaignResponse) GetClickedCount() int64 {
+	if x != nil {
+		return x.ClickedCount
+	}
+	return 0
+}
+
+func (x *DetailCampaignResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DetailCampaignResponse) GetSkippedCount() int64 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+type ScheduleCampaignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// kosong berarti dikirim sekarang
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleCampaignRequest) Reset() {
+	*x = ScheduleCampaignRequest{}
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleCampaignRequest) ProtoMessage() {}
+
+func (x *ScheduleCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleCampaignRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_campaign_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ScheduleCampaignRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleCampaignRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+type ScheduleCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleCampaignResponse) Reset() {
+	*x = ScheduleCampaignResponse{}
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleCampaignResponse) ProtoMessage() {}
+
+func (x *ScheduleCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleCampaignResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_campaign_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ScheduleCampaignResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type CancelCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCampaignRequest) Reset() {
+	*x = CancelCampaignRequest{}
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCampaignRequest) ProtoMessage() {}
+
+func (x *CancelCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCampaignRequest.ProtoReflect.Descriptor instead.
+func (*CancelCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_campaign_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelCampaignRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelCampaignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCampaignResponse) Reset() {
+	*x = CancelCampaignResponse{}
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCampaignResponse) ProtoMessage() {}
+
+func (x *CancelCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_newsletter_newsletter_campaign_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCampaignResponse.ProtoReflect.Descriptor instead.
+func (*CancelCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_newsletter_newsletter_campaign_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelCampaignResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+var File_newsletter_newsletter_campaign_proto protoreflect.FileDescriptor
+
+const file_newsletter_newsletter_campaign_proto_rawDesc = "" +
+	"\n" +
+	"$newsletter/newsletter_campaign.proto\x12\n" +
+	"newsletter\x1a\x1acommon/base_response.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1e\n" +
+	"\x1cListCampaignTemplatesRequest\"K\n" +
+	"!ListCampaignTemplatesResponseItem\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x8e\x01\n" +
+	"\x1dListCampaignTemplatesResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12C\n" +
+	"\x05items\x18\x02 \x03(\v2-.newsletter.ListCampaignTemplatesResponseItemR\x05items\"\xeb\x02\n" +
+	"\x15CreateCampaignRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12.\n" +
+	"\rtemplate_code\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\ftemplateCode\x12$\n" +
+	"\asubject\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\asubject\x12 \n" +
+	"\x05title\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05title\x12\x1b\n" +
+	"\x04body\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04body\x12#\n" +
+	"\bcta_text\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\actaText\x12!\n" +
+	"\acta_url\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\x06ctaUrl\x126\n" +
+	"\asegment\x18\b \x01(\tB\x1c\xbaH\x19r\x17R\x03allR\tcustomersR\x05topicR\asegment\x12\x1d\n" +
+	"\x05topic\x18\t \x01(\tB\a\xbaH\x04r\x02\x182R\x05topic\"R\n" +
+	"\x16CreateCampaignResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"Y\n" +
+	"\x14ListCampaignsRequest\x12A\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"pagination\"\xaa\x03\n" +
+	"\x19ListCampaignsResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rtemplate_code\x18\x03 \x01(\tR\ftemplateCode\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x18\n" +
+	"\asegment\x18\x05 \x01(\tR\asegment\x12\x14\n" +
+	"\x05topic\x18\x06 \x01(\tR\x05topic\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12=\n" +
+	"\fscheduled_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12=\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12)\n" +
+	"\x10total_recipients\x18\n" +
+	" \x01(\x03R\x0ftotalRecipients\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xba\x01\n" +
+	"\x15ListCampaignsResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\x12;\n" +
+	"\x05items\x18\x03 \x03(\v2%.newsletter.ListCampaignsResponseItemR\x05items\"1\n" +
+	"\x15DetailCampaignRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\xbe\x06\n" +
+	"\x16DetailCampaignResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12#\n" +
+	"\rtemplate_code\x18\x04 \x01(\tR\ftemplateCode\x12\x18\n" +
+	"\asubject\x18\x05 \x01(\tR\asubject\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\a \x01(\tR\x04body\x12\x19\n" +
+	"\bcta_text\x18\b \x01(\tR\actaText\x12\x17\n" +
+	"\acta_url\x18\t \x01(\tR\x06ctaUrl\x12\x18\n" +
+	"\asegment\x18\n" +
+	" \x01(\tR\asegment\x12\x14\n" +
+	"\x05topic\x18\v \x01(\tR\x05topic\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12=\n" +
+	"\fscheduled_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x129\n" +
+	"\n" +
+	"started_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12)\n" +
+	"\x10total_recipients\x18\x10 \x01(\x03R\x0ftotalRecipients\x12#\n" +
+	"\rpending_count\x18\x11 \x01(\x03R\fpendingCount\x12\x1d\n" +
+	"\n" +
+	"sent_count\x18\x12 \x01(\x03R\tsentCount\x12!\n" +
+	"\ffailed_count\x18\x13 \x01(\x03R\vfailedCount\x12!\n" +
+	"\fopened_count\x18\x14 \x01(\x03R\vopenedCount\x12#\n" +
+	"\rclicked_count\x18\x15 \x01(\x03R\fclickedCount\x129\n" +
+	"\n" +
+	"created_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12#\n" +
+	"\rskipped_count\x18\x17 \x01(\x03R\fskippedCount\"r\n" +
+	"\x17ScheduleCampaignRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12=\n" +
+	"\fscheduled_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"D\n" +
+	"\x18ScheduleCampaignResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"1\n" +
+	"\x15CancelCampaignRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"B\n" +
+	"\x16CancelCampaignResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xc9\x04\n" +
+	"\x19NewsletterCampaignService\x12l\n" +
+	"\x15ListCampaignTemplates\x12(.newsletter.ListCampaignTemplatesRequest\x1a).newsletter.ListCampaignTemplatesResponse\x12W\n" +
+	"\x0eCreateCampaign\x12!.newsletter.CreateCampaignRequest\x1a\".newsletter.CreateCampaignResponse\x12T\n" +
+	"\rListCampaigns\x12 .newsletter.ListCampaignsRequest\x1a!.newsletter.ListCampaignsResponse\x12W\n" +
+	"\x0eDetailCampaign\x12!.newsletter.DetailCampaignRequest\x1a\".newsletter.DetailCampaignResponse\x12]\n" +
+	"\x10ScheduleCampaign\x12#.newsletter.ScheduleCampaignRequest\x1a$.newsletter.ScheduleCampaignResponse\x12W\n" +
+	"\x0eCancelCampaign\x12!.newsletter.CancelCampaignRequest\x1a\".newsletter.CancelCampaignResponseB9Z7github.com/luzmareto/go-grpc-ecommerce-be/pb/newsletterb\x06proto3"
+
+var (
+	file_newsletter_newsletter_campaign_proto_rawDescOnce sync.Once
+	file_newsletter_newsletter_campaign_proto_rawDescData []byte
+)
+
+func file_newsletter_newsletter_campaign_proto_rawDescGZIP() []byte {
+	file_newsletter_newsletter_campaign_proto_rawDescOnce.Do(func() {
+		file_newsletter_newsletter_campaign_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_newsletter_newsletter_campaign_proto_rawDesc), len(file_newsletter_newsletter_campaign_proto_rawDesc)))
+	})
+	return file_newsletter_newsletter_campaign_proto_rawDescData
+}
+
+var file_newsletter_newsletter_campaign_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_newsletter_newsletter_campaign_proto_goTypes = []any{
+	(*ListCampaignTemplatesRequest)(nil),      // 0: newsletter.ListCampaignTemplatesRequest
+	(*ListCampaignTemplatesResponseItem)(nil), // 1: newsletter.ListCampaignTemplatesResponseItem
+	(*ListCampaignTemplatesResponse)(nil),     // 2: newsletter.ListCampaignTemplatesResponse
+	(*CreateCampaignRequest)(nil),             // 3: newsletter.CreateCampaignRequest
+	(*CreateCampaignResponse)(nil),            // 4: newsletter.CreateCampaignResponse
+	(*ListCampaignsRequest)(nil),              // 5: newsletter.ListCampaignsRequest
+	(*ListCampaignsResponseItem)(nil),         // 6: newsletter.ListCampaignsResponseItem
+	(*ListCampaignsResponse)(nil),             // 7: newsletter.ListCampaignsResponse
+	(*DetailCampaignRequest)(nil),             // 8: newsletter.DetailCampaignRequest
+	(*DetailCampaignResponse)(nil),            // 9: newsletter.DetailCampaignResponse
+	(*ScheduleCampaignRequest)(nil),           // 10: newsletter.ScheduleCampaignRequest
+	(*ScheduleCampaignResponse)(nil),          // 11: newsletter.ScheduleCampaignResponse
+	(*CancelCampaignRequest)(nil),             // 12: newsletter.CancelCampaignRequest
+	(*CancelCampaignResponse)(nil),            // 13: newsletter.CancelCampaignResponse
+	(*common.BaseResponse)(nil),               // 14: common.BaseResponse
+	(*common.PaginationRequest)(nil),          // 15: common.PaginationRequest
+	(*timestamppb.Timestamp)(nil),             // 16: google.protobuf.Timestamp
+	(*common.PaginationResponse)(nil),         // 17: common.PaginationResponse
+}
+var file_newsletter_newsletter_campaign_proto_depIdxs = []int32{
+	14, // 0: newsletter.ListCampaignTemplatesResponse.base:type_name -> common.BaseResponse
+	1,  // 1: newsletter.ListCampaignTemplatesResponse.items:type_name -> newsletter.ListCampaignTemplatesResponseItem
+	14, // 2: newsletter.CreateCampaignResponse.base:type_name -> common.BaseResponse
+	15, // 3: newsletter.ListCampaignsRequest.pagination:type_name -> common.PaginationRequest
+	16, // 4: newsletter.ListCampaignsResponseItem.scheduled_at:type_name -> google.protobuf.Timestamp
+	16, // 5: newsletter.ListCampaignsResponseItem.completed_at:type_name -> google.protobuf.Timestamp
+	16, // 6: newsletter.ListCampaignsResponseItem.created_at:type_name -> google.protobuf.Timestamp
+	14, // 7: newsletter.ListCampaignsResponse.base:type_name -> common.BaseResponse
+	17, // 8: newsletter.ListCampaignsResponse.pagination:type_name -> common.PaginationResponse
+	6,  // 9: newsletter.ListCampaignsResponse.items:type_name -> newsletter.ListCampaignsResponseItem
+	14, // 10: newsletter.DetailCampaignResponse.base:type_name -> common.BaseResponse
+	16, // 11: newsletter.DetailCampaignResponse.scheduled_at:type_name -> google.protobuf.Timestamp
+	16, // 12: newsletter.DetailCampaignResponse.started_at:type_name -> google.protobuf.Timestamp
+	16, // 13: newsletter.DetailCampaignResponse.completed_at:type_name -> google.protobuf.Timestamp
+	16, // 14: newsletter.DetailCampaignResponse.created_at:type_name -> google.protobuf.Timestamp
+	16, // 15: newsletter.ScheduleCampaignRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	14, // 16: newsletter.ScheduleCampaignResponse.base:type_name -> common.BaseResponse
+	14, // 17: newsletter.CancelCampaignResponse.base:type_name -> common.BaseResponse
+	0,  // 18: newsletter.NewsletterCampaignService.ListCampaignTemplates:input_type -> newsletter.ListCampaignTemplatesRequest
+	3,  // 19: newsletter.NewsletterCampaignService.CreateCampaign:input_type -> newsletter.CreateCampaignRequest
+	5,  // 20: newsletter.NewsletterCampaignService.ListCampaigns:input_type -> newsletter.ListCampaignsRequest
+	8,  // 21: newsletter.NewsletterCampaignService.DetailCampaign:input_type -> newsletter.DetailCampaignRequest
+	10, // 22: newsletter.NewsletterCampaignService.ScheduleCampaign:input_type -> newsletter.ScheduleCampaignRequest
+	12, // 23: newsletter.NewsletterCampaignService.CancelCampaign:input_type -> newsletter.CancelCampaignRequest
+	2,  // 24: newsletter.NewsletterCampaignService.ListCampaignTemplates:output_type -> newsletter.ListCampaignTemplatesResponse
+	4,  // 25: newsletter.NewsletterCampaignService.CreateCampaign:output_type -> newsletter.CreateCampaignResponse
+	7,  // 26: newsletter.NewsletterCampaignService.ListCampaigns:output_type -> newsletter.ListCampaignsResponse
+	9,  // 27: newsletter.NewsletterCampaignService.DetailCampaign:output_type -> newsletter.DetailCampaignResponse
+	11, // 28: newsletter.NewsletterCampaignService.ScheduleCampaign:output_type -> newsletter.ScheduleCampaignResponse
+	13, // 29: newsletter.NewsletterCampaignService.CancelCampaign:output_type -> newsletter.CancelCampaignResponse
+	24, // [24:30] is the sub-list for method output_type
+	18, // [18:24] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_newsletter_newsletter_campaign_proto_init() }
+func file_newsletter_newsletter_campaign_proto_init() {
+	if File_newsletter_newsletter_campaign_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_newsletter_newsletter_campaign_proto_rawDesc), len(file_newsletter_newsletter_campaign_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_newsletter_newsletter_campaign_proto_goTypes,
+		DependencyIndexes: file_newsletter_newsletter_campaign_proto_depIdxs,
+		MessageInfos:      file_newsletter_newsletter_campaign_proto_msgTypes,
+	}.Build()
+	File_newsletter_newsletter_campaign_proto = out.File
+	file_newsletter_newsletter_campaign_proto_goTypes = nil
+	file_newsletter_newsletter_campaign_proto_depIdxs = nil
+}
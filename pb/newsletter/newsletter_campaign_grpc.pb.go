@@ -0,0 +1,316 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: newsletter/newsletter_campaign.proto
+
+package newsletter
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NewsletterCampaignService_ListCampaignTemplates_FullMethodName = "/newsletter.NewsletterCampaignService/ListCampaignTemplates"
+	NewsletterCampaignService_CreateCampaign_FullMethodName        = "/newsletter.NewsletterCampaignService/CreateCampaign"
+	NewsletterCampaignService_ListCampaigns_FullMethodName         = "/newsletter.NewsletterCampaignService/ListCampaigns"
+	NewsletterCampaignService_DetailCampaign_FullMethodName        = "/newsletter.NewsletterCampaignService/DetailCampaign"
+	NewsletterCampaignService_ScheduleCampaign_FullMethodName      = "/newsletter.NewsletterCampaignService/ScheduleCampaign"
+	NewsletterCampaignService_CancelCampaign_FullMethodName        = "/newsletter.NewsletterCampaignService/CancelCampaign"
+)
+
+// NewsletterCampaignServiceClient is the client API for NewsletterCampaignService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// semua rpc hanya untuk admin
+type NewsletterCampaignServiceClient interface {
+	ListCampaignTemplates(ctx context.Context, in *ListCampaignTemplatesRequest, opts ...grpc.CallOption) (*ListCampaignTemplatesResponse, error)
+	CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignResponse, error)
+	ListCampaigns(ctx context.Context, in *ListCampaignsRequest, opts ...grpc.CallOption) (*ListCampaignsResponse, error)
+	DetailCampaign(ctx context.Context, in *DetailCampaignRequest, opts ...grpc.CallOption) (*DetailCampaignResponse, error)
+	ScheduleCampaign(ctx context.Context, in *ScheduleCampaignRequest, opts ...grpc.CallOption) (*ScheduleCampaignResponse, error)
+	CancelCampaign(ctx context.Context, in *CancelCampaignRequest, opts ...grpc.CallOption) (*CancelCampaignResponse, error)
+}
+
+type newsletterCampaignServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNewsletterCampaignServiceClient(cc grpc.ClientConnInterface) NewsletterCampaignServiceClient {
+	return &newsletterCampaignServiceClient{cc}
+}
+
+func (c *newsletterCampaignServiceClient) ListCampaignTemplates(ctx context.Context, in *ListCampaignTemplatesRequest, opts ...grpc.CallOption) (*ListCampaignTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCampaignTemplatesResponse)
+	err := c.cc.Invoke(ctx, NewsletterCampaignService_ListCampaignTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterCampaignServiceClient) CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCampaignResponse)
+	err := c.cc.Invoke(ctx, NewsletterCampaignService_CreateCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterCampaignServiceClient) ListCampaigns(ctx context.Context, in *ListCampaignsRequest, opts ...grpc.CallOption) (*ListCampaignsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCampaignsResponse)
+	err := c.cc.Invoke(ctx, NewsletterCampaignService_ListCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterCampaignServiceClient) DetailCampaign(ctx context.Context, in *DetailCampaignRequest, opts ...grpc.CallOption) (*DetailCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetailCampaignResponse)
+	err := c.cc.Invoke(ctx, NewsletterCampaignService_DetailCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterCampaignServiceClient) ScheduleCampaign(ctx context.Context, in *ScheduleCampaignRequest, opts ...grpc.CallOption) (*ScheduleCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleCampaignResponse)
+	err := c.cc.Invoke(ctx, NewsletterCampaignService_ScheduleCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterCampaignServiceClient) CancelCampaign(ctx context.Context, in *CancelCampaignRequest, opts ...grpc.CallOption) (*CancelCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelCampaignResponse)
+	err := c.cc.Invoke(ctx, NewsletterCampaignService_CancelCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NewsletterCampaignServiceServer is the server API for NewsletterCampaignService service.
+// All implementations must embed UnimplementedNewsletterCampaignServiceServer
+// for forward compatibility.
+//
+// semua rpc hanya untuk admin
+type NewsletterCampaignServiceServer interface {
+	ListCampaignTemplates(context.Context, *ListCampaignTemplatesRequest) (*ListCampaignTemplatesResponse, error)
+	CreateCampaign(context.Context, *CreateCampaignRequest) (*CreateCampaignResponse, error)
+	ListCampaigns(context.Context, *ListCampaignsRequest) (*ListCampaignsResponse, error)
+	DetailCampaign(context.Context, *DetailCampaignRequest) (*DetailCampaignResponse, error)
+	ScheduleCampaign(context.Context, *ScheduleCampaignRequest) (*ScheduleCampaignResponse, error)
+	CancelCampaign(context.Context, *CancelCampaignRequest) (*CancelCampaignResponse, error)
+	mustEmbedUnimplementedNewsletterCampaignServiceServer()
+}
+
+// UnimplementedNewsletterCampaignServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNewsletterCampaignServiceServer struct{}
+
+func (UnimplementedNewsletterCampaignServiceServer) ListCampaignTemplates(context.Context, *ListCampaignTemplatesRequest) (*ListCampaignTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCampaignTemplates not implemented")
+}
+func (UnimplementedNewsletterCampaignServiceServer) CreateCampaign(context.Context, *CreateCampaignRequest) (*CreateCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (UnimplementedNewsletterCampaignServiceServer) ListCampaigns(context.Context, *ListCampaignsRequest) (*ListCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCampaigns not implemented")
+}
+func (UnimplementedNewsletterCampaignServiceServer) DetailCampaign(context.Context, *DetailCampaignRequest) (*DetailCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetailCampaign not implemented")
+}
+func (UnimplementedNewsletterCampaignServiceServer) ScheduleCampaign(context.Context, *ScheduleCampaignRequest) (*ScheduleCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCampaign not implemented")
+}
+func (UnimplementedNewsletterCampaignServiceServer) CancelCampaign(context.Context, *CancelCampaignRequest) (*CancelCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCampaign not implemented")
+}
+func (UnimplementedNewsletterCampaignServiceServer) mustEmbedUnimplementedNewsletterCampaignServiceServer() {
+}
+func (UnimplementedNewsletterCampaignServiceServer) testEmbeddedByValue() {}
+
+// UnsafeNewsletterCampaignServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NewsletterCampaignServiceServer will
+// result in compilation errors.
+type UnsafeNewsletterCampaignServiceServer interface {
+	mustEmbedUnimplementedNewsletterCampaignServiceServer()
+}
+
+func RegisterNewsletterCampaignServiceServer(s grpc.ServiceRegistrar, srv NewsletterCampaignServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNewsletterCampaignServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NewsletterCampaignService_ServiceDesc, srv)
+}
+
+func _NewsletterCampaignService_ListCampaignTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampaignTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterCampaignServiceServer).ListCampaignTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterCampaignService_ListCampaignTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterCampaignServiceServer).ListCampaignTemplates(ctx, req.(*ListCampaignTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterCampaignService_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterCampaignServiceServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterCampaignService_CreateCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterCampaignServiceServer).CreateCampaign(ctx, req.(*CreateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterCampaignService_ListCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterCampaignServiceServer).ListCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterCampaignService_ListCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterCampaignServiceServer).ListCampaigns(ctx, req.(*ListCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterCampaignService_DetailCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetailCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterCampaignServiceServer).DetailCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterCampaignService_DetailCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterCampaignServiceServer).DetailCampaign(ctx, req.(*DetailCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterCampaignService_ScheduleCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterCampaignServiceServer).ScheduleCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterCampaignService_ScheduleCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterCampaignServiceServer).ScheduleCampaign(ctx, req.(*ScheduleCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterCampaignService_CancelCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterCampaignServiceServer).CancelCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterCampaignService_CancelCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterCampaignServiceServer).CancelCampaign(ctx, req.(*CancelCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NewsletterCampaignService_ServiceDesc is the grpc.ServiceDesc for NewsletterCampaignService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NewsletterCampaignService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "newsletter.NewsletterCampaignService",
+	HandlerType: (*NewsletterCampaignServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCampaignTemplates",
+			Handler:    _NewsletterCampaignService_ListCampaignTemplates_Handler,
+		},
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _NewsletterCampaignService_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "ListCampaigns",
+			Handler:    _NewsletterCampaignService_ListCampaigns_Handler,
+		},
+		{
+			MethodName: "DetailCampaign",
+			Handler:    _NewsletterCampaignService_DetailCampaign_Handler,
+		},
+		{
+			MethodName: "ScheduleCampaign",
+			Handler:    _NewsletterCampaignService_ScheduleCampaign_Handler,
+		},
+		{
+			MethodName: "CancelCampaign",
+			Handler:    _NewsletterCampaignService_CancelCampaign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "newsletter/newsletter_campaign.proto",
+}
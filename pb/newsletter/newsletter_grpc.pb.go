@@ -19,7 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NewsletterService_SubcribeNewsletter_FullMethodName = "/newsletter.NewsletterService/SubcribeNewsletter"
+	NewsletterService_SubcribeNewsletter_FullMethodName          = "/newsletter.NewsletterService/SubcribeNewsletter"
+	NewsletterService_ConfirmNewsletter_FullMethodName           = "/newsletter.NewsletterService/ConfirmNewsletter"
+	NewsletterService_GetNewsletterSubscription_FullMethodName   = "/newsletter.NewsletterService/GetNewsletterSubscription"
+	NewsletterService_UpdateNewsletterPreferences_FullMethodName = "/newsletter.NewsletterService/UpdateNewsletterPreferences"
+	NewsletterService_UnsubscribeNewsletter_FullMethodName       = "/newsletter.NewsletterService/UnsubscribeNewsletter"
+	NewsletterService_ListSubscribers_FullMethodName             = "/newsletter.NewsletterService/ListSubscribers"
+	NewsletterService_RemoveSubscriber_FullMethodName            = "/newsletter.NewsletterService/RemoveSubscriber"
 )
 
 // NewsletterServiceClient is the client API for NewsletterService service.
@@ -27,6 +33,13 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type NewsletterServiceClient interface {
 	SubcribeNewsletter(ctx context.Context, in *SubcribeNewsletterRequest, opts ...grpc.CallOption) (*SubcribeNewsletterResponse, error)
+	ConfirmNewsletter(ctx context.Context, in *ConfirmNewsletterRequest, opts ...grpc.CallOption) (*ConfirmNewsletterResponse, error)
+	GetNewsletterSubscription(ctx context.Context, in *GetNewsletterSubscriptionRequest, opts ...grpc.CallOption) (*GetNewsletterSubscriptionResponse, error)
+	UpdateNewsletterPreferences(ctx context.Context, in *UpdateNewsletterPreferencesRequest, opts ...grpc.CallOption) (*UpdateNewsletterPreferencesResponse, error)
+	UnsubscribeNewsletter(ctx context.Context, in *UnsubscribeNewsletterRequest, opts ...grpc.CallOption) (*UnsubscribeNewsletterResponse, error)
+	// admin
+	ListSubscribers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error)
+	RemoveSubscriber(ctx context.Context, in *RemoveSubscriberRequest, opts ...grpc.CallOption) (*RemoveSubscriberResponse, error)
 }
 
 type newsletterServiceClient struct {
@@ -47,11 +60,78 @@ func (c *newsletterServiceClient) SubcribeNewsletter(ctx context.Context, in *Su
 	return out, nil
 }
 
+func (c *newsletterServiceClient) ConfirmNewsletter(ctx context.Context, in *ConfirmNewsletterRequest, opts ...grpc.CallOption) (*ConfirmNewsletterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmNewsletterResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_ConfirmNewsletter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) GetNewsletterSubscription(ctx context.Context, in *GetNewsletterSubscriptionRequest, opts ...grpc.CallOption) (*GetNewsletterSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNewsletterSubscriptionResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_GetNewsletterSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) UpdateNewsletterPreferences(ctx context.Context, in *UpdateNewsletterPreferencesRequest, opts ...grpc.CallOption) (*UpdateNewsletterPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNewsletterPreferencesResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_UpdateNewsletterPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) UnsubscribeNewsletter(ctx context.Context, in *UnsubscribeNewsletterRequest, opts ...grpc.CallOption) (*UnsubscribeNewsletterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeNewsletterResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_UnsubscribeNewsletter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) ListSubscribers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscribersResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_ListSubscribers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsletterServiceClient) RemoveSubscriber(ctx context.Context, in *RemoveSubscriberRequest, opts ...grpc.CallOption) (*RemoveSubscriberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveSubscriberResponse)
+	err := c.cc.Invoke(ctx, NewsletterService_RemoveSubscriber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NewsletterServiceServer is the server API for NewsletterService service.
 // All implementations must embed UnimplementedNewsletterServiceServer
 // for forward compatibility.
 type NewsletterServiceServer interface {
 	SubcribeNewsletter(context.Context, *SubcribeNewsletterRequest) (*SubcribeNewsletterResponse, error)
+	ConfirmNewsletter(context.Context, *ConfirmNewsletterRequest) (*ConfirmNewsletterResponse, error)
+	GetNewsletterSubscription(context.Context, *GetNewsletterSubscriptionRequest) (*GetNewsletterSubscriptionResponse, error)
+	UpdateNewsletterPreferences(context.Context, *UpdateNewsletterPreferencesRequest) (*UpdateNewsletterPreferencesResponse, error)
+	UnsubscribeNewsletter(context.Context, *UnsubscribeNewsletterRequest) (*UnsubscribeNewsletterResponse, error)
+	// admin
+	ListSubscribers(context.Context, *ListSubscribersRequest) (*ListSubscribersResponse, error)
+	RemoveSubscriber(context.Context, *RemoveSubscriberRequest) (*RemoveSubscriberResponse, error)
 	mustEmbedUnimplementedNewsletterServiceServer()
 }
 
@@ -65,6 +145,24 @@ type UnimplementedNewsletterServiceServer struct{}
 func (UnimplementedNewsletterServiceServer) SubcribeNewsletter(context.Context, *SubcribeNewsletterRequest) (*SubcribeNewsletterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubcribeNewsletter not implemented")
 }
+func (UnimplementedNewsletterServiceServer) ConfirmNewsletter(context.Context, *ConfirmNewsletterRequest) (*ConfirmNewsletterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmNewsletter not implemented")
+}
+func (UnimplementedNewsletterServiceServer) GetNewsletterSubscription(context.Context, *GetNewsletterSubscriptionRequest) (*GetNewsletterSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNewsletterSubscription not implemented")
+}
+func (UnimplementedNewsletterServiceServer) UpdateNewsletterPreferences(context.Context, *UpdateNewsletterPreferencesRequest) (*UpdateNewsletterPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNewsletterPreferences not implemented")
+}
+func (UnimplementedNewsletterServiceServer) UnsubscribeNewsletter(context.Context, *UnsubscribeNewsletterRequest) (*UnsubscribeNewsletterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeNewsletter not implemented")
+}
+func (UnimplementedNewsletterServiceServer) ListSubscribers(context.Context, *ListSubscribersRequest) (*ListSubscribersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscribers not implemented")
+}
+func (UnimplementedNewsletterServiceServer) RemoveSubscriber(context.Context, *RemoveSubscriberRequest) (*RemoveSubscriberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSubscriber not implemented")
+}
 func (UnimplementedNewsletterServiceServer) mustEmbedUnimplementedNewsletterServiceServer() {}
 func (UnimplementedNewsletterServiceServer) testEmbeddedByValue()                           {}
 
@@ -104,6 +202,114 @@ func _NewsletterService_SubcribeNewsletter_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NewsletterService_ConfirmNewsletter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmNewsletterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).ConfirmNewsletter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_ConfirmNewsletter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).ConfirmNewsletter(ctx, req.(*ConfirmNewsletterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_GetNewsletterSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNewsletterSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).GetNewsletterSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_GetNewsletterSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).GetNewsletterSubscription(ctx, req.(*GetNewsletterSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_UpdateNewsletterPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNewsletterPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).UpdateNewsletterPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_UpdateNewsletterPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).UpdateNewsletterPreferences(ctx, req.(*UpdateNewsletterPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_UnsubscribeNewsletter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeNewsletterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).UnsubscribeNewsletter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_UnsubscribeNewsletter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).UnsubscribeNewsletter(ctx, req.(*UnsubscribeNewsletterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_ListSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscribersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).ListSubscribers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_ListSubscribers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).ListSubscribers(ctx, req.(*ListSubscribersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsletterService_RemoveSubscriber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSubscriberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsletterServiceServer).RemoveSubscriber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsletterService_RemoveSubscriber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsletterServiceServer).RemoveSubscriber(ctx, req.(*RemoveSubscriberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NewsletterService_ServiceDesc is the grpc.ServiceDesc for NewsletterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +321,30 @@ var NewsletterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubcribeNewsletter",
 			Handler:    _NewsletterService_SubcribeNewsletter_Handler,
 		},
+		{
+			MethodName: "ConfirmNewsletter",
+			Handler:    _NewsletterService_ConfirmNewsletter_Handler,
+		},
+		{
+			MethodName: "GetNewsletterSubscription",
+			Handler:    _NewsletterService_GetNewsletterSubscription_Handler,
+		},
+		{
+			MethodName: "UpdateNewsletterPreferences",
+			Handler:    _NewsletterService_UpdateNewsletterPreferences_Handler,
+		},
+		{
+			MethodName: "UnsubscribeNewsletter",
+			Handler:    _NewsletterService_UnsubscribeNewsletter_Handler,
+		},
+		{
+			MethodName: "ListSubscribers",
+			Handler:    _NewsletterService_ListSubscribers_Handler,
+		},
+		{
+			MethodName: "RemoveSubscriber",
+			Handler:    _NewsletterService_RemoveSubscriber_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "newsletter/newsletter.proto",
@@ -77,12 +77,20 @@ func (x *CreateOrderRequestProductItem) GetQuantity() int64 {
 }
 
 type CreateOrderRequest struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	FullName      string                           `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Address       string                           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	PhoneNumber   string                           `protobuf:"bytes,3,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Notes         string                           `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
-	Products      []*CreateOrderRequestProductItem `protobuf:"bytes,5,rep,name=products,proto3" json:"products,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// full_name, address dan phone_number boleh kosong jika address_id diisi
+	FullName    string                           `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Address     string                           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	PhoneNumber string                           `protobuf:"bytes,3,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Notes       string                           `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	Products    []*CreateOrderRequestProductItem `protobuf:"bytes,5,rep,name=products,proto3" json:"products,omitempty"`
+	AddressId   string                           `protobuf:"bytes,6,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	// kurir dan service dari GetShippingRates, jika kosong dipilih tarif termurah
+	ShippingCourier string `protobuf:"bytes,7,opt,name=shipping_courier,json=shippingCourier,proto3" json:"shipping_courier,omitempty"`
+	ShippingService string `protobuf:"bytes,8,opt,name=shipping_service,json=shippingService,proto3" json:"shipping_service,omitempty"`
+	VoucherCode     string `protobuf:"bytes,9,opt,name=voucher_code,json=voucherCode,proto3" json:"voucher_code,omitempty"`
+	// mata uang order, kosong berarti mata uang dasar toko. kurs dikunci saat order dibuat
+	Currency      string `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -152,12 +160,48 @@ func (x *CreateOrderRequest) GetProducts() []*CreateOrderRequestProductItem {
 	return nil
 }
 
+func (x *CreateOrderRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetShippingCourier() string {
+	if x != nil {
+		return x.ShippingCourier
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetShippingService() string {
+	if x != nil {
+		return x.ShippingService
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type CreateOrderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Base            *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id              string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	OrderStatusCode string                 `protobuf:"bytes,3,opt,name=order_status_code,json=orderStatusCode,proto3" json:"order_status_code,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateOrderResponse) Reset() {
@@ -204,6 +248,13 @@ func (x *CreateOrderResponse) GetId() string {
 	return ""
 }
 
+func (x *CreateOrderResponse) GetOrderStatusCode() string {
+	if x != nil {
+		return x.OrderStatusCode
+	}
+	return ""
+}
+
 type ListOrderAdminRequest struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -249,11 +300,13 @@ func (x *ListOrderAdminRequest) GetPagination() *common.PaginationRequest {
 }
 
 type ListOrderAdminResponseItemProduct struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Price         float64       `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int64         `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PriceMoney    *common.Money `protobuf:"bytes,5,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -302,6 +355,7 @@ func (x *ListOrderAdminResponseItemProduct) GetName() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in order/order.proto.
 func (x *ListOrderAdminResponseItemProduct) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -316,17 +370,39 @@ func (x *ListOrderAdminResponseItemProduct) GetQuantity() int64 {
 	return 0
 }
 
+func (x *ListOrderAdminResponseItemProduct) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
 type ListOrderAdminResponseItem struct {
-	state         protoimpl.MessageState               `protogen:"open.v1"`
-	Id            string                               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Number        string                               `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
-	Customer      string                               `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
-	StatusCode    string                               `protobuf:"bytes,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	Total         float64                              `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
-	CreatedAt     *timestamppb.Timestamp               `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Products      []*ListOrderAdminResponseItemProduct `protobuf:"bytes,7,rep,name=products,proto3" json:"products,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Number     string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Customer   string                 `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
+	StatusCode string                 `protobuf:"bytes,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Total               float64                              `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
+	CreatedAt           *timestamppb.Timestamp               `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Products            []*ListOrderAdminResponseItemProduct `protobuf:"bytes,7,rep,name=products,proto3" json:"products,omitempty"`
+	PaymentReviewReason string                               `protobuf:"bytes,8,opt,name=payment_review_reason,json=paymentReviewReason,proto3" json:"payment_review_reason,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Subtotal float64 `protobuf:"fixed64,9,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Discount float64 `protobuf:"fixed64,10,opt,name=discount,proto3" json:"discount,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Tax float64 `protobuf:"fixed64,11,opt,name=tax,proto3" json:"tax,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	ShippingCost      float64       `protobuf:"fixed64,12,opt,name=shipping_cost,json=shippingCost,proto3" json:"shipping_cost,omitempty"`
+	TotalMoney        *common.Money `protobuf:"bytes,13,opt,name=total_money,json=totalMoney,proto3" json:"total_money,omitempty"`
+	SubtotalMoney     *common.Money `protobuf:"bytes,14,opt,name=subtotal_money,json=subtotalMoney,proto3" json:"subtotal_money,omitempty"`
+	DiscountMoney     *common.Money `protobuf:"bytes,15,opt,name=discount_money,json=discountMoney,proto3" json:"discount_money,omitempty"`
+	TaxMoney          *common.Money `protobuf:"bytes,16,opt,name=tax_money,json=taxMoney,proto3" json:"tax_money,omitempty"`
+	ShippingCostMoney *common.Money `protobuf:"bytes,17,opt,name=shipping_cost_money,json=shippingCostMoney,proto3" json:"shipping_cost_money,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ListOrderAdminResponseItem) Reset() {
@@ -387,6 +463,7 @@ func (x *ListOrderAdminResponseItem) GetStatusCode() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in order/order.proto.
 func (x *ListOrderAdminResponseItem) GetTotal() float64 {
 	if x != nil {
 		return x.Total
@@ -408,6 +485,80 @@ func (x *ListOrderAdminResponseItem) GetProducts() []*ListOrderAdminResponseItem
 	return nil
 }
 
+func (x *ListOrderAdminResponseItem) GetPaymentReviewReason() string {
+	if x != nil {
+		return x.PaymentReviewReason
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *ListOrderAdminResponseItem) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *ListOrderAdminResponseItem) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *ListOrderAdminResponseItem) GetTax() float64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *ListOrderAdminResponseItem) GetShippingCost() float64 {
+	if x != nil {
+		return x.ShippingCost
+	}
+	return 0
+}
+
+func (x *ListOrderAdminResponseItem) GetTotalMoney() *common.Money {
+	if x != nil {
+		return x.TotalMoney
+	}
+	return nil
+}
+
+func (x *ListOrderAdminResponseItem) GetSubtotalMoney() *common.Money {
+	if x != nil {
+		return x.SubtotalMoney
+	}
+	return nil
+}
+
+func (x *ListOrderAdminResponseItem) GetDiscountMoney() *common.Money {
+	if x != nil {
+		return x.DiscountMoney
+	}
+	return nil
+}
+
+func (x *ListOrderAdminResponseItem) GetTaxMoney() *common.Money {
+	if x != nil {
+		return x.TaxMoney
+	}
+	return nil
+}
+
+func (x *ListOrderAdminResponseItem) GetShippingCostMoney() *common.Money {
+	if x != nil {
+		return x.ShippingCostMoney
+	}
+	return nil
+}
+
 type ListOrderAdminResponse struct {
 	state         protoimpl.MessageState        `protogen:"open.v1"`
 	Base          *common.BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -513,11 +664,13 @@ func (x *ListOrderRequest) GetPagination() *common.PaginationRequest {
 }
 
 type ListOrderResponseItemProduct struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Price         float64       `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int64         `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PriceMoney    *common.Money `protobuf:"bytes,5,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -566,6 +719,7 @@ func (x *ListOrderResponseItemProduct) GetName() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in order/order.proto.
 func (x *ListOrderResponseItemProduct) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -580,16 +734,25 @@ func (x *ListOrderResponseItemProduct) GetQuantity() int64 {
 	return 0
 }
 
+func (x *ListOrderResponseItemProduct) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
 type ListOrderResponseItem struct {
-	state           protoimpl.MessageState          `protogen:"open.v1"`
-	Id              string                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Number          string                          `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
-	Customer        string                          `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
-	StatusCode      string                          `protobuf:"bytes,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Number     string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Customer   string                 `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
+	StatusCode string                 `protobuf:"bytes,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
 	Total           float64                         `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
 	CreatedAt       *timestamppb.Timestamp          `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Products        []*ListOrderResponseItemProduct `protobuf:"bytes,7,rep,name=products,proto3" json:"products,omitempty"`
 	XenditNvoiceUrl string                          `protobuf:"bytes,8,opt,name=xendit_nvoice_url,json=xenditNvoiceUrl,proto3" json:"xendit_nvoice_url,omitempty"`
+	TotalMoney      *common.Money                   `protobuf:"bytes,9,opt,name=total_money,json=totalMoney,proto3" json:"total_money,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -652,6 +815,7 @@ func (x *ListOrderResponseItem) GetStatusCode() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in order/order.proto.
 func (x *ListOrderResponseItem) GetTotal() float64 {
 	if x != nil {
 		return x.Total
@@ -680,6 +844,13 @@ func (x *ListOrderResponseItem) GetXenditNvoiceUrl() string {
 	return ""
 }
 
+func (x *ListOrderResponseItem) GetTotalMoney() *common.Money {
+	if x != nil {
+		return x.TotalMoney
+	}
+	return nil
+}
+
 type ListOrderResponse struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -785,11 +956,14 @@ func (x *DetailOrderRequest) GetId() string {
 }
 
 type DetailOrderResponseItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Price         float64       `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int64         `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	OrderItemId   string        `protobuf:"bytes,5,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	PriceMoney    *common.Money `protobuf:"bytes,6,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -838,6 +1012,7 @@ func (x *DetailOrderResponseItem) GetName() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in order/order.proto.
 func (x *DetailOrderResponseItem) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -852,6 +1027,200 @@ func (x *DetailOrderResponseItem) GetQuantity() int64 {
 	return 0
 }
 
+func (x *DetailOrderResponseItem) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *DetailOrderResponseItem) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+type DetailOrderResponseRefundItem struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	OrderItemId string                 `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	ProductId   string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity    int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Amount        float64       `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountMoney   *common.Money `protobuf:"bytes,5,opt,name=amount_money,json=amountMoney,proto3" json:"amount_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailOrderResponseRefundItem) Reset() {
+	*x = DetailOrderResponseRefundItem{}
+	mi := &file_order_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailOrderResponseRefundItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailOrderResponseRefundItem) ProtoMessage() {}
+
+func (x *DetailOrderResponseRefundItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailOrderResponseRefundItem.ProtoReflect.Descriptor instead.
+func (*DetailOrderResponseRefundItem) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DetailOrderResponseRefundItem) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *DetailOrderResponseRefundItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DetailOrderResponseRefundItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *DetailOrderResponseRefundItem) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DetailOrderResponseRefundItem) GetAmountMoney() *common.Money {
+	if x != nil {
+		return x.AmountMoney
+	}
+	return nil
+}
+
+type DetailOrderResponseRefund struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status       string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason       string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RejectReason string                 `protobuf:"bytes,4,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Amount        float64                          `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Items         []*DetailOrderResponseRefundItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt     *timestamppb.Timestamp           `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AmountMoney   *common.Money                    `protobuf:"bytes,8,opt,name=amount_money,json=amountMoney,proto3" json:"amount_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailOrderResponseRefund) Reset() {
+	*x = DetailOrderResponseRefund{}
+	mi := &file_order_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailOrderResponseRefund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailOrderResponseRefund) ProtoMessage() {}
+
+func (x *DetailOrderResponseRefund) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailOrderResponseRefund.ProtoReflect.Descriptor instead.
+func (*DetailOrderResponseRefund) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DetailOrderResponseRefund) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DetailOrderResponseRefund) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DetailOrderResponseRefund) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DetailOrderResponseRefund) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *DetailOrderResponseRefund) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DetailOrderResponseRefund) GetItems() []*DetailOrderResponseRefundItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *DetailOrderResponseRefund) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DetailOrderResponseRefund) GetAmountMoney() *common.Money {
+	if x != nil {
+		return x.AmountMoney
+	}
+	return nil
+}
+
 type DetailOrderResponse struct {
 	state            protoimpl.MessageState     `protogen:"open.v1"`
 	Base             *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -865,15 +1234,48 @@ type DetailOrderResponse struct {
 	CreatedAt        *timestamppb.Timestamp     `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	XenditInvoiceUrl string                     `protobuf:"bytes,10,opt,name=xendit_invoice_url,json=xenditInvoiceUrl,proto3" json:"xendit_invoice_url,omitempty"`
 	Items            []*DetailOrderResponseItem `protobuf:"bytes,11,rep,name=items,proto3" json:"items,omitempty"`
-	Total            float64                    `protobuf:"fixed64,12,opt,name=total,proto3" json:"total,omitempty"`
-	ExpiredAt        *timestamppb.Timestamp     `protobuf:"bytes,13,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Total     float64                `protobuf:"fixed64,12,opt,name=total,proto3" json:"total,omitempty"`
+	ExpiredAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	PaidAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	PaidAmount          float64                      `protobuf:"fixed64,15,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	PaymentReviewReason string                       `protobuf:"bytes,16,opt,name=payment_review_reason,json=paymentReviewReason,proto3" json:"payment_review_reason,omitempty"`
+	Refunds             []*DetailOrderResponseRefund `protobuf:"bytes,17,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	ShippingCourier     string                       `protobuf:"bytes,18,opt,name=shipping_courier,json=shippingCourier,proto3" json:"shipping_courier,omitempty"`
+	ShippingService     string                       `protobuf:"bytes,19,opt,name=shipping_service,json=shippingService,proto3" json:"shipping_service,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	ShippingCost    float64                `protobuf:"fixed64,20,opt,name=shipping_cost,json=shippingCost,proto3" json:"shipping_cost,omitempty"`
+	TrackingCourier string                 `protobuf:"bytes,21,opt,name=tracking_courier,json=trackingCourier,proto3" json:"tracking_courier,omitempty"`
+	TrackingNumber  string                 `protobuf:"bytes,22,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	ShippedAt       *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at,omitempty"`
+	VoucherCode     string                 `protobuf:"bytes,24,opt,name=voucher_code,json=voucherCode,proto3" json:"voucher_code,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Discount float64 `protobuf:"fixed64,25,opt,name=discount,proto3" json:"discount,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Subtotal     float64 `protobuf:"fixed64,26,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	TaxName      string  `protobuf:"bytes,27,opt,name=tax_name,json=taxName,proto3" json:"tax_name,omitempty"`
+	TaxRate      float64 `protobuf:"fixed64,28,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxInclusive bool    `protobuf:"varint,29,opt,name=tax_inclusive,json=taxInclusive,proto3" json:"tax_inclusive,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Tax               float64       `protobuf:"fixed64,30,opt,name=tax,proto3" json:"tax,omitempty"`
+	TotalMoney        *common.Money `protobuf:"bytes,31,opt,name=total_money,json=totalMoney,proto3" json:"total_money,omitempty"`
+	PaidAmountMoney   *common.Money `protobuf:"bytes,32,opt,name=paid_amount_money,json=paidAmountMoney,proto3" json:"paid_amount_money,omitempty"`
+	ShippingCostMoney *common.Money `protobuf:"bytes,33,opt,name=shipping_cost_money,json=shippingCostMoney,proto3" json:"shipping_cost_money,omitempty"`
+	DiscountMoney     *common.Money `protobuf:"bytes,34,opt,name=discount_money,json=discountMoney,proto3" json:"discount_money,omitempty"`
+	SubtotalMoney     *common.Money `protobuf:"bytes,35,opt,name=subtotal_money,json=subtotalMoney,proto3" json:"subtotal_money,omitempty"`
+	TaxMoney          *common.Money `protobuf:"bytes,36,opt,name=tax_money,json=taxMoney,proto3" json:"tax_money,omitempty"`
+	Currency          string        `protobuf:"bytes,37,opt,name=currency,proto3" json:"currency,omitempty"`
+	// nilai 1 satuan base_currency dalam currency order saat order dibuat
+	ExchangeRate  float64 `protobuf:"fixed64,38,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	BaseCurrency  string  `protobuf:"bytes,39,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DetailOrderResponse) Reset() {
 	*x = DetailOrderResponse{}
-	mi := &file_order_order_proto_msgTypes[13]
+	mi := &file_order_order_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -885,7 +1287,7 @@ func (x *DetailOrderResponse) String() string {
 func (*DetailOrderResponse) ProtoMessage() {}
 
 func (x *DetailOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[13]
+	mi := &file_order_order_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,7 +1300,7 @@ func (x *DetailOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetailOrderResponse.ProtoReflect.Descriptor instead.
 func (*DetailOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{13}
+	return file_order_order_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DetailOrderResponse) GetBase() *common.BaseResponse {
@@ -978,6 +1380,7 @@ func (x *DetailOrderResponse) GetItems() []*DetailOrderResponseItem {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in order/order.proto.
 func (x *DetailOrderResponse) GetTotal() float64 {
 	if x != nil {
 		return x.Total
@@ -992,29 +1395,676 @@ func (x *DetailOrderResponse) GetExpiredAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type UpdateOrderStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+func (x *DetailOrderResponse) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *DetailOrderResponse) GetPaidAmount() float64 {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return 0
+}
+
+func (x *DetailOrderResponse) GetPaymentReviewReason() string {
+	if x != nil {
+		return x.PaymentReviewReason
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetRefunds() []*DetailOrderResponseRefund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetShippingCourier() string {
+	if x != nil {
+		return x.ShippingCourier
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetShippingService() string {
+	if x != nil {
+		return x.ShippingService
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *DetailOrderResponse) GetShippingCost() float64 {
+	if x != nil {
+		return x.ShippingCost
+	}
+	return 0
+}
+
+func (x *DetailOrderResponse) GetTrackingCourier() string {
+	if x != nil {
+		return x.TrackingCourier
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetShippedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShippedAt
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *DetailOrderResponse) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *DetailOrderResponse) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *DetailOrderResponse) GetTaxName() string {
+	if x != nil {
+		return x.TaxName
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetTaxRate() float64 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *DetailOrderResponse) GetTaxInclusive() bool {
+	if x != nil {
+		return x.TaxInclusive
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *DetailOrderResponse) GetTax() float64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+func (x *DetailOrderResponse) GetTotalMoney() *common.Money {
+	if x != nil {
+		return x.TotalMoney
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetPaidAmountMoney() *common.Money {
+	if x != nil {
+		return x.PaidAmountMoney
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetShippingCostMoney() *common.Money {
+	if x != nil {
+		return x.ShippingCostMoney
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetDiscountMoney() *common.Money {
+	if x != nil {
+		return x.DiscountMoney
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetSubtotalMoney() *common.Money {
+	if x != nil {
+		return x.SubtotalMoney
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetTaxMoney() *common.Money {
+	if x != nil {
+		return x.TaxMoney
+	}
+	return nil
+}
+
+func (x *DetailOrderResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *DetailOrderResponse) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+type UpdateOrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	NewStatusCode string                 `protobuf:"bytes,2,opt,name=new_status_code,json=newStatusCode,proto3" json:"new_status_code,omitempty"`
+	// wajib diisi saat status diubah menjadi shipped
+	TrackingCourier string `protobuf:"bytes,3,opt,name=tracking_courier,json=trackingCourier,proto3" json:"tracking_courier,omitempty"`
+	TrackingNumber  string `protobuf:"bytes,4,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusRequest) Reset() {
+	*x = UpdateOrderStatusRequest{}
+	mi := &file_order_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusRequest) ProtoMessage() {}
+
+func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateOrderStatusRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetNewStatusCode() string {
+	if x != nil {
+		return x.NewStatusCode
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetTrackingCourier() string {
+	if x != nil {
+		return x.TrackingCourier
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+type UpdateOrderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusResponse) Reset() {
+	*x = UpdateOrderStatusResponse{}
+	mi := &file_order_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusResponse) ProtoMessage() {}
+
+func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateOrderStatusResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// payment link dibuat secara async oleh dispatcher, client melakukan polling ke rpc ini
+type GetOrderPaymentLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderPaymentLinkRequest) Reset() {
+	*x = GetOrderPaymentLinkRequest{}
+	mi := &file_order_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderPaymentLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderPaymentLinkRequest) ProtoMessage() {}
+
+func (x *GetOrderPaymentLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderPaymentLinkRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderPaymentLinkRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetOrderPaymentLinkRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetOrderPaymentLinkResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Base             *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	OrderId          string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderStatusCode  string                 `protobuf:"bytes,3,opt,name=order_status_code,json=orderStatusCode,proto3" json:"order_status_code,omitempty"`
+	XenditInvoiceUrl string                 `protobuf:"bytes,4,opt,name=xendit_invoice_url,json=xenditInvoiceUrl,proto3" json:"xendit_invoice_url,omitempty"`
+	IsReady          bool                   `protobuf:"varint,5,opt,name=is_ready,json=isReady,proto3" json:"is_ready,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetOrderPaymentLinkResponse) Reset() {
+	*x = GetOrderPaymentLinkResponse{}
+	mi := &file_order_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderPaymentLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderPaymentLinkResponse) ProtoMessage() {}
+
+func (x *GetOrderPaymentLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderPaymentLinkResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderPaymentLinkResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetOrderPaymentLinkResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetOrderPaymentLinkResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetOrderPaymentLinkResponse) GetOrderStatusCode() string {
+	if x != nil {
+		return x.OrderStatusCode
+	}
+	return ""
+}
+
+func (x *GetOrderPaymentLinkResponse) GetXenditInvoiceUrl() string {
+	if x != nil {
+		return x.XenditInvoiceUrl
+	}
+	return ""
+}
+
+func (x *GetOrderPaymentLinkResponse) GetIsReady() bool {
+	if x != nil {
+		return x.IsReady
+	}
+	return false
+}
+
+type RequestReturnRequestItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItemId   string                 `protobuf:"bytes,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReturnRequestItem) Reset() {
+	*x = RequestReturnRequestItem{}
+	mi := &file_order_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReturnRequestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnRequestItem) ProtoMessage() {}
+
+func (x *RequestReturnRequestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnRequestItem.ProtoReflect.Descriptor instead.
+func (*RequestReturnRequestItem) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RequestReturnRequestItem) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *RequestReturnRequestItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RequestReturnRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	OrderId       string                      `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason        string                      `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Items         []*RequestReturnRequestItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReturnRequest) Reset() {
+	*x = RequestReturnRequest{}
+	mi := &file_order_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnRequest) ProtoMessage() {}
+
+func (x *RequestReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnRequest.ProtoReflect.Descriptor instead.
+func (*RequestReturnRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RequestReturnRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RequestReturnRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RequestReturnRequest) GetItems() []*RequestReturnRequestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RequestReturnResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Base     *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RefundId string                 `protobuf:"bytes,2,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Amount        float64       `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountMoney   *common.Money `protobuf:"bytes,4,opt,name=amount_money,json=amountMoney,proto3" json:"amount_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReturnResponse) Reset() {
+	*x = RequestReturnResponse{}
+	mi := &file_order_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnResponse) ProtoMessage() {}
+
+func (x *RequestReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnResponse.ProtoReflect.Descriptor instead.
+func (*RequestReturnResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RequestReturnResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RequestReturnResponse) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *RequestReturnResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RequestReturnResponse) GetAmountMoney() *common.Money {
+	if x != nil {
+		return x.AmountMoney
+	}
+	return nil
+}
+
+type ApproveRefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefundId      string                 `protobuf:"bytes,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRefundRequest) Reset() {
+	*x = ApproveRefundRequest{}
+	mi := &file_order_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRefundRequest) ProtoMessage() {}
+
+func (x *ApproveRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRefundRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRefundRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ApproveRefundRequest) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+type ApproveRefundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RefundStatus  string                 `protobuf:"bytes,2,opt,name=refund_status,json=refundStatus,proto3" json:"refund_status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusRequest) Reset() {
-	*x = UpdateOrderStatusRequest{}
-	mi := &file_order_order_proto_msgTypes[14]
+func (x *ApproveRefundResponse) Reset() {
+	*x = ApproveRefundResponse{}
+	mi := &file_order_order_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusRequest) String() string {
+func (x *ApproveRefundResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusRequest) ProtoMessage() {}
+func (*ApproveRefundResponse) ProtoMessage() {}
 
-func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[14]
+func (x *ApproveRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1025,47 +2075,99 @@ func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ApproveRefundResponse.ProtoReflect.Descriptor instead.
+func (*ApproveRefundResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UpdateOrderStatusRequest) GetOrderId() string {
+func (x *ApproveRefundResponse) GetBase() *common.BaseResponse {
 	if x != nil {
-		return x.OrderId
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ApproveRefundResponse) GetRefundStatus() string {
+	if x != nil {
+		return x.RefundStatus
 	}
 	return ""
 }
 
-func (x *UpdateOrderStatusRequest) GetNewStatusCode() string {
+type RejectRefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefundId      string                 `protobuf:"bytes,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRefundRequest) Reset() {
+	*x = RejectRefundRequest{}
+	mi := &file_order_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRefundRequest) ProtoMessage() {}
+
+func (x *RejectRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[25]
 	if x != nil {
-		return x.NewStatusCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRefundRequest.ProtoReflect.Descriptor instead.
+func (*RejectRefundRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RejectRefundRequest) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
 	}
 	return ""
 }
 
-type UpdateOrderStatusResponse struct {
+func (x *RejectRefundRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RejectRefundResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusResponse) Reset() {
-	*x = UpdateOrderStatusResponse{}
-	mi := &file_order_order_proto_msgTypes[15]
+func (x *RejectRefundResponse) Reset() {
+	*x = RejectRefundResponse{}
+	mi := &file_order_order_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusResponse) String() string {
+func (x *RejectRefundResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusResponse) ProtoMessage() {}
+func (*RejectRefundResponse) ProtoMessage() {}
 
-func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[15]
+func (x *RejectRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,57 +2178,339 @@ func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RejectRefundResponse.ProtoReflect.Descriptor instead.
+func (*RejectRefundResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdateOrderStatusResponse) GetBase() *common.BaseResponse {
+func (x *RejectRefundResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// preview diskon voucher sebelum checkout, input sama dengan CreateOrderRequest
+type ApplyVoucherRequest struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	VoucherCode     string                           `protobuf:"bytes,1,opt,name=voucher_code,json=voucherCode,proto3" json:"voucher_code,omitempty"`
+	Products        []*CreateOrderRequestProductItem `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	AddressId       string                           `protobuf:"bytes,3,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	ShippingCourier string                           `protobuf:"bytes,4,opt,name=shipping_courier,json=shippingCourier,proto3" json:"shipping_courier,omitempty"`
+	ShippingService string                           `protobuf:"bytes,5,opt,name=shipping_service,json=shippingService,proto3" json:"shipping_service,omitempty"`
+	Currency        string                           `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApplyVoucherRequest) Reset() {
+	*x = ApplyVoucherRequest{}
+	mi := &file_order_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyVoucherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyVoucherRequest) ProtoMessage() {}
+
+func (x *ApplyVoucherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyVoucherRequest.ProtoReflect.Descriptor instead.
+func (*ApplyVoucherRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ApplyVoucherRequest) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+func (x *ApplyVoucherRequest) GetProducts() []*CreateOrderRequestProductItem {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ApplyVoucherRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+func (x *ApplyVoucherRequest) GetShippingCourier() string {
+	if x != nil {
+		return x.ShippingCourier
+	}
+	return ""
+}
+
+func (x *ApplyVoucherRequest) GetShippingService() string {
+	if x != nil {
+		return x.ShippingService
+	}
+	return ""
+}
+
+func (x *ApplyVoucherRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ApplyVoucherResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Base        *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	VoucherCode string                 `protobuf:"bytes,2,opt,name=voucher_code,json=voucherCode,proto3" json:"voucher_code,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Subtotal float64 `protobuf:"fixed64,3,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	ShippingCost float64 `protobuf:"fixed64,4,opt,name=shipping_cost,json=shippingCost,proto3" json:"shipping_cost,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	ProductDiscount float64 `protobuf:"fixed64,5,opt,name=product_discount,json=productDiscount,proto3" json:"product_discount,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	ShippingDiscount float64 `protobuf:"fixed64,6,opt,name=shipping_discount,json=shippingDiscount,proto3" json:"shipping_discount,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Discount float64 `protobuf:"fixed64,7,opt,name=discount,proto3" json:"discount,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Total float64 `protobuf:"fixed64,8,opt,name=total,proto3" json:"total,omitempty"`
+	// Deprecated: Marked as deprecated in order/order.proto.
+	Tax                   float64       `protobuf:"fixed64,9,opt,name=tax,proto3" json:"tax,omitempty"`
+	SubtotalMoney         *common.Money `protobuf:"bytes,10,opt,name=subtotal_money,json=subtotalMoney,proto3" json:"subtotal_money,omitempty"`
+	ShippingCostMoney     *common.Money `protobuf:"bytes,11,opt,name=shipping_cost_money,json=shippingCostMoney,proto3" json:"shipping_cost_money,omitempty"`
+	ProductDiscountMoney  *common.Money `protobuf:"bytes,12,opt,name=product_discount_money,json=productDiscountMoney,proto3" json:"product_discount_money,omitempty"`
+	ShippingDiscountMoney *common.Money `protobuf:"bytes,13,opt,name=shipping_discount_money,json=shippingDiscountMoney,proto3" json:"shipping_discount_money,omitempty"`
+	DiscountMoney         *common.Money `protobuf:"bytes,14,opt,name=discount_money,json=discountMoney,proto3" json:"discount_money,omitempty"`
+	TotalMoney            *common.Money `protobuf:"bytes,15,opt,name=total_money,json=totalMoney,proto3" json:"total_money,omitempty"`
+	TaxMoney              *common.Money `protobuf:"bytes,16,opt,name=tax_money,json=taxMoney,proto3" json:"tax_money,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ApplyVoucherResponse) Reset() {
+	*x = ApplyVoucherResponse{}
+	mi := &file_order_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyVoucherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyVoucherResponse) ProtoMessage() {}
+
+func (x *ApplyVoucherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyVoucherResponse.ProtoReflect.Descriptor instead.
+func (*ApplyVoucherResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ApplyVoucherResponse) GetBase() *common.BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
+func (x *ApplyVoucherResponse) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *ApplyVoucherResponse) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *ApplyVoucherResponse) GetShippingCost() float64 {
+	if x != nil {
+		return x.ShippingCost
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *ApplyVoucherResponse) GetProductDiscount() float64 {
+	if x != nil {
+		return x.ProductDiscount
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *ApplyVoucherResponse) GetShippingDiscount() float64 {
+	if x != nil {
+		return x.ShippingDiscount
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *ApplyVoucherResponse) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *ApplyVoucherResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in order/order.proto.
+func (x *ApplyVoucherResponse) GetTax() float64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+func (x *ApplyVoucherResponse) GetSubtotalMoney() *common.Money {
+	if x != nil {
+		return x.SubtotalMoney
+	}
+	return nil
+}
+
+func (x *ApplyVoucherResponse) GetShippingCostMoney() *common.Money {
+	if x != nil {
+		return x.ShippingCostMoney
+	}
+	return nil
+}
+
+func (x *ApplyVoucherResponse) GetProductDiscountMoney() *common.Money {
+	if x != nil {
+		return x.ProductDiscountMoney
+	}
+	return nil
+}
+
+func (x *ApplyVoucherResponse) GetShippingDiscountMoney() *common.Money {
+	if x != nil {
+		return x.ShippingDiscountMoney
+	}
+	return nil
+}
+
+func (x *ApplyVoucherResponse) GetDiscountMoney() *common.Money {
+	if x != nil {
+		return x.DiscountMoney
+	}
+	return nil
+}
+
+func (x *ApplyVoucherResponse) GetTotalMoney() *common.Money {
+	if x != nil {
+		return x.TotalMoney
+	}
+	return nil
+}
+
+func (x *ApplyVoucherResponse) GetTaxMoney() *common.Money {
+	if x != nil {
+		return x.TaxMoney
+	}
+	return nil
+}
+
 var File_order_order_proto protoreflect.FileDescriptor
 
 const file_order_order_proto_rawDesc = "" +
 	"\n" +
-	"\x11order/order.proto\x12\x05order\x1a\x1bbuf/validate/validate.proto\x1a\x1acommon/base_response.proto\x1a\x17common/pagination.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"K\n" +
+	"\x11order/order.proto\x12\x05order\x1a\x1bbuf/validate/validate.proto\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x17common/pagination.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"K\n" +
 	"\x1dCreateOrderRequestProductItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\xf4\x01\n" +
-	"\x12CreateOrderRequest\x12'\n" +
-	"\tfull_name\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\bfullName\x12$\n" +
-	"\aaddress\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aaddress\x12-\n" +
-	"\fphone_number\x18\x03 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vphoneNumber\x12\x1e\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\xd0\x03\n" +
+	"\x12CreateOrderRequest\x12%\n" +
+	"\tfull_name\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\bfullName\x12\"\n" +
+	"\aaddress\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\aaddress\x12+\n" +
+	"\fphone_number\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\vphoneNumber\x12\x1e\n" +
 	"\x05notes\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x05notes\x12@\n" +
-	"\bproducts\x18\x05 \x03(\v2$.order.CreateOrderRequestProductItemR\bproducts\"O\n" +
+	"\bproducts\x18\x05 \x03(\v2$.order.CreateOrderRequestProductItemR\bproducts\x12'\n" +
+	"\n" +
+	"address_id\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\taddressId\x122\n" +
+	"\x10shipping_courier\x18\a \x01(\tB\a\xbaH\x04r\x02\x182R\x0fshippingCourier\x122\n" +
+	"\x10shipping_service\x18\b \x01(\tB\a\xbaH\x04r\x02\x182R\x0fshippingService\x12*\n" +
+	"\fvoucher_code\x18\t \x01(\tB\a\xbaH\x04r\x02\x182R\vvoucherCode\x12#\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tB\a\xbaH\x04r\x02\x18\x03R\bcurrency\"{\n" +
 	"\x13CreateOrderResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"R\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12*\n" +
+	"\x11order_status_code\x18\x03 \x01(\tR\x0forderStatusCode\"R\n" +
 	"\x15ListOrderAdminRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"y\n" +
+	"pagination\"\xad\x01\n" +
 	"!ListOrderAdminResponseItemProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x03R\bquantity\"\x98\x02\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\x05price\x18\x03 \x01(\x01B\x02\x18\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12.\n" +
+	"\vprice_money\x18\x05 \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\"\xd6\x05\n" +
 	"\x1aListOrderAdminResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06number\x18\x02 \x01(\tR\x06number\x12\x1a\n" +
 	"\bcustomer\x18\x03 \x01(\tR\bcustomer\x12\x1f\n" +
 	"\vstatus_code\x18\x04 \x01(\tR\n" +
-	"statusCode\x12\x14\n" +
-	"\x05total\x18\x05 \x01(\x01R\x05total\x129\n" +
+	"statusCode\x12\x18\n" +
+	"\x05total\x18\x05 \x01(\x01B\x02\x18\x01R\x05total\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12D\n" +
-	"\bproducts\x18\a \x03(\v2(.order.ListOrderAdminResponseItemProductR\bproducts\"\xb7\x01\n" +
+	"\bproducts\x18\a \x03(\v2(.order.ListOrderAdminResponseItemProductR\bproducts\x122\n" +
+	"\x15payment_review_reason\x18\b \x01(\tR\x13paymentReviewReason\x12\x1e\n" +
+	"\bsubtotal\x18\t \x01(\x01B\x02\x18\x01R\bsubtotal\x12\x1e\n" +
+	"\bdiscount\x18\n" +
+	" \x01(\x01B\x02\x18\x01R\bdiscount\x12\x14\n" +
+	"\x03tax\x18\v \x01(\x01B\x02\x18\x01R\x03tax\x12'\n" +
+	"\rshipping_cost\x18\f \x01(\x01B\x02\x18\x01R\fshippingCost\x12.\n" +
+	"\vtotal_money\x18\r \x01(\v2\r.common.MoneyR\n" +
+	"totalMoney\x124\n" +
+	"\x0esubtotal_money\x18\x0e \x01(\v2\r.common.MoneyR\rsubtotalMoney\x124\n" +
+	"\x0ediscount_money\x18\x0f \x01(\v2\r.common.MoneyR\rdiscountMoney\x12*\n" +
+	"\ttax_money\x18\x10 \x01(\v2\r.common.MoneyR\btaxMoney\x12=\n" +
+	"\x13shipping_cost_money\x18\x11 \x01(\v2\r.common.MoneyR\x11shippingCostMoney\"\xb7\x01\n" +
 	"\x16ListOrderAdminResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
 	"\n" +
@@ -1136,23 +2520,27 @@ const file_order_order_proto_rawDesc = "" +
 	"\x10ListOrderRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"t\n" +
+	"pagination\"\xa8\x01\n" +
 	"\x1cListOrderResponseItemProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x03R\bquantity\"\xba\x02\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\x05price\x18\x03 \x01(\x01B\x02\x18\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12.\n" +
+	"\vprice_money\x18\x05 \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\"\xee\x02\n" +
 	"\x15ListOrderResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06number\x18\x02 \x01(\tR\x06number\x12\x1a\n" +
 	"\bcustomer\x18\x03 \x01(\tR\bcustomer\x12\x1f\n" +
 	"\vstatus_code\x18\x04 \x01(\tR\n" +
-	"statusCode\x12\x14\n" +
-	"\x05total\x18\x05 \x01(\x01R\x05total\x129\n" +
+	"statusCode\x12\x18\n" +
+	"\x05total\x18\x05 \x01(\x01B\x02\x18\x01R\x05total\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12?\n" +
 	"\bproducts\x18\a \x03(\v2#.order.ListOrderResponseItemProductR\bproducts\x12*\n" +
-	"\x11xendit_nvoice_url\x18\b \x01(\tR\x0fxenditNvoiceUrl\"\xad\x01\n" +
+	"\x11xendit_nvoice_url\x18\b \x01(\tR\x0fxenditNvoiceUrl\x12.\n" +
+	"\vtotal_money\x18\t \x01(\v2\r.common.MoneyR\n" +
+	"totalMoney\"\xad\x01\n" +
 	"\x11ListOrderResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
 	"\n" +
@@ -1161,12 +2549,32 @@ const file_order_order_proto_rawDesc = "" +
 	"\x05items\x18\x03 \x03(\v2\x1c.order.ListOrderResponseItemR\x05items\"0\n" +
 	"\x12DetailOrderRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"o\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"\xc7\x01\n" +
 	"\x17DetailOrderResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x03R\bquantity\"\xfc\x03\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\x05price\x18\x03 \x01(\x01B\x02\x18\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\"\n" +
+	"\rorder_item_id\x18\x05 \x01(\tR\vorderItemId\x12.\n" +
+	"\vprice_money\x18\x06 \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\"\xcc\x01\n" +
+	"\x1dDetailOrderResponseRefundItem\x12\"\n" +
+	"\rorder_item_id\x18\x01 \x01(\tR\vorderItemId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12\x1a\n" +
+	"\x06amount\x18\x04 \x01(\x01B\x02\x18\x01R\x06amount\x120\n" +
+	"\famount_money\x18\x05 \x01(\v2\r.common.MoneyR\vamountMoney\"\xc5\x02\n" +
+	"\x19DetailOrderResponseRefund\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12#\n" +
+	"\rreject_reason\x18\x04 \x01(\tR\frejectReason\x12\x1a\n" +
+	"\x06amount\x18\x05 \x01(\x01B\x02\x18\x01R\x06amount\x12:\n" +
+	"\x05items\x18\x06 \x03(\v2$.order.DetailOrderResponseRefundItemR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x120\n" +
+	"\famount_money\x18\b \x01(\v2\r.common.MoneyR\vamountMoney\"\xd4\f\n" +
 	"\x13DetailOrderResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x16\n" +
@@ -1180,23 +2588,123 @@ const file_order_order_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12,\n" +
 	"\x12xendit_invoice_url\x18\n" +
 	" \x01(\tR\x10xenditInvoiceUrl\x124\n" +
-	"\x05items\x18\v \x03(\v2\x1e.order.DetailOrderResponseItemR\x05items\x12\x14\n" +
-	"\x05total\x18\f \x01(\x01R\x05total\x129\n" +
+	"\x05items\x18\v \x03(\v2\x1e.order.DetailOrderResponseItemR\x05items\x12\x18\n" +
+	"\x05total\x18\f \x01(\x01B\x02\x18\x01R\x05total\x129\n" +
 	"\n" +
-	"expired_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\texpiredAt\"u\n" +
+	"expired_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\texpiredAt\x123\n" +
+	"\apaid_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\x12#\n" +
+	"\vpaid_amount\x18\x0f \x01(\x01B\x02\x18\x01R\n" +
+	"paidAmount\x122\n" +
+	"\x15payment_review_reason\x18\x10 \x01(\tR\x13paymentReviewReason\x12:\n" +
+	"\arefunds\x18\x11 \x03(\v2 .order.DetailOrderResponseRefundR\arefunds\x12)\n" +
+	"\x10shipping_courier\x18\x12 \x01(\tR\x0fshippingCourier\x12)\n" +
+	"\x10shipping_service\x18\x13 \x01(\tR\x0fshippingService\x12'\n" +
+	"\rshipping_cost\x18\x14 \x01(\x01B\x02\x18\x01R\fshippingCost\x12)\n" +
+	"\x10tracking_courier\x18\x15 \x01(\tR\x0ftrackingCourier\x12'\n" +
+	"\x0ftracking_number\x18\x16 \x01(\tR\x0etrackingNumber\x129\n" +
+	"\n" +
+	"shipped_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\tshippedAt\x12!\n" +
+	"\fvoucher_code\x18\x18 \x01(\tR\vvoucherCode\x12\x1e\n" +
+	"\bdiscount\x18\x19 \x01(\x01B\x02\x18\x01R\bdiscount\x12\x1e\n" +
+	"\bsubtotal\x18\x1a \x01(\x01B\x02\x18\x01R\bsubtotal\x12\x19\n" +
+	"\btax_name\x18\x1b \x01(\tR\ataxName\x12\x19\n" +
+	"\btax_rate\x18\x1c \x01(\x01R\ataxRate\x12#\n" +
+	"\rtax_inclusive\x18\x1d \x01(\bR\ftaxInclusive\x12\x14\n" +
+	"\x03tax\x18\x1e \x01(\x01B\x02\x18\x01R\x03tax\x12.\n" +
+	"\vtotal_money\x18\x1f \x01(\v2\r.common.MoneyR\n" +
+	"totalMoney\x129\n" +
+	"\x11paid_amount_money\x18  \x01(\v2\r.common.MoneyR\x0fpaidAmountMoney\x12=\n" +
+	"\x13shipping_cost_money\x18! \x01(\v2\r.common.MoneyR\x11shippingCostMoney\x124\n" +
+	"\x0ediscount_money\x18\" \x01(\v2\r.common.MoneyR\rdiscountMoney\x124\n" +
+	"\x0esubtotal_money\x18# \x01(\v2\r.common.MoneyR\rsubtotalMoney\x12*\n" +
+	"\ttax_money\x18$ \x01(\v2\r.common.MoneyR\btaxMoney\x12\x1a\n" +
+	"\bcurrency\x18% \x01(\tR\bcurrency\x12#\n" +
+	"\rexchange_rate\x18& \x01(\x01R\fexchangeRate\x12#\n" +
+	"\rbase_currency\x18' \x01(\tR\fbaseCurrency\"\xdc\x01\n" +
 	"\x18UpdateOrderStatusRequest\x12%\n" +
 	"\border_id\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aorderId\x122\n" +
 	"\x0fnew_status_code\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\rnewStatusCode\"E\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\rnewStatusCode\x122\n" +
+	"\x10tracking_courier\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x182R\x0ftrackingCourier\x121\n" +
+	"\x0ftracking_number\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x0etrackingNumber\"E\n" +
 	"\x19UpdateOrderStatusResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\x81\x03\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"C\n" +
+	"\x1aGetOrderPaymentLinkRequest\x12%\n" +
+	"\border_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aorderId\"\xd7\x01\n" +
+	"\x1bGetOrderPaymentLinkResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12*\n" +
+	"\x11order_status_code\x18\x03 \x01(\tR\x0forderStatusCode\x12,\n" +
+	"\x12xendit_invoice_url\x18\x04 \x01(\tR\x10xenditInvoiceUrl\x12\x19\n" +
+	"\bis_ready\x18\x05 \x01(\bR\aisReady\"o\n" +
+	"\x18RequestReturnRequestItem\x12.\n" +
+	"\rorder_item_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vorderItemId\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bquantity\"\xa2\x01\n" +
+	"\x14RequestReturnRequest\x12%\n" +
+	"\border_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aorderId\x12\"\n" +
+	"\x06reason\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06reason\x12?\n" +
+	"\x05items\x18\x03 \x03(\v2\x1f.order.RequestReturnRequestItemB\b\xbaH\x05\x92\x01\x02\b\x01R\x05items\"\xac\x01\n" +
+	"\x15RequestReturnResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x1b\n" +
+	"\trefund_id\x18\x02 \x01(\tR\brefundId\x12\x1a\n" +
+	"\x06amount\x18\x03 \x01(\x01B\x02\x18\x01R\x06amount\x120\n" +
+	"\famount_money\x18\x04 \x01(\v2\r.common.MoneyR\vamountMoney\"?\n" +
+	"\x14ApproveRefundRequest\x12'\n" +
+	"\trefund_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\brefundId\"f\n" +
+	"\x15ApproveRefundResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12#\n" +
+	"\rrefund_status\x18\x02 \x01(\tR\frefundStatus\"b\n" +
+	"\x13RejectRefundRequest\x12'\n" +
+	"\trefund_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\brefundId\x12\"\n" +
+	"\x06reason\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06reason\"@\n" +
+	"\x14RejectRefundResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\xc5\x02\n" +
+	"\x13ApplyVoucherRequest\x12,\n" +
+	"\fvoucher_code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\vvoucherCode\x12J\n" +
+	"\bproducts\x18\x02 \x03(\v2$.order.CreateOrderRequestProductItemB\b\xbaH\x05\x92\x01\x02\b\x01R\bproducts\x12'\n" +
+	"\n" +
+	"address_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\taddressId\x122\n" +
+	"\x10shipping_courier\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x182R\x0fshippingCourier\x122\n" +
+	"\x10shipping_service\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x182R\x0fshippingService\x12#\n" +
+	"\bcurrency\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x18\x03R\bcurrency\"\xef\x05\n" +
+	"\x14ApplyVoucherResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12!\n" +
+	"\fvoucher_code\x18\x02 \x01(\tR\vvoucherCode\x12\x1e\n" +
+	"\bsubtotal\x18\x03 \x01(\x01B\x02\x18\x01R\bsubtotal\x12'\n" +
+	"\rshipping_cost\x18\x04 \x01(\x01B\x02\x18\x01R\fshippingCost\x12-\n" +
+	"\x10product_discount\x18\x05 \x01(\x01B\x02\x18\x01R\x0fproductDiscount\x12/\n" +
+	"\x11shipping_discount\x18\x06 \x01(\x01B\x02\x18\x01R\x10shippingDiscount\x12\x1e\n" +
+	"\bdiscount\x18\a \x01(\x01B\x02\x18\x01R\bdiscount\x12\x18\n" +
+	"\x05total\x18\b \x01(\x01B\x02\x18\x01R\x05total\x12\x14\n" +
+	"\x03tax\x18\t \x01(\x01B\x02\x18\x01R\x03tax\x124\n" +
+	"\x0esubtotal_money\x18\n" +
+	" \x01(\v2\r.common.MoneyR\rsubtotalMoney\x12=\n" +
+	"\x13shipping_cost_money\x18\v \x01(\v2\r.common.MoneyR\x11shippingCostMoney\x12C\n" +
+	"\x16product_discount_money\x18\f \x01(\v2\r.common.MoneyR\x14productDiscountMoney\x12E\n" +
+	"\x17shipping_discount_money\x18\r \x01(\v2\r.common.MoneyR\x15shippingDiscountMoney\x124\n" +
+	"\x0ediscount_money\x18\x0e \x01(\v2\r.common.MoneyR\rdiscountMoney\x12.\n" +
+	"\vtotal_money\x18\x0f \x01(\v2\r.common.MoneyR\n" +
+	"totalMoney\x12*\n" +
+	"\ttax_money\x18\x10 \x01(\v2\r.common.MoneyR\btaxMoney2\x89\x06\n" +
 	"\fOrderService\x12D\n" +
 	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\x12M\n" +
 	"\x0eListOrderAdmin\x12\x1c.order.ListOrderAdminRequest\x1a\x1d.order.ListOrderAdminResponse\x12>\n" +
 	"\tListOrder\x12\x17.order.ListOrderRequest\x1a\x18.order.ListOrderResponse\x12D\n" +
 	"\vDetailOrder\x12\x19.order.DetailOrderRequest\x1a\x1a.order.DetailOrderResponse\x12V\n" +
-	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a .order.UpdateOrderStatusResponseB4Z2github.com/luzmareto/go-grpc-ecommerce-be/pb/orderb\x06proto3"
+	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a .order.UpdateOrderStatusResponse\x12\\\n" +
+	"\x13GetOrderPaymentLink\x12!.order.GetOrderPaymentLinkRequest\x1a\".order.GetOrderPaymentLinkResponse\x12J\n" +
+	"\rRequestReturn\x12\x1b.order.RequestReturnRequest\x1a\x1c.order.RequestReturnResponse\x12J\n" +
+	"\rApproveRefund\x12\x1b.order.ApproveRefundRequest\x1a\x1c.order.ApproveRefundResponse\x12G\n" +
+	"\fRejectRefund\x12\x1a.order.RejectRefundRequest\x1a\x1b.order.RejectRefundResponse\x12G\n" +
+	"\fApplyVoucher\x12\x1a.order.ApplyVoucherRequest\x1a\x1b.order.ApplyVoucherResponseB4Z2github.com/luzmareto/go-grpc-ecommerce-be/pb/orderb\x06proto3"
 
 var (
 	file_order_order_proto_rawDescOnce sync.Once
@@ -1210,7 +2718,7 @@ func file_order_order_proto_rawDescGZIP() []byte {
 	return file_order_order_proto_rawDescData
 }
 
-var file_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_order_order_proto_goTypes = []any{
 	(*CreateOrderRequestProductItem)(nil),     // 0: order.CreateOrderRequestProductItem
 	(*CreateOrderRequest)(nil),                // 1: order.CreateOrderRequest
@@ -1225,49 +2733,110 @@ var file_order_order_proto_goTypes = []any{
 	(*ListOrderResponse)(nil),                 // 10: order.ListOrderResponse
 	(*DetailOrderRequest)(nil),                // 11: order.DetailOrderRequest
 	(*DetailOrderResponseItem)(nil),           // 12: order.DetailOrderResponseItem
-	(*DetailOrderResponse)(nil),               // 13: order.DetailOrderResponse
-	(*UpdateOrderStatusRequest)(nil),          // 14: order.UpdateOrderStatusRequest
-	(*UpdateOrderStatusResponse)(nil),         // 15: order.UpdateOrderStatusResponse
-	(*common.BaseResponse)(nil),               // 16: common.BaseResponse
-	(*common.PaginationRequest)(nil),          // 17: common.PaginationRequest
-	(*timestamppb.Timestamp)(nil),             // 18: google.protobuf.Timestamp
-	(*common.PaginationResponse)(nil),         // 19: common.PaginationResponse
+	(*DetailOrderResponseRefundItem)(nil),     // 13: order.DetailOrderResponseRefundItem
+	(*DetailOrderResponseRefund)(nil),         // 14: order.DetailOrderResponseRefund
+	(*DetailOrderResponse)(nil),               // 15: order.DetailOrderResponse
+	(*UpdateOrderStatusRequest)(nil),          // 16: order.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil),         // 17: order.UpdateOrderStatusResponse
+	(*GetOrderPaymentLinkRequest)(nil),        // 18: order.GetOrderPaymentLinkRequest
+	(*GetOrderPaymentLinkResponse)(nil),       // 19: order.GetOrderPaymentLinkResponse
+	(*RequestReturnRequestItem)(nil),          // 20: order.RequestReturnRequestItem
+	(*RequestReturnRequest)(nil),              // 21: order.RequestReturnRequest
+	(*RequestReturnResponse)(nil),             // 22: order.RequestReturnResponse
+	(*ApproveRefundRequest)(nil),              // 23: order.ApproveRefundRequest
+	(*ApproveRefundResponse)(nil),             // 24: order.ApproveRefundResponse
+	(*RejectRefundRequest)(nil),               // 25: order.RejectRefundRequest
+	(*RejectRefundResponse)(nil),              // 26: order.RejectRefundResponse
+	(*ApplyVoucherRequest)(nil),               // 27: order.ApplyVoucherRequest
+	(*ApplyVoucherResponse)(nil),              // 28: order.ApplyVoucherResponse
+	(*common.BaseResponse)(nil),               // 29: common.BaseResponse
+	(*common.PaginationRequest)(nil),          // 30: common.PaginationRequest
+	(*common.Money)(nil),                      // 31: common.Money
+	(*timestamppb.Timestamp)(nil),             // 32: google.protobuf.Timestamp
+	(*common.PaginationResponse)(nil),         // 33: common.PaginationResponse
 }
 var file_order_order_proto_depIdxs = []int32{
 	0,  // 0: order.CreateOrderRequest.products:type_name -> order.CreateOrderRequestProductItem
-	16, // 1: order.CreateOrderResponse.base:type_name -> common.BaseResponse
-	17, // 2: order.ListOrderAdminRequest.pagination:type_name -> common.PaginationRequest
-	18, // 3: order.ListOrderAdminResponseItem.created_at:type_name -> google.protobuf.Timestamp
-	4,  // 4: order.ListOrderAdminResponseItem.products:type_name -> order.ListOrderAdminResponseItemProduct
-	16, // 5: order.ListOrderAdminResponse.base:type_name -> common.BaseResponse
-	19, // 6: order.ListOrderAdminResponse.pagination:type_name -> common.PaginationResponse
-	5,  // 7: order.ListOrderAdminResponse.items:type_name -> order.ListOrderAdminResponseItem
-	17, // 8: order.ListOrderRequest.pagination:type_name -> common.PaginationRequest
-	18, // 9: order.ListOrderResponseItem.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 10: order.ListOrderResponseItem.products:type_name -> order.ListOrderResponseItemProduct
-	16, // 11: order.ListOrderResponse.base:type_name -> common.BaseResponse
-	19, // 12: order.ListOrderResponse.pagination:type_name -> common.PaginationResponse
-	9,  // 13: order.ListOrderResponse.items:type_name -> order.ListOrderResponseItem
-	16, // 14: order.DetailOrderResponse.base:type_name -> common.BaseResponse
-	18, // 15: order.DetailOrderResponse.created_at:type_name -> google.protobuf.Timestamp
-	12, // 16: order.DetailOrderResponse.items:type_name -> order.DetailOrderResponseItem
-	18, // 17: order.DetailOrderResponse.expired_at:type_name -> google.protobuf.Timestamp
-	16, // 18: order.UpdateOrderStatusResponse.base:type_name -> common.BaseResponse
-	1,  // 19: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	3,  // 20: order.OrderService.ListOrderAdmin:input_type -> order.ListOrderAdminRequest
-	7,  // 21: order.OrderService.ListOrder:input_type -> order.ListOrderRequest
-	11, // 22: order.OrderService.DetailOrder:input_type -> order.DetailOrderRequest
-	14, // 23: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
-	2,  // 24: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
-	6,  // 25: order.OrderService.ListOrderAdmin:output_type -> order.ListOrderAdminResponse
-	10, // 26: order.OrderService.ListOrder:output_type -> order.ListOrderResponse
-	13, // 27: order.OrderService.DetailOrder:output_type -> order.DetailOrderResponse
-	15, // 28: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
-	24, // [24:29] is the sub-list for method output_type
-	19, // [19:24] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	29, // 1: order.CreateOrderResponse.base:type_name -> common.BaseResponse
+	30, // 2: order.ListOrderAdminRequest.pagination:type_name -> common.PaginationRequest
+	31, // 3: order.ListOrderAdminResponseItemProduct.price_money:type_name -> common.Money
+	32, // 4: order.ListOrderAdminResponseItem.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 5: order.ListOrderAdminResponseItem.products:type_name -> order.ListOrderAdminResponseItemProduct
+	31, // 6: order.ListOrderAdminResponseItem.total_money:type_name -> common.Money
+	31, // 7: order.ListOrderAdminResponseItem.subtotal_money:type_name -> common.Money
+	31, // 8: order.ListOrderAdminResponseItem.discount_money:type_name -> common.Money
+	31, // 9: order.ListOrderAdminResponseItem.tax_money:type_name -> common.Money
+	31, // 10: order.ListOrderAdminResponseItem.shipping_cost_money:type_name -> common.Money
+	29, // 11: order.ListOrderAdminResponse.base:type_name -> common.BaseResponse
+	33, // 12: order.ListOrderAdminResponse.pagination:type_name -> common.PaginationResponse
+	5,  // 13: order.ListOrderAdminResponse.items:type_name -> order.ListOrderAdminResponseItem
+	30, // 14: order.ListOrderRequest.pagination:type_name -> common.PaginationRequest
+	31, // 15: order.ListOrderResponseItemProduct.price_money:type_name -> common.Money
+	32, // 16: order.ListOrderResponseItem.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 17: order.ListOrderResponseItem.products:type_name -> order.ListOrderResponseItemProduct
+	31, // 18: order.ListOrderResponseItem.total_money:type_name -> common.Money
+	29, // 19: order.ListOrderResponse.base:type_name -> common.BaseResponse
+	33, // 20: order.ListOrderResponse.pagination:type_name -> common.PaginationResponse
+	9,  // 21: order.ListOrderResponse.items:type_name -> order.ListOrderResponseItem
+	31, // 22: order.DetailOrderResponseItem.price_money:type_name -> common.Money
+	31, // 23: order.DetailOrderResponseRefundItem.amount_money:type_name -> common.Money
+	13, // 24: order.DetailOrderResponseRefund.items:type_name -> order.DetailOrderResponseRefundItem
+	32, // 25: order.DetailOrderResponseRefund.created_at:type_name -> google.protobuf.Timestamp
+	31, // 26: order.DetailOrderResponseRefund.amount_money:type_name -> common.Money
+	29, // 27: order.DetailOrderResponse.base:type_name -> common.BaseResponse
+	32, // 28: order.DetailOrderResponse.created_at:type_name -> google.protobuf.Timestamp
+	12, // 29: order.DetailOrderResponse.items:type_name -> order.DetailOrderResponseItem
+	32, // 30: order.DetailOrderResponse.expired_at:type_name -> google.protobuf.Timestamp
+	32, // 31: order.DetailOrderResponse.paid_at:type_name -> google.protobuf.Timestamp
+	14, // 32: order.DetailOrderResponse.refunds:type_name -> order.DetailOrderResponseRefund
+	32, // 33: order.DetailOrderResponse.shipped_at:type_name -> google.protobuf.Timestamp
+	31, // 34: order.DetailOrderResponse.total_money:type_name -> common.Money
+	31, // 35: order.DetailOrderResponse.paid_amount_money:type_name -> common.Money
+	31, // 36: order.DetailOrderResponse.shipping_cost_money:type_name -> common.Money
+	31, // 37: order.DetailOrderResponse.discount_money:type_name -> common.Money
+	31, // 38: order.DetailOrderResponse.subtotal_money:type_name -> common.Money
+	31, // 39: order.DetailOrderResponse.tax_money:type_name -> common.Money
+	29, // 40: order.UpdateOrderStatusResponse.base:type_name -> common.BaseResponse
+	29, // 41: order.GetOrderPaymentLinkResponse.base:type_name -> common.BaseResponse
+	20, // 42: order.RequestReturnRequest.items:type_name -> order.RequestReturnRequestItem
+	29, // 43: order.RequestReturnResponse.base:type_name -> common.BaseResponse
+	31, // 44: order.RequestReturnResponse.amount_money:type_name -> common.Money
+	29, // 45: order.ApproveRefundResponse.base:type_name -> common.BaseResponse
+	29, // 46: order.RejectRefundResponse.base:type_name -> common.BaseResponse
+	0,  // 47: order.ApplyVoucherRequest.products:type_name -> order.CreateOrderRequestProductItem
+	29, // 48: order.ApplyVoucherResponse.base:type_name -> common.BaseResponse
+	31, // 49: order.ApplyVoucherResponse.subtotal_money:type_name -> common.Money
+	31, // 50: order.ApplyVoucherResponse.shipping_cost_money:type_name -> common.Money
+	31, // 51: order.ApplyVoucherResponse.product_discount_money:type_name -> common.Money
+	31, // 52: order.ApplyVoucherResponse.shipping_discount_money:type_name -> common.Money
+	31, // 53: order.ApplyVoucherResponse.discount_money:type_name -> common.Money
+	31, // 54: order.ApplyVoucherResponse.total_money:type_name -> common.Money
+	31, // 55: order.ApplyVoucherResponse.tax_money:type_name -> common.Money
+	1,  // 56: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	3,  // 57: order.OrderService.ListOrderAdmin:input_type -> order.ListOrderAdminRequest
+	7,  // 58: order.OrderService.ListOrder:input_type -> order.ListOrderRequest
+	11, // 59: order.OrderService.DetailOrder:input_type -> order.DetailOrderRequest
+	16, // 60: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
+	18, // 61: order.OrderService.GetOrderPaymentLink:input_type -> order.GetOrderPaymentLinkRequest
+	21, // 62: order.OrderService.RequestReturn:input_type -> order.RequestReturnRequest
+	23, // 63: order.OrderService.ApproveRefund:input_type -> order.ApproveRefundRequest
+	25, // 64: order.OrderService.RejectRefund:input_type -> order.RejectRefundRequest
+	27, // 65: order.OrderService.ApplyVoucher:input_type -> order.ApplyVoucherRequest
+	2,  // 66: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
+	6,  // 67: order.OrderService.ListOrderAdmin:output_type -> order.ListOrderAdminResponse
+	10, // 68: order.OrderService.ListOrder:output_type -> order.ListOrderResponse
+	15, // 69: order.OrderService.DetailOrder:output_type -> order.DetailOrderResponse
+	17, // 70: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
+	19, // 71: order.OrderService.GetOrderPaymentLink:output_type -> order.GetOrderPaymentLinkResponse
+	22, // 72: order.OrderService.RequestReturn:output_type -> order.RequestReturnResponse
+	24, // 73: order.OrderService.ApproveRefund:output_type -> order.ApproveRefundResponse
+	26, // 74: order.OrderService.RejectRefund:output_type -> order.RejectRefundResponse
+	28, // 75: order.OrderService.ApplyVoucher:output_type -> order.ApplyVoucherResponse
+	66, // [66:76] is the sub-list for method output_type
+	56, // [56:66] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_order_order_proto_init() }
@@ -1281,7 +2850,7 @@ func file_order_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_order_proto_rawDesc), len(file_order_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,11 +24,17 @@ const (
 )
 
 type CreateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	ImageFileName string                 `protobuf:"bytes,4,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: Marked as deprecated in product/product.proto.
+	Price         float64       `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	ImageFileName string        `protobuf:"bytes,4,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	WeightGram    int64         `protobuf:"varint,5,opt,name=weight_gram,json=weightGram,proto3" json:"weight_gram,omitempty"`
+	Category      string        `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	PriceMoney    *common.Money `protobuf:"bytes,7,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	// kosong berarti stok tidak dicatat
+	Stock         *int64 `protobuf:"varint,8,opt,name=stock,proto3,oneof" json:"stock,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -77,6 +83,7 @@ func (x *CreateProductRequest) GetDescription() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in product/product.proto.
 func (x *CreateProductRequest) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -91,6 +98,34 @@ func (x *CreateProductRequest) GetImageFileName() string {
 	return ""
 }
 
+func (x *CreateProductRequest) GetWeightGram() int64 {
+	if x != nil {
+		return x.WeightGram
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetStock() int64 {
+	if x != nil && x.Stock != nil {
+		return *x.Stock
+	}
+	return 0
+}
+
 type CreateProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -144,8 +179,10 @@ func (x *CreateProductResponse) GetId() string {
 }
 
 type DetailProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// mata uang tampilan harga, kosong berarti mata uang produk
+	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -187,14 +224,26 @@ func (x *DetailProductRequest) GetId() string {
 	return ""
 }
 
+func (x *DetailProductRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type DetailProductResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Base        *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id          string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: Marked as deprecated in product/product.proto.
+	Price         float64       `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string        `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	WeightGram    int64         `protobuf:"varint,7,opt,name=weight_gram,json=weightGram,proto3" json:"weight_gram,omitempty"`
+	Category      string        `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	PriceMoney    *common.Money `protobuf:"bytes,9,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	Stock         *int64        `protobuf:"varint,10,opt,name=stock,proto3,oneof" json:"stock,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -257,6 +306,7 @@ func (x *DetailProductResponse) GetDescription() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in product/product.proto.
 func (x *DetailProductResponse) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -271,13 +321,47 @@ func (x *DetailProductResponse) GetImageUrl() string {
 	return ""
 }
 
+func (x *DetailProductResponse) GetWeightGram() int64 {
+	if x != nil {
+		return x.WeightGram
+	}
+	return 0
+}
+
+func (x *DetailProductResponse) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *DetailProductResponse) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+func (x *DetailProductResponse) GetStock() int64 {
+	if x != nil && x.Stock != nil {
+		return *x.Stock
+	}
+	return 0
+}
+
 type EditProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	ImageFileName string                 `protobuf:"bytes,5,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: Marked as deprecated in product/product.proto.
+	Price         float64       `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	ImageFileName string        `protobuf:"bytes,5,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	WeightGram    int64         `protobuf:"varint,6,opt,name=weight_gram,json=weightGram,proto3" json:"weight_gram,omitempty"`
+	Category      string        `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	PriceMoney    *common.Money `protobuf:"bytes,8,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	// kosong berarti stok tidak diubah
+	Stock         *int64 `protobuf:"varint,9,opt,name=stock,proto3,oneof" json:"stock,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -333,6 +417,7 @@ func (x *EditProductRequest) GetDescription() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in product/product.proto.
 func (x *EditProductRequest) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -347,6 +432,34 @@ func (x *EditProductRequest) GetImageFileName() string {
 	return ""
 }
 
+func (x *EditProductRequest) GetWeightGram() int64 {
+	if x != nil {
+		return x.WeightGram
+	}
+	return 0
+}
+
+func (x *EditProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *EditProductRequest) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+func (x *EditProductRequest) GetStock() int64 {
+	if x != nil && x.Stock != nil {
+		return *x.Stock
+	}
+	return 0
+}
+
 type EditProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -490,6 +603,7 @@ func (x *DeleteProductResponse) GetBase() *common.BaseResponse {
 type ListProductRequest struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Currency      string                    `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -531,13 +645,22 @@ func (x *ListProductRequest) GetPagination() *common.PaginationRequest {
 	return nil
 }
 
+func (x *ListProductRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type ListProductResponseItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: Marked as deprecated in product/product.proto.
+	Price         float64       `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string        `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	PriceMoney    *common.Money `protobuf:"bytes,6,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -593,6 +716,7 @@ func (x *ListProductResponseItem) GetDescription() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in product/product.proto.
 func (x *ListProductResponseItem) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -607,6 +731,13 @@ func (x *ListProductResponseItem) GetImageUrl() string {
 	return ""
 }
 
+func (x *ListProductResponseItem) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
 type ListProductResponse struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -712,12 +843,14 @@ func (x *ListProductAdminRequest) GetPagination() *common.PaginationRequest {
 }
 
 type ListProductAdminResponseItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: Marked as deprecated in product/product.proto.
+	Price         float64       `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string        `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	PriceMoney    *common.Money `protobuf:"bytes,6,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -773,6 +906,7 @@ func (x *ListProductAdminResponseItem) GetDescription() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in product/product.proto.
 func (x *ListProductAdminResponseItem) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -787,6 +921,13 @@ func (x *ListProductAdminResponseItem) GetImageUrl() string {
 	return ""
 }
 
+func (x *ListProductAdminResponseItem) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
 type ListProductAdminResponse struct {
 	state         protoimpl.MessageState          `protogen:"open.v1"`
 	Base          *common.BaseResponse            `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -849,6 +990,7 @@ func (x *ListProductAdminResponse) GetData() []*ListProductAdminResponseItem {
 
 type HighlightProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -883,13 +1025,22 @@ func (*HighlightProductsRequest) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{14}
 }
 
+func (x *HighlightProductsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type HighlightProductsResponseItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: Marked as deprecated in product/product.proto.
+	Price         float64       `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string        `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	PriceMoney    *common.Money `protobuf:"bytes,6,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -945,6 +1096,7 @@ func (x *HighlightProductsResponseItem) GetDescription() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in product/product.proto.
 func (x *HighlightProductsResponseItem) GetPrice() float64 {
 	if x != nil {
 		return x.Price
@@ -959,6 +1111,13 @@ func (x *HighlightProductsResponseItem) GetImageUrl() string {
 	return ""
 }
 
+func (x *HighlightProductsResponseItem) GetPriceMoney() *common.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
 type HighlightProductsResponse struct {
 	state         protoimpl.MessageState           `protogen:"open.v1"`
 	Base          *common.BaseResponse             `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -1015,38 +1174,61 @@ var File_product_product_proto protoreflect.FileDescriptor
 
 const file_product_product_proto_rawDesc = "" +
 	"\n" +
-	"\x15product/product.proto\x12\aproduct\x1a\x1acommon/base_response.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\"\xbe\x01\n" +
+	"\x15product/product.proto\x12\aproduct\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\"\xee\x02\n" +
 	"\x14CreateProductRequest\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12,\n" +
 	"\vdescription\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vdescription\x12$\n" +
-	"\x05price\x18\x03 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x05price\x122\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vdescription\x12&\n" +
+	"\x05price\x18\x03 \x01(\x01B\x10\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00\x18\x01R\x05price\x122\n" +
 	"\x0fimage_file_name\x18\x04 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\rimageFileName\"Q\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\rimageFileName\x12(\n" +
+	"\vweight_gram\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\n" +
+	"weightGram\x12$\n" +
+	"\bcategory\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\bcategory\x12.\n" +
+	"\vprice_money\x18\a \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\x12\"\n" +
+	"\x05stock\x18\b \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x00R\x05stock\x88\x01\x01B\b\n" +
+	"\x06_stock\"Q\n" +
 	"\x15CreateProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"2\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"W\n" +
 	"\x14DetailProductRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"\xba\x01\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12#\n" +
+	"\bcurrency\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18\x03R\bcurrency\"\xd0\x02\n" +
 	"\x15DetailProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x06 \x01(\tR\bimageUrl\"\xd8\x01\n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\x05price\x18\x05 \x01(\x01B\x02\x18\x01R\x05price\x12\x1b\n" +
+	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12\x1f\n" +
+	"\vweight_gram\x18\a \x01(\x03R\n" +
+	"weightGram\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\x12.\n" +
+	"\vprice_money\x18\t \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\x12\x19\n" +
+	"\x05stock\x18\n" +
+	" \x01(\x03H\x00R\x05stock\x88\x01\x01B\b\n" +
+	"\x06_stock\"\x88\x03\n" +
 	"\x12EditProductRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12\x1e\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12,\n" +
 	"\vdescription\x18\x03 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vdescription\x12$\n" +
-	"\x05price\x18\x04 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x05price\x122\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vdescription\x12&\n" +
+	"\x05price\x18\x04 \x01(\x01B\x10\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00\x18\x01R\x05price\x122\n" +
 	"\x0fimage_file_name\x18\x05 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\rimageFileName\"O\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\rimageFileName\x12(\n" +
+	"\vweight_gram\x18\x06 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\n" +
+	"weightGram\x12$\n" +
+	"\bcategory\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\bcategory\x12.\n" +
+	"\vprice_money\x18\b \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\x12\"\n" +
+	"\x05stock\x18\t \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x00R\x05stock\x88\x01\x01B\b\n" +
+	"\x06_stock\"O\n" +
 	"\x13EditProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\"2\n" +
@@ -1054,17 +1236,20 @@ const file_product_product_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"A\n" +
 	"\x15DeleteProductResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"O\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"t\n" +
 	"\x12ListProductRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"\x92\x01\n" +
+	"pagination\x12#\n" +
+	"\bcurrency\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18\x03R\bcurrency\"\xc6\x01\n" +
 	"\x17ListProductResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x05 \x01(\tR\bimageUrl\"\xb1\x01\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\x05price\x18\x04 \x01(\x01B\x02\x18\x01R\x05price\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12.\n" +
+	"\vprice_money\x18\x06 \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\"\xb1\x01\n" +
 	"\x13ListProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
 	"\n" +
@@ -1074,26 +1259,31 @@ const file_product_product_proto_rawDesc = "" +
 	"\x17ListProductAdminRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"\x97\x01\n" +
+	"pagination\"\xcb\x01\n" +
 	"\x1cListProductAdminResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x05 \x01(\tR\bimageUrl\"\xbb\x01\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\x05price\x18\x04 \x01(\x01B\x02\x18\x01R\x05price\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12.\n" +
+	"\vprice_money\x18\x06 \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\"\xbb\x01\n" +
 	"\x18ListProductAdminResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
 	"pagination\x129\n" +
-	"\x04data\x18\x03 \x03(\v2%.product.ListProductAdminResponseItemR\x04data\"\x1a\n" +
-	"\x18HighlightProductsRequest\"\x98\x01\n" +
+	"\x04data\x18\x03 \x03(\v2%.product.ListProductAdminResponseItemR\x04data\"?\n" +
+	"\x18HighlightProductsRequest\x12#\n" +
+	"\bcurrency\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18\x03R\bcurrency\"\xcc\x01\n" +
 	"\x1dHighlightProductsResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x05 \x01(\tR\bimageUrl\"\x81\x01\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\x05price\x18\x04 \x01(\x01B\x02\x18\x01R\x05price\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12.\n" +
+	"\vprice_money\x18\x06 \x01(\v2\r.common.MoneyR\n" +
+	"priceMoney\"\x81\x01\n" +
 	"\x19HighlightProductsResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
 	"\x04data\x18\x02 \x03(\v2&.product.HighlightProductsResponseItemR\x04data2\xc9\x04\n" +
@@ -1137,44 +1327,51 @@ var file_product_product_proto_goTypes = []any{
 	(*HighlightProductsRequest)(nil),      // 14: product.HighlightProductsRequest
 	(*HighlightProductsResponseItem)(nil), // 15: product.HighlightProductsResponseItem
 	(*HighlightProductsResponse)(nil),     // 16: product.HighlightProductsResponse
-	(*common.BaseResponse)(nil),           // 17: common.BaseResponse
-	(*common.PaginationRequest)(nil),      // 18: common.PaginationRequest
-	(*common.PaginationResponse)(nil),     // 19: common.PaginationResponse
+	(*common.Money)(nil),                  // 17: common.Money
+	(*common.BaseResponse)(nil),           // 18: common.BaseResponse
+	(*common.PaginationRequest)(nil),      // 19: common.PaginationRequest
+	(*common.PaginationResponse)(nil),     // 20: common.PaginationResponse
 }
 var file_product_product_proto_depIdxs = []int32{
-	17, // 0: product.CreateProductResponse.base:type_name -> common.BaseResponse
-	17, // 1: product.DetailProductResponse.base:type_name -> common.BaseResponse
-	17, // 2: product.EditProductResponse.base:type_name -> common.BaseResponse
-	17, // 3: product.DeleteProductResponse.base:type_name -> common.BaseResponse
-	18, // 4: product.ListProductRequest.pagination:type_name -> common.PaginationRequest
-	17, // 5: product.ListProductResponse.base:type_name -> common.BaseResponse
-	19, // 6: product.ListProductResponse.pagination:type_name -> common.PaginationResponse
-	9,  // 7: product.ListProductResponse.data:type_name -> product.ListProductResponseItem
-	18, // 8: product.ListProductAdminRequest.pagination:type_name -> common.PaginationRequest
-	17, // 9: product.ListProductAdminResponse.base:type_name -> common.BaseResponse
-	19, // 10: product.ListProductAdminResponse.pagination:type_name -> common.PaginationResponse
-	12, // 11: product.ListProductAdminResponse.data:type_name -> product.ListProductAdminResponseItem
-	17, // 12: product.HighlightProductsResponse.base:type_name -> common.BaseResponse
-	15, // 13: product.HighlightProductsResponse.data:type_name -> product.HighlightProductsResponseItem
-	0,  // 14: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
-	2,  // 15: product.ProductService.DetailProduct:input_type -> product.DetailProductRequest
-	4,  // 16: product.ProductService.EditProduct:input_type -> product.EditProductRequest
-	6,  // 17: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
-	8,  // 18: product.ProductService.ListProduct:input_type -> product.ListProductRequest
-	11, // 19: product.ProductService.ListProductAdmin:input_type -> product.ListProductAdminRequest
-	14, // 20: product.ProductService.HighlightProducts:input_type -> product.HighlightProductsRequest
-	1,  // 21: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
-	3,  // 22: product.ProductService.DetailProduct:output_type -> product.DetailProductResponse
-	5,  // 23: product.ProductService.EditProduct:output_type -> product.EditProductResponse
-	7,  // 24: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
-	10, // 25: product.ProductService.ListProduct:output_type -> product.ListProductResponse
-	13, // 26: product.ProductService.ListProductAdmin:output_type -> product.ListProductAdminResponse
-	16, // 27: product.ProductService.HighlightProducts:output_type -> product.HighlightProductsResponse
-	21, // [21:28] is the sub-list for method output_type
-	14, // [14:21] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	17, // 0: product.CreateProductRequest.price_money:type_name -> common.Money
+	18, // 1: product.CreateProductResponse.base:type_name -> common.BaseResponse
+	18, // 2: product.DetailProductResponse.base:type_name -> common.BaseResponse
+	17, // 3: product.DetailProductResponse.price_money:type_name -> common.Money
+	17, // 4: product.EditProductRequest.price_money:type_name -> common.Money
+	18, // 5: product.EditProductResponse.base:type_name -> common.BaseResponse
+	18, // 6: product.DeleteProductResponse.base:type_name -> common.BaseResponse
+	19, // 7: product.ListProductRequest.pagination:type_name -> common.PaginationRequest
+	17, // 8: product.ListProductResponseItem.price_money:type_name -> common.Money
+	18, // 9: product.ListProductResponse.base:type_name -> common.BaseResponse
+	20, // 10: product.ListProductResponse.pagination:type_name -> common.PaginationResponse
+	9,  // 11: product.ListProductResponse.data:type_name -> product.ListProductResponseItem
+	19, // 12: product.ListProductAdminRequest.pagination:type_name -> common.PaginationRequest
+	17, // 13: product.ListProductAdminResponseItem.price_money:type_name -> common.Money
+	18, // 14: product.ListProductAdminResponse.base:type_name -> common.BaseResponse
+	20, // 15: product.ListProductAdminResponse.pagination:type_name -> common.PaginationResponse
+	12, // 16: product.ListProductAdminResponse.data:type_name -> product.ListProductAdminResponseItem
+	17, // 17: product.HighlightProductsResponseItem.price_money:type_name -> common.Money
+	18, // 18: product.HighlightProductsResponse.base:type_name -> common.BaseResponse
+	15, // 19: product.HighlightProductsResponse.data:type_name -> product.HighlightProductsResponseItem
+	0,  // 20: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
+	2,  // 21: product.ProductService.DetailProduct:input_type -> product.DetailProductRequest
+	4,  // 22: product.ProductService.EditProduct:input_type -> product.EditProductRequest
+	6,  // 23: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
+	8,  // 24: product.ProductService.ListProduct:input_type -> product.ListProductRequest
+	11, // 25: product.ProductService.ListProductAdmin:input_type -> product.ListProductAdminRequest
+	14, // 26: product.ProductService.HighlightProducts:input_type -> product.HighlightProductsRequest
+	1,  // 27: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
+	3,  // 28: product.ProductService.DetailProduct:output_type -> product.DetailProductResponse
+	5,  // 29: product.ProductService.EditProduct:output_type -> product.EditProductResponse
+	7,  // 30: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
+	10, // 31: product.ProductService.ListProduct:output_type -> product.ListProductResponse
+	13, // 32: product.ProductService.ListProductAdmin:output_type -> product.ListProductAdminResponse
+	16, // 33: product.ProductService.HighlightProducts:output_type -> product.HighlightProductsResponse
+	27, // [27:34] is the sub-list for method output_type
+	20, // [20:27] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_product_product_proto_init() }
@@ -1182,6 +1379,9 @@ func file_product_product_proto_init() {
 	if File_product_product_proto != nil {
 		return
 	}
+	file_product_product_proto_msgTypes[0].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[3].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
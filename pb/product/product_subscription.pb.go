@@ -0,0 +1,308 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: product/product_subscription.proto
+
+package product
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubscribeProductEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeProductEventRequest) Reset() {
+	*x = SubscribeProductEventRequest{}
+	mi := &file_product_product_subscription_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeProductEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeProductEventRequest) ProtoMessage() {}
+
+func (x *SubscribeProductEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_subscription_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeProductEventRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeProductEventRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeProductEventRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SubscribeProductEventRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+type SubscribeProductEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeProductEventResponse) Reset() {
+	*x = SubscribeProductEventResponse{}
+	mi := &file_product_product_subscription_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeProductEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeProductEventResponse) ProtoMessage() {}
+
+func (x *SubscribeProductEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_subscription_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeProductEventResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeProductEventResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubscribeProductEventResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SubscribeProductEventResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UnsubscribeProductEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeProductEventRequest) Reset() {
+	*x = UnsubscribeProductEventRequest{}
+	mi := &file_product_product_subscription_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeProductEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeProductEventRequest) ProtoMessage() {}
+
+func (x *UnsubscribeProductEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_subscription_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeProductEventRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeProductEventRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_subscription_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UnsubscribeProductEventRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UnsubscribeProductEventRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+type UnsubscribeProductEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeProductEventResponse) Reset() {
+	*x = UnsubscribeProductEventResponse{}
+	mi := &file_product_product_subscription_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeProductEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeProductEventResponse) ProtoMessage() {}
+
+func (x *UnsubscribeProductEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_subscription_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeProductEventResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeProductEventResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_subscription_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnsubscribeProductEventResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+var File_product_product_subscription_proto protoreflect.FileDescriptor
+
+const file_product_product_subscription_proto_rawDesc = "" +
+	"\n" +
+	"\"product/product_subscription.proto\x12\aproduct\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\"\x88\x01\n" +
+	"\x1cSubscribeProductEventRequest\x12'\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tproductId\x12?\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tB \xbaH\x1dr\x1bR\rback_in_stockR\n" +
+	"price_dropR\teventType\"Y\n" +
+	"\x1dSubscribeProductEventResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x8a\x01\n" +
+	"\x1eUnsubscribeProductEventRequest\x12'\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tproductId\x12?\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tB \xbaH\x1dr\x1bR\rback_in_stockR\n" +
+	"price_dropR\teventType\"K\n" +
+	"\x1fUnsubscribeProductEventResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xf2\x01\n" +
+	"\x1aProductSubscriptionService\x12f\n" +
+	"\x15SubscribeProductEvent\x12%.product.SubscribeProductEventRequest\x1a&.product.SubscribeProductEventResponse\x12l\n" +
+	"\x17UnsubscribeProductEvent\x12'.product.UnsubscribeProductEventRequest\x1a(.product.UnsubscribeProductEventResponseB6Z4github.com/luzmareto/go-grpc-ecommerce-be/pb/productb\x06proto3"
+
+var (
+	file_product_product_subscription_proto_rawDescOnce sync.Once
+	file_product_product_subscription_proto_rawDescData []byte
+)
+
+func file_product_product_subscription_proto_rawDescGZIP() []byte {
+	file_product_product_subscription_proto_rawDescOnce.Do(func() {
+		file_product_product_subscription_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_product_product_subscription_proto_rawDesc), len(file_product_product_subscription_proto_rawDesc)))
+	})
+	return file_product_product_subscription_proto_rawDescData
+}
+
+var file_product_product_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_product_product_subscription_proto_goTypes = []any{
+	(*SubscribeProductEventRequest)(nil),    // 0: product.SubscribeProductEventRequest
+	(*SubscribeProductEventResponse)(nil),   // 1: product.SubscribeProductEventResponse
+	(*UnsubscribeProductEventRequest)(nil),  // 2: product.UnsubscribeProductEventRequest
+	(*UnsubscribeProductEventResponse)(nil), // 3: product.UnsubscribeProductEventResponse
+	(*common.BaseResponse)(nil),             // 4: common.BaseResponse
+}
+var file_product_product_subscription_proto_depIdxs = []int32{
+	4, // 0: product.SubscribeProductEventResponse.base:type_name -> common.BaseResponse
+	4, // 1: product.UnsubscribeProductEventResponse.base:type_name -> common.BaseResponse
+	0, // 2: product.ProductSubscriptionService.SubscribeProductEvent:input_type -> product.SubscribeProductEventRequest
+	2, // 3: product.ProductSubscriptionService.UnsubscribeProductEvent:input_type -> product.UnsubscribeProductEventRequest
+	1, // 4: product.ProductSubscriptionService.SubscribeProductEvent:output_type -> product.SubscribeProductEventResponse
+	3, // 5: product.ProductSubscriptionService.UnsubscribeProductEvent:output_type -> product.UnsubscribeProductEventResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_product_product_subscription_proto_init() }
+func file_product_product_subscription_proto_init() {
+	if File_product_product_subscription_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_product_subscription_proto_rawDesc), len(file_product_product_subscription_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_product_product_subscription_proto_goTypes,
+		DependencyIndexes: file_product_product_subscription_proto_depIdxs,
+		MessageInfos:      file_product_product_subscription_proto_msgTypes,
+	}.Build()
+	File_product_product_subscription_proto = out.File
+	file_product_product_subscription_proto_goTypes = nil
+	file_product_product_subscription_proto_depIdxs = nil
+}
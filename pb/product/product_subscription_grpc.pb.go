@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: product/product_subscription.proto
+
+package product
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ProductSubscriptionService_SubscribeProductEvent_FullMethodName   = "/product.ProductSubscriptionService/SubscribeProductEvent"
+	ProductSubscriptionService_UnsubscribeProductEvent_FullMethodName = "/product.ProductSubscriptionService/UnsubscribeProductEvent"
+)
+
+// ProductSubscriptionServiceClient is the client API for ProductSubscriptionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProductSubscriptionServiceClient interface {
+	SubscribeProductEvent(ctx context.Context, in *SubscribeProductEventRequest, opts ...grpc.CallOption) (*SubscribeProductEventResponse, error)
+	UnsubscribeProductEvent(ctx context.Context, in *UnsubscribeProductEventRequest, opts ...grpc.CallOption) (*UnsubscribeProductEventResponse, error)
+}
+
+type productSubscriptionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProductSubscriptionServiceClient(cc grpc.ClientConnInterface) ProductSubscriptionServiceClient {
+	return &productSubscriptionServiceClient{cc}
+}
+
+func (c *productSubscriptionServiceClient) SubscribeProductEvent(ctx context.Context, in *SubscribeProductEventRequest, opts ...grpc.CallOption) (*SubscribeProductEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeProductEventResponse)
+	err := c.cc.Invoke(ctx, ProductSubscriptionService_SubscribeProductEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productSubscriptionServiceClient) UnsubscribeProductEvent(ctx context.Context, in *UnsubscribeProductEventRequest, opts ...grpc.CallOption) (*UnsubscribeProductEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeProductEventResponse)
+	err := c.cc.Invoke(ctx, ProductSubscriptionService_UnsubscribeProductEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductSubscriptionServiceServer is the server API for ProductSubscriptionService service.
+// All implementations must embed UnimplementedProductSubscriptionServiceServer
+// for forward compatibility.
+type ProductSubscriptionServiceServer interface {
+	SubscribeProductEvent(context.Context, *SubscribeProductEventRequest) (*SubscribeProductEventResponse, error)
+	UnsubscribeProductEvent(context.Context, *UnsubscribeProductEventRequest) (*UnsubscribeProductEventResponse, error)
+	mustEmbedUnimplementedProductSubscriptionServiceServer()
+}
+
+// UnimplementedProductSubscriptionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductSubscriptionServiceServer struct{}
+
+func (UnimplementedProductSubscriptionServiceServer) SubscribeProductEvent(context.Context, *SubscribeProductEventRequest) (*SubscribeProductEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeProductEvent not implemented")
+}
+func (UnimplementedProductSubscriptionServiceServer) UnsubscribeProductEvent(context.Context, *UnsubscribeProductEventRequest) (*UnsubscribeProductEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeProductEvent not implemented")
+}
+func (UnimplementedProductSubscriptionServiceServer) mustEmbedUnimplementedProductSubscriptionServiceServer() {
+}
+func (UnimplementedProductSubscriptionServiceServer) testEmbeddedByValue() {}
+
+// UnsafeProductSubscriptionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductSubscriptionServiceServer will
+// result in compilation errors.
+type UnsafeProductSubscriptionServiceServer interface {
+	mustEmbedUnimplementedProductSubscriptionServiceServer()
+}
+
+func RegisterProductSubscriptionServiceServer(s grpc.ServiceRegistrar, srv ProductSubscriptionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProductSubscriptionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProductSubscriptionService_ServiceDesc, srv)
+}
+
+func _ProductSubscriptionService_SubscribeProductEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeProductEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductSubscriptionServiceServer).SubscribeProductEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductSubscriptionService_SubscribeProductEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductSubscriptionServiceServer).SubscribeProductEvent(ctx, req.(*SubscribeProductEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductSubscriptionService_UnsubscribeProductEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeProductEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductSubscriptionServiceServer).UnsubscribeProductEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductSubscriptionService_UnsubscribeProductEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductSubscriptionServiceServer).UnsubscribeProductEvent(ctx, req.(*UnsubscribeProductEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProductSubscriptionService_ServiceDesc is the grpc.ServiceDesc for ProductSubscriptionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProductSubscriptionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "product.ProductSubscriptionService",
+	HandlerType: (*ProductSubscriptionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubscribeProductEvent",
+			Handler:    _ProductSubscriptionService_SubscribeProductEvent_Handler,
+		},
+		{
+			MethodName: "UnsubscribeProductEvent",
+			Handler:    _ProductSubscriptionService_UnsubscribeProductEvent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "product/product_subscription.proto",
+}
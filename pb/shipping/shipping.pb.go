@@ -0,0 +1,1030 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.30.0--rc1
+// source: shipping/shipping.proto
+
+package shipping
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	RecipientName string                 `protobuf:"bytes,2,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	City          string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	Province      string                 `protobuf:"bytes,6,opt,name=province,proto3" json:"province,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAddressRequest) Reset() {
+	*x = CreateAddressRequest{}
+	mi := &file_shipping_shipping_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAddressRequest) ProtoMessage() {}
+
+func (x *CreateAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAddressRequest.ProtoReflect.Descriptor instead.
+func (*CreateAddressRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateAddressRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetRecipientName() string {
+	if x != nil {
+		return x.RecipientName
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *CreateAddressRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type CreateAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAddressResponse) Reset() {
+	*x = CreateAddressResponse{}
+	mi := &file_shipping_shipping_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAddressResponse) ProtoMessage() {}
+
+func (x *CreateAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAddressResponse.ProtoReflect.Descriptor instead.
+func (*CreateAddressResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAddressResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateAddressResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressRequest) Reset() {
+	*x = ListAddressRequest{}
+	mi := &file_shipping_shipping_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressRequest) ProtoMessage() {}
+
+func (x *ListAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressRequest.ProtoReflect.Descriptor instead.
+func (*ListAddressRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{2}
+}
+
+type ListAddressResponseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	RecipientName string                 `protobuf:"bytes,3,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,4,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	City          string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	Province      string                 `protobuf:"bytes,7,opt,name=province,proto3" json:"province,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,8,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,9,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressResponseItem) Reset() {
+	*x = ListAddressResponseItem{}
+	mi := &file_shipping_shipping_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressResponseItem) ProtoMessage() {}
+
+func (x *ListAddressResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressResponseItem.ProtoReflect.Descriptor instead.
+func (*ListAddressResponseItem) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAddressResponseItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListAddressResponseItem) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ListAddressResponseItem) GetRecipientName() string {
+	if x != nil {
+		return x.RecipientName
+	}
+	return ""
+}
+
+func (x *ListAddressResponseItem) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *ListAddressResponseItem) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ListAddressResponseItem) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ListAddressResponseItem) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *ListAddressResponseItem) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *ListAddressResponseItem) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type ListAddressResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items         []*ListAddressResponseItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressResponse) Reset() {
+	*x = ListAddressResponse{}
+	mi := &file_shipping_shipping_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressResponse) ProtoMessage() {}
+
+func (x *ListAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressResponse.ProtoReflect.Descriptor instead.
+func (*ListAddressResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAddressResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListAddressResponse) GetItems() []*ListAddressResponseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpdateAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	RecipientName string                 `protobuf:"bytes,3,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,4,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	City          string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	Province      string                 `protobuf:"bytes,7,opt,name=province,proto3" json:"province,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,8,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,9,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAddressRequest) Reset() {
+	*x = UpdateAddressRequest{}
+	mi := &file_shipping_shipping_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAddressRequest) ProtoMessage() {}
+
+func (x *UpdateAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAddressRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAddressRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateAddressRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetRecipientName() string {
+	if x != nil {
+		return x.RecipientName
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type UpdateAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAddressResponse) Reset() {
+	*x = UpdateAddressResponse{}
+	mi := &file_shipping_shipping_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAddressResponse) ProtoMessage() {}
+
+func (x *UpdateAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAddressResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAddressResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateAddressResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type DeleteAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAddressRequest) Reset() {
+	*x = DeleteAddressRequest{}
+	mi := &file_shipping_shipping_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressRequest) ProtoMessage() {}
+
+func (x *DeleteAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAddressRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteAddressRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAddressResponse) Reset() {
+	*x = DeleteAddressResponse{}
+	mi := &file_shipping_shipping_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressResponse) ProtoMessage() {}
+
+func (x *DeleteAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAddressResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteAddressResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type GetShippingRatesRequestProductItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShippingRatesRequestProductItem) Reset() {
+	*x = GetShippingRatesRequestProductItem{}
+	mi := &file_shipping_shipping_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShippingRatesRequestProductItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShippingRatesRequestProductItem) ProtoMessage() {}
+
+func (x *GetShippingRatesRequestProductItem) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShippingRatesRequestProductItem.ProtoReflect.Descriptor instead.
+func (*GetShippingRatesRequestProductItem) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetShippingRatesRequestProductItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetShippingRatesRequestProductItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type GetShippingRatesRequest struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	AddressId     string                                `protobuf:"bytes,1,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	Products      []*GetShippingRatesRequestProductItem `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShippingRatesRequest) Reset() {
+	*x = GetShippingRatesRequest{}
+	mi := &file_shipping_shipping_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShippingRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShippingRatesRequest) ProtoMessage() {}
+
+func (x *GetShippingRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShippingRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetShippingRatesRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetShippingRatesRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+func (x *GetShippingRatesRequest) GetProducts() []*GetShippingRatesRequestProductItem {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type GetShippingRatesResponseItem struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Courier string                 `protobuf:"bytes,1,opt,name=courier,proto3" json:"courier,omitempty"`
+	Service string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// Deprecated: Marked as deprecated in shipping/shipping.proto.
+	Cost          float64       `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	EtdDays       int64         `protobuf:"varint,4,opt,name=etd_days,json=etdDays,proto3" json:"etd_days,omitempty"`
+	CostMoney     *common.Money `protobuf:"bytes,5,opt,name=cost_money,json=costMoney,proto3" json:"cost_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShippingRatesResponseItem) Reset() {
+	*x = GetShippingRatesResponseItem{}
+	mi := &file_shipping_shipping_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShippingRatesResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShippingRatesResponseItem) ProtoMessage() {}
+
+func (x *GetShippingRatesResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShippingRatesResponseItem.ProtoReflect.Descriptor instead.
+func (*GetShippingRatesResponseItem) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetShippingRatesResponseItem) GetCourier() string {
+	if x != nil {
+		return x.Courier
+	}
+	return ""
+}
+
+func (x *GetShippingRatesResponseItem) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in shipping/shipping.proto.
+func (x *GetShippingRatesResponseItem) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *GetShippingRatesResponseItem) GetEtdDays() int64 {
+	if x != nil {
+		return x.EtdDays
+	}
+	return 0
+}
+
+func (x *GetShippingRatesResponseItem) GetCostMoney() *common.Money {
+	if x != nil {
+		return x.CostMoney
+	}
+	return nil
+}
+
+type GetShippingRatesResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Base          *common.BaseResponse            `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	WeightGram    int64                           `protobuf:"varint,2,opt,name=weight_gram,json=weightGram,proto3" json:"weight_gram,omitempty"`
+	Items         []*GetShippingRatesResponseItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShippingRatesResponse) Reset() {
+	*x = GetShippingRatesResponse{}
+	mi := &file_shipping_shipping_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShippingRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShippingRatesResponse) ProtoMessage() {}
+
+func (x *GetShippingRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_shipping_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShippingRatesResponse.ProtoReflect.Descriptor instead.
+func (*GetShippingRatesResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_shipping_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetShippingRatesResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetShippingRatesResponse) GetWeightGram() int64 {
+	if x != nil {
+		return x.WeightGram
+	}
+	return 0
+}
+
+func (x *GetShippingRatesResponse) GetItems() []*GetShippingRatesResponseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_shipping_shipping_proto protoreflect.FileDescriptor
+
+const file_shipping_shipping_proto_rawDesc = "" +
+	"\n" +
+	"\x17shipping/shipping.proto\x12\bshipping\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x1bbuf/validate/validate.proto\"\xd3\x02\n" +
+	"\x14CreateAddressRequest\x12 \n" +
+	"\x05label\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05label\x121\n" +
+	"\x0erecipient_name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\rrecipientName\x12-\n" +
+	"\fphone_number\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vphoneNumber\x12$\n" +
+	"\aaddress\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aaddress\x12\x1e\n" +
+	"\x04city\x18\x05 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04city\x12&\n" +
+	"\bprovince\x18\x06 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\bprovince\x12*\n" +
+	"\vpostal_code\x18\a \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\n" +
+	"postalCode\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\b \x01(\bR\tisDefault\"Q\n" +
+	"\x15CreateAddressResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x14\n" +
+	"\x12ListAddressRequest\"\x93\x02\n" +
+	"\x17ListAddressResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12%\n" +
+	"\x0erecipient_name\x18\x03 \x01(\tR\rrecipientName\x12!\n" +
+	"\fphone_number\x18\x04 \x01(\tR\vphoneNumber\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04city\x18\x06 \x01(\tR\x04city\x12\x1a\n" +
+	"\bprovince\x18\a \x01(\tR\bprovince\x12\x1f\n" +
+	"\vpostal_code\x18\b \x01(\tR\n" +
+	"postalCode\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\t \x01(\bR\tisDefault\"x\n" +
+	"\x13ListAddressResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x127\n" +
+	"\x05items\x18\x02 \x03(\v2!.shipping.ListAddressResponseItemR\x05items\"\xef\x02\n" +
+	"\x14UpdateAddressRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12 \n" +
+	"\x05label\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05label\x121\n" +
+	"\x0erecipient_name\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\rrecipientName\x12-\n" +
+	"\fphone_number\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vphoneNumber\x12$\n" +
+	"\aaddress\x18\x05 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aaddress\x12\x1e\n" +
+	"\x04city\x18\x06 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04city\x12&\n" +
+	"\bprovince\x18\a \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\bprovince\x12*\n" +
+	"\vpostal_code\x18\b \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\n" +
+	"postalCode\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\t \x01(\bR\tisDefault\"A\n" +
+	"\x15UpdateAddressResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"2\n" +
+	"\x14DeleteAddressRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"A\n" +
+	"\x15DeleteAddressResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"e\n" +
+	"\"GetShippingRatesRequestProductItem\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bquantity\"\x98\x01\n" +
+	"\x17GetShippingRatesRequest\x12)\n" +
+	"\n" +
+	"address_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\taddressId\x12R\n" +
+	"\bproducts\x18\x02 \x03(\v2,.shipping.GetShippingRatesRequestProductItemB\b\xbaH\x05\x92\x01\x02\b\x01R\bproducts\"\xb3\x01\n" +
+	"\x1cGetShippingRatesResponseItem\x12\x18\n" +
+	"\acourier\x18\x01 \x01(\tR\acourier\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aservice\x12\x16\n" +
+	"\x04cost\x18\x03 \x01(\x01B\x02\x18\x01R\x04cost\x12\x19\n" +
+	"\betd_days\x18\x04 \x01(\x03R\aetdDays\x12,\n" +
+	"\n" +
+	"cost_money\x18\x05 \x01(\v2\r.common.MoneyR\tcostMoney\"\xa3\x01\n" +
+	"\x18GetShippingRatesResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x1f\n" +
+	"\vweight_gram\x18\x02 \x01(\x03R\n" +
+	"weightGram\x12<\n" +
+	"\x05items\x18\x03 \x03(\v2&.shipping.GetShippingRatesResponseItemR\x05items2\xae\x03\n" +
+	"\x0fShippingService\x12P\n" +
+	"\rCreateAddress\x12\x1e.shipping.CreateAddressRequest\x1a\x1f.shipping.CreateAddressResponse\x12J\n" +
+	"\vListAddress\x12\x1c.shipping.ListAddressRequest\x1a\x1d.shipping.ListAddressResponse\x12P\n" +
+	"\rUpdateAddress\x12\x1e.shipping.UpdateAddressRequest\x1a\x1f.shipping.UpdateAddressResponse\x12P\n" +
+	"\rDeleteAddress\x12\x1e.shipping.DeleteAddressRequest\x1a\x1f.shipping.DeleteAddressResponse\x12Y\n" +
+	"\x10GetShippingRates\x12!.shipping.GetShippingRatesRequest\x1a\".shipping.GetShippingRatesResponseB7Z5github.com/luzmareto/go-grpc-ecommerce-be/pb/shippingb\x06proto3"
+
+var (
+	file_shipping_shipping_proto_rawDescOnce sync.Once
+	file_shipping_shipping_proto_rawDescData []byte
+)
+
+func file_shipping_shipping_proto_rawDescGZIP() []byte {
+	file_shipping_shipping_proto_rawDescOnce.Do(func() {
+		file_shipping_shipping_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_shipping_shipping_proto_rawDesc), len(file_shipping_shipping_proto_rawDesc)))
+	})
+	return file_shipping_shipping_proto_rawDescData
+}
+
+var file_shipping_shipping_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_shipping_shipping_proto_goTypes = []any{
+	(*CreateAddressRequest)(nil),               // 0: shipping.CreateAddressRequest
+	(*CreateAddressResponse)(nil),              // 1: shipping.CreateAddressResponse
+	(*ListAddressRequest)(nil),                 // 2: shipping.ListAddressRequest
+	(*ListAddressResponseItem)(nil),            // 3: shipping.ListAddressResponseItem
+	(*ListAddressResponse)(nil),                // 4: shipping.ListAddressResponse
+	(*UpdateAddressRequest)(nil),               // 5: shipping.UpdateAddressRequest
+	(*UpdateAddressResponse)(nil),              // 6: shipping.UpdateAddressResponse
+	(*DeleteAddressRequest)(nil),               // 7: shipping.DeleteAddressRequest
+	(*DeleteAddressResponse)(nil),              // 8: shipping.DeleteAddressResponse
+	(*GetShippingRatesRequestProductItem)(nil), // 9: shipping.GetShippingRatesRequestProductItem
+	(*GetShippingRatesRequest)(nil),            // 10: shipping.GetShippingRatesRequest
+	(*GetShippingRatesResponseItem)(nil),       // 11: shipping.GetShippingRatesResponseItem
+	(*GetShippingRatesResponse)(nil),           // 12: shipping.GetShippingRatesResponse
+	(*common.BaseResponse)(nil),                // 13: common.BaseResponse
+	(*common.Money)(nil),                       // 14: common.Money
+}
+var file_shipping_shipping_proto_depIdxs = []int32{
+	13, // 0: shipping.CreateAddressResponse.base:type_name -> common.BaseResponse
+	13, // 1: shipping.ListAddressResponse.base:type_name -> common.BaseResponse
+	3,  // 2: shipping.ListAddressResponse.items:type_name -> shipping.ListAddressResponseItem
+	13, // 3: shipping.UpdateAddressResponse.base:type_name -> common.BaseResponse
+	13, // 4: shipping.DeleteAddressResponse.base:type_name -> common.BaseResponse
+	9,  // 5: shipping.GetShippingRatesRequest.products:type_name -> shipping.GetShippingRatesRequestProductItem
+	14, // 6: shipping.GetShippingRatesResponseItem.cost_money:type_name -> common.Money
+	13, // 7: shipping.GetShippingRatesResponse.base:type_name -> common.BaseResponse
+	11, // 8: shipping.GetShippingRatesResponse.items:type_name -> shipping.GetShippingRatesResponseItem
+	0,  // 9: shipping.ShippingService.CreateAddress:input_type -> shipping.CreateAddressRequest
+	2,  // 10: shipping.ShippingService.ListAddress:input_type -> shipping.ListAddressRequest
+	5,  // 11: shipping.ShippingService.UpdateAddress:input_type -> shipping.UpdateAddressRequest
+	7,  // 12: shipping.ShippingService.DeleteAddress:input_type -> shipping.DeleteAddressRequest
+	10, // 13: shipping.ShippingService.GetShippingRates:input_type -> shipping.GetShippingRatesRequest
+	1,  // 14: shipping.ShippingService.CreateAddress:output_type -> shipping.CreateAddressResponse
+	4,  // 15: shipping.ShippingService.ListAddress:output_type -> shipping.ListAddressResponse
+	6,  // 16: shipping.ShippingService.UpdateAddress:output_type -> shipping.UpdateAddressResponse
+	8,  // 17: shipping.ShippingService.DeleteAddress:output_type -> shipping.DeleteAddressResponse
+	12, // 18: shipping.ShippingService.GetShippingRates:output_type -> shipping.GetShippingRatesResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_shipping_shipping_proto_init() }
+func file_shipping_shipping_proto_init() {
+	if File_shipping_shipping_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shipping_shipping_proto_rawDesc), len(file_shipping_shipping_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_shipping_shipping_proto_goTypes,
+		DependencyIndexes: file_shipping_shipping_proto_depIdxs,
+		MessageInfos:      file_shipping_shipping_proto_msgTypes,
+	}.Build()
+	File_shipping_shipping_proto = out.File
+	file_shipping_shipping_proto_goTypes = nil
+	file_shipping_shipping_proto_depIdxs = nil
+}
@@ -0,0 +1,1016 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.30.0--rc1
+// source: voucher/voucher.proto
+
+package voucher
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// max_discount, usage_limit dan per_user_usage_limit bernilai 0 berarti tanpa batas,
+// product_ids dan categories kosong berarti voucher berlaku untuk semua produk
+type CreateVoucherRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DiscountType  string                 `protobuf:"bytes,3,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	DiscountValue float64                `protobuf:"fixed64,4,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
+	// Deprecated: Marked as deprecated in voucher/voucher.proto.
+	MaxDiscount float64 `protobuf:"fixed64,5,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	// Deprecated: Marked as deprecated in voucher/voucher.proto.
+	MinSpend          float64                `protobuf:"fixed64,6,opt,name=min_spend,json=minSpend,proto3" json:"min_spend,omitempty"`
+	UsageLimit        int64                  `protobuf:"varint,7,opt,name=usage_limit,json=usageLimit,proto3" json:"usage_limit,omitempty"`
+	PerUserUsageLimit int64                  `protobuf:"varint,8,opt,name=per_user_usage_limit,json=perUserUsageLimit,proto3" json:"per_user_usage_limit,omitempty"`
+	StartsAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	ProductIds        []string               `protobuf:"bytes,11,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Categories        []string               `protobuf:"bytes,12,rep,name=categories,proto3" json:"categories,omitempty"`
+	IsActive          bool                   `protobuf:"varint,13,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	MaxDiscountMoney  *common.Money          `protobuf:"bytes,14,opt,name=max_discount_money,json=maxDiscountMoney,proto3" json:"max_discount_money,omitempty"`
+	MinSpendMoney     *common.Money          `protobuf:"bytes,15,opt,name=min_spend_money,json=minSpendMoney,proto3" json:"min_spend_money,omitempty"`
+	Currency          string                 `protobuf:"bytes,16,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateVoucherRequest) Reset() {
+	*x = CreateVoucherRequest{}
+	mi := &file_voucher_voucher_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVoucherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVoucherRequest) ProtoMessage() {}
+
+func (x *CreateVoucherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voucher_voucher_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVoucherRequest.ProtoReflect.Descriptor instead.
+func (*CreateVoucherRequest) Descriptor() ([]byte, []int) {
+	return file_voucher_voucher_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateVoucherRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateVoucherRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateVoucherRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *CreateVoucherRequest) GetDiscountValue() float64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in voucher/voucher.proto.
+func (x *CreateVoucherRequest) GetMaxDiscount() float64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in voucher/voucher.proto.
+func (x *CreateVoucherRequest) GetMinSpend() float64 {
+	if x != nil {
+		return x.MinSpend
+	}
+	return 0
+}
+
+func (x *CreateVoucherRequest) GetUsageLimit() int64 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *CreateVoucherRequest) GetPerUserUsageLimit() int64 {
+	if x != nil {
+		return x.PerUserUsageLimit
+	}
+	return 0
+}
+
+func (x *CreateVoucherRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreateVoucherRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CreateVoucherRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *CreateVoucherRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *CreateVoucherRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreateVoucherRequest) GetMaxDiscountMoney() *common.Money {
+	if x != nil {
+		return x.MaxDiscountMoney
+	}
+	return nil
+}
+
+func (x *CreateVoucherRequest) GetMinSpendMoney() *common.Money {
+	if x != nil {
+		return x.MinSpendMoney
+	}
+	return nil
+}
+
+func (x *CreateVoucherRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CreateVoucherResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVoucherResponse) Reset() {
+	*x = CreateVoucherResponse{}
+	mi := &file_voucher_voucher_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVoucherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVoucherResponse) ProtoMessage() {}
+
+func (x *CreateVoucherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_voucher_voucher_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVoucherResponse.ProtoReflect.Descriptor instead.
+func (*CreateVoucherResponse) Descriptor() ([]byte, []int) {
+	return file_voucher_voucher_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateVoucherResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateVoucherResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EditVoucherRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DiscountType  string                 `protobuf:"bytes,3,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	DiscountValue float64                `protobuf:"fixed64,4,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
+	// Deprecated: Marked as deprecated in voucher/voucher.proto.
+	MaxDiscount float64 `protobuf:"fixed64,5,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	// Deprecated: Marked as deprecated in voucher/voucher.proto.
+	MinSpend          float64                `protobuf:"fixed64,6,opt,name=min_spend,json=minSpend,proto3" json:"min_spend,omitempty"`
+	UsageLimit        int64                  `protobuf:"varint,7,opt,name=usage_limit,json=usageLimit,proto3" json:"usage_limit,omitempty"`
+	PerUserUsageLimit int64                  `protobuf:"varint,8,opt,name=per_user_usage_limit,json=perUserUsageLimit,proto3" json:"per_user_usage_limit,omitempty"`
+	StartsAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	ProductIds        []string               `protobuf:"bytes,11,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Categories        []string               `protobuf:"bytes,12,rep,name=categories,proto3" json:"categories,omitempty"`
+	IsActive          bool                   `protobuf:"varint,13,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	MaxDiscountMoney  *common.Money          `protobuf:"bytes,14,opt,name=max_discount_money,json=maxDiscountMoney,proto3" json:"max_discount_money,omitempty"`
+	MinSpendMoney     *common.Money          `protobuf:"bytes,15,opt,name=min_spend_money,json=minSpendMoney,proto3" json:"min_spend_money,omitempty"`
+	Currency          string                 `protobuf:"bytes,16,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EditVoucherRequest) Reset() {
+	*x = EditVoucherRequest{}
+	mi := &file_voucher_voucher_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditVoucherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditVoucherRequest) ProtoMessage() {}
+
+func (x *EditVoucherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voucher_voucher_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditVoucherRequest.ProtoReflect.Descriptor instead.
+func (*EditVoucherRequest) Descriptor() ([]byte, []int) {
+	return file_voucher_voucher_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EditVoucherRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EditVoucherRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EditVoucherRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *EditVoucherRequest) GetDiscountValue() float64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in voucher/voucher.proto.
+func (x *EditVoucherRequest) GetMaxDiscount() float64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in voucher/voucher.proto.
+func (x *EditVoucherRequest) GetMinSpend() float64 {
+	if x != nil {
+		return x.MinSpend
+	}
+	return 0
+}
+
+func (x *EditVoucherRequest) GetUsageLimit() int64 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *EditVoucherRequest) GetPerUserUsageLimit() int64 {
+	if x != nil {
+		return x.PerUserUsageLimit
+	}
+	return 0
+}
+
+func (x *EditVoucherRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *EditVoucherRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *EditVoucherRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *EditVoucherRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *EditVoucherRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *EditVoucherRequest) GetMaxDiscountMoney() *common.Money {
+	if x != nil {
+		return x.MaxDiscountMoney
+	}
+	return nil
+}
+
+func (x *EditVoucherRequest) GetMinSpendMoney() *common.Money {
+	if x != nil {
+		return x.MinSpendMoney
+	}
+	return nil
+}
+
+func (x *EditVoucherRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type EditVoucherResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditVoucherResponse) Reset() {
+	*x = EditVoucherResponse{}
+	mi := &file_voucher_voucher_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditVoucherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditVoucherResponse) ProtoMessage() {}
+
+func (x *EditVoucherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_voucher_voucher_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditVoucherResponse.ProtoReflect.Descriptor instead.
+func (*EditVoucherResponse) Descriptor() ([]byte, []int) {
+	return file_voucher_voucher_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EditVoucherResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type DeleteVoucherRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVoucherRequest) Reset() {
+	*x = DeleteVoucherRequest{}
+	mi := &file_voucher_voucher_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVoucherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVoucherRequest) ProtoMessage() {}
+
+func (x *DeleteVoucherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voucher_voucher_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVoucherRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVoucherRequest) Descriptor() ([]byte, []int) {
+	return file_voucher_voucher_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteVoucherRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteVoucherResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVoucherResponse) Reset() {
+	*x = DeleteVoucherResponse{}
+	mi := &file_voucher_voucher_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVoucherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVoucherResponse) ProtoMessage() {}
+
+func (x *DeleteVoucherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_voucher_voucher_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVoucherResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVoucherResponse) Descriptor() ([]byte, []int) {
+	return file_voucher_voucher_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteVoucherResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListVoucherAdminRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVoucherAdminRequest) Reset() {
+	*x = ListVoucherAdminRequest{}
+	mi := &file_voucher_voucher_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVoucherAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVoucherAdminRequest) ProtoMessage() {}
+
+func (x *ListVoucherAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_voucher_voucher_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVoucherAdminRequest.ProtoReflect.Descriptor instead.
+func (*ListVoucherAdminRequest) Descriptor() ([]byte, []int) {
+	return file_voucher_voucher_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListVoucherAdminRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListVoucherAdminResponseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DiscountType  string                 `protobuf:"bytes,4,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	DiscountValue float64                `protobuf:"fixed64,5,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
+	// Deprecated: Marked as deprecated in voucher/voucher.proto.
+	MaxDiscount float64 `protobuf:"fixed64,6,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	// Deprecated: Marked as deprecated in voucher/voucher.proto.
+	MinSpend          float64                `protobuf:"fixed64,7,opt,name=min_spend,json=minSpend,proto3" json:"min_spend,omitempty"`
+	UsageLimit        int64                  `protobuf:"varint,8,opt,name=usage_limit,json=usageLimit,proto3" json:"usage_limit,omitempty"`
+	PerUserUsageLimit int64                  `protobuf:"varint,9,opt,name=per_user_usage_limit,json=perUserUsageLimit,proto3" json:"per_user_usage_limit,omitempty"`
+	StartsAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	ProductIds        []string               `protobuf:"bytes,12,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Categories        []string               `protobuf:"bytes,13,rep,name=categories,proto3" json:"categories,omitempty"`
+	IsActive          bool                   `protobuf:"varint,14,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	MaxDiscountMoney  *common.Money          `protobuf:"bytes,15,opt,name=max_discount_money,json=maxDiscountMoney,proto3" json:"max_discount_money,omitempty"`
+	MinSpendMoney     *common.Money          `protobuf:"bytes,16,opt,name=min_spend_money,json=minSpendMoney,proto3" json:"min_spend_money,omitempty"`
+	Currency          string                 `protobuf:"bytes,17,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListVoucherAdminResponseItem) Reset() {
+	*x = ListVoucherAdminResponseItem{}
+	mi := &file_voucher_voucher_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVoucherAdminResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVoucherAdminResponseItem) ProtoMessage() {}
+
+func (x *ListVoucherAdminResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_voucher_voucher_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVoucherAdminResponseItem.ProtoReflect.Descriptor instead.
+func (*ListVoucherAdminResponseItem) Descriptor() ([]byte, []int) {
+	return file_voucher_voucher_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListVoucherAdminResponseItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListVoucherAdminResponseItem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListVoucherAdminResponseItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ListVoucherAdminResponseItem) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *ListVoucherAdminResponseItem) GetDiscountValue() float64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in voucher/voucher.proto.
+func (x *ListVoucherAdminResponseItem) GetMaxDiscount() float64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in voucher/voucher.proto.
+func (x *ListVoucherAdminResponseItem) GetMinSpend() float64 {
+	if x != nil {
+		return x.MinSpend
+	}
+	return 0
+}
+
+func (x *ListVoucherAdminResponseItem) GetUsageLimit() int64 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *ListVoucherAdminResponseItem) GetPerUserUsageLimit() int64 {
+	if x != nil {
+		return x.PerUserUsageLimit
+	}
+	return 0
+}
+
+func (x *ListVoucherAdminResponseItem) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *ListVoucherAdminResponseItem) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *ListVoucherAdminResponseItem) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *ListVoucherAdminResponseItem) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListVoucherAdminResponseItem) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ListVoucherAdminResponseItem) GetMaxDiscountMoney() *common.Money {
+	if x != nil {
+		return x.MaxDiscountMoney
+	}
+	return nil
+}
+
+func (x *ListVoucherAdminResponseItem) GetMinSpendMoney() *common.Money {
+	if x != nil {
+		return x.MinSpendMoney
+	}
+	return nil
+}
+
+func (x *ListVoucherAdminResponseItem) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ListVoucherAdminResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Base          *common.BaseResponse            `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Items         []*ListVoucherAdminResponseItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVoucherAdminResponse) Reset() {
+	*x = ListVoucherAdminResponse{}
+	mi := &file_voucher_voucher_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVoucherAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVoucherAdminResponse) ProtoMessage() {}
+
+func (x *ListVoucherAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_voucher_voucher_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVoucherAdminResponse.ProtoReflect.Descriptor instead.
+func (*ListVoucherAdminResponse) Descriptor() ([]byte, []int) {
+	return file_voucher_voucher_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListVoucherAdminResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListVoucherAdminResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListVoucherAdminResponse) GetItems() []*ListVoucherAdminResponseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_voucher_voucher_proto protoreflect.FileDescriptor
+
+const file_voucher_voucher_proto_rawDesc = "" +
+	"\n" +
+	"\x15voucher/voucher.proto\x12\avoucher\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaa\x06\n" +
+	"\x14CreateVoucherRequest\x12\x1d\n" +
+	"\x04code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x04code\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\vdescription\x12S\n" +
+	"\rdiscount_type\x18\x03 \x01(\tB.\xbaH+r)R\n" +
+	"percentageR\ffixed_amountR\rfree_shippingR\fdiscountType\x125\n" +
+	"\x0ediscount_value\x18\x04 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\rdiscountValue\x123\n" +
+	"\fmax_discount\x18\x05 \x01(\x01B\x10\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00\x18\x01R\vmaxDiscount\x12-\n" +
+	"\tmin_spend\x18\x06 \x01(\x01B\x10\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00\x18\x01R\bminSpend\x12(\n" +
+	"\vusage_limit\x18\a \x01(\x03B\a\xbaH\x04\"\x02(\x00R\n" +
+	"usageLimit\x128\n" +
+	"\x14per_user_usage_limit\x18\b \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x11perUserUsageLimit\x12?\n" +
+	"\tstarts_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\bstartsAt\x12;\n" +
+	"\aends_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x06endsAt\x12\x1f\n" +
+	"\vproduct_ids\x18\v \x03(\tR\n" +
+	"productIds\x12\x1e\n" +
+	"\n" +
+	"categories\x18\f \x03(\tR\n" +
+	"categories\x12\x1b\n" +
+	"\tis_active\x18\r \x01(\bR\bisActive\x12;\n" +
+	"\x12max_discount_money\x18\x0e \x01(\v2\r.common.MoneyR\x10maxDiscountMoney\x125\n" +
+	"\x0fmin_spend_money\x18\x0f \x01(\v2\r.common.MoneyR\rminSpendMoney\x12#\n" +
+	"\bcurrency\x18\x10 \x01(\tB\a\xbaH\x04r\x02\x18\x03R\bcurrency\"Q\n" +
+	"\x15CreateVoucherResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xa5\x06\n" +
+	"\x12EditVoucherRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\vdescription\x12S\n" +
+	"\rdiscount_type\x18\x03 \x01(\tB.\xbaH+r)R\n" +
+	"percentageR\ffixed_amountR\rfree_shippingR\fdiscountType\x125\n" +
+	"\x0ediscount_value\x18\x04 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\rdiscountValue\x123\n" +
+	"\fmax_discount\x18\x05 \x01(\x01B\x10\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00\x18\x01R\vmaxDiscount\x12-\n" +
+	"\tmin_spend\x18\x06 \x01(\x01B\x10\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00\x18\x01R\bminSpend\x12(\n" +
+	"\vusage_limit\x18\a \x01(\x03B\a\xbaH\x04\"\x02(\x00R\n" +
+	"usageLimit\x128\n" +
+	"\x14per_user_usage_limit\x18\b \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x11perUserUsageLimit\x12?\n" +
+	"\tstarts_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\bstartsAt\x12;\n" +
+	"\aends_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x06endsAt\x12\x1f\n" +
+	"\vproduct_ids\x18\v \x03(\tR\n" +
+	"productIds\x12\x1e\n" +
+	"\n" +
+	"categories\x18\f \x03(\tR\n" +
+	"categories\x12\x1b\n" +
+	"\tis_active\x18\r \x01(\bR\bisActive\x12;\n" +
+	"\x12max_discount_money\x18\x0e \x01(\v2\r.common.MoneyR\x10maxDiscountMoney\x125\n" +
+	"\x0fmin_spend_money\x18\x0f \x01(\v2\r.common.MoneyR\rminSpendMoney\x12#\n" +
+	"\bcurrency\x18\x10 \x01(\tB\a\xbaH\x04r\x02\x18\x03R\bcurrency\"?\n" +
+	"\x13EditVoucherResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"2\n" +
+	"\x14DeleteVoucherRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"A\n" +
+	"\x15DeleteVoucherResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\\\n" +
+	"\x17ListVoucherAdminRequest\x12A\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"pagination\"\xa6\x05\n" +
+	"\x1cListVoucherAdminResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rdiscount_type\x18\x04 \x01(\tR\fdiscountType\x12%\n" +
+	"\x0ediscount_value\x18\x05 \x01(\x01R\rdiscountValue\x12%\n" +
+	"\fmax_discount\x18\x06 \x01(\x01B\x02\x18\x01R\vmaxDiscount\x12\x1f\n" +
+	"\tmin_spend\x18\a \x01(\x01B\x02\x18\x01R\bminSpend\x12\x1f\n" +
+	"\vusage_limit\x18\b \x01(\x03R\n" +
+	"usageLimit\x12/\n" +
+	"\x14per_user_usage_limit\x18\t \x01(\x03R\x11perUserUsageLimit\x127\n" +
+	"\tstarts_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1f\n" +
+	"\vproduct_ids\x18\f \x03(\tR\n" +
+	"productIds\x12\x1e\n" +
+	"\n" +
+	"categories\x18\r \x03(\tR\n" +
+	"categories\x12\x1b\n" +
+	"\tis_active\x18\x0e \x01(\bR\bisActive\x12;\n" +
+	"\x12max_discount_money\x18\x0f \x01(\v2\r.common.MoneyR\x10maxDiscountMoney\x125\n" +
+	"\x0fmin_spend_money\x18\x10 \x01(\v2\r.common.MoneyR\rminSpendMoney\x12\x1a\n" +
+	"\bcurrency\x18\x11 \x01(\tR\bcurrency\"\xbd\x01\n" +
+	"\x18ListVoucherAdminResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\x12;\n" +
+	"\x05items\x18\x03 \x03(\v2%.voucher.ListVoucherAdminResponseItemR\x05items2\xd3\x02\n" +
+	"\x0eVoucherService\x12N\n" +
+	"\rCreateVoucher\x12\x1d.voucher.CreateVoucherRequest\x1a\x1e.voucher.CreateVoucherResponse\x12H\n" +
+	"\vEditVoucher\x12\x1b.voucher.EditVoucherRequest\x1a\x1c.voucher.EditVoucherResponse\x12N\n" +
+	"\rDeleteVoucher\x12\x1d.voucher.DeleteVoucherRequest\x1a\x1e.voucher.DeleteVoucherResponse\x12W\n" +
+	"\x10ListVoucherAdmin\x12 .voucher.ListVoucherAdminRequest\x1a!.voucher.ListVoucherAdminResponseB6Z4github.com/luzmareto/go-grpc-ecommerce-be/pb/voucherb\x06proto3"
+
+var (
+	file_voucher_voucher_proto_rawDescOnce sync.Once
+	file_voucher_voucher_proto_rawDescData []byte
+)
+
+func file_voucher_voucher_proto_rawDescGZIP() []byte {
+	file_voucher_voucher_proto_rawDescOnce.Do(func() {
+		file_voucher_voucher_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_voucher_voucher_proto_rawDesc), len(file_voucher_voucher_proto_rawDesc)))
+	})
+	return file_voucher_voucher_proto_rawDescData
+}
+
+var file_voucher_voucher_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_voucher_voucher_proto_goTypes = []any{
+	(*CreateVoucherRequest)(nil),         // 0: voucher.CreateVoucherRequest
+	(*CreateVoucherResponse)(nil),        // 1: voucher.CreateVoucherResponse
+	(*EditVoucherRequest)(nil),           // 2: voucher.EditVoucherRequest
+	(*EditVoucherResponse)(nil),          // 3: voucher.EditVoucherResponse
+	(*DeleteVoucherRequest)(nil),         // 4: voucher.DeleteVoucherRequest
+	(*DeleteVoucherResponse)(nil),        // 5: voucher.DeleteVoucherResponse
+	(*ListVoucherAdminRequest)(nil),      // 6: voucher.ListVoucherAdminRequest
+	(*ListVoucherAdminResponseItem)(nil), // 7: voucher.ListVoucherAdminResponseItem
+	(*ListVoucherAdminResponse)(nil),     // 8: voucher.ListVoucherAdminResponse
+	(*timestamppb.Timestamp)(nil),        // 9: google.protobuf.Timestamp
+	(*common.Money)(nil),                 // 10: common.Money
+	(*common.BaseResponse)(nil),          // 11: common.BaseResponse
+	(*common.PaginationRequest)(nil),     // 12: common.PaginationRequest
+	(*common.PaginationResponse)(nil),    // 13: common.PaginationResponse
+}
+var file_voucher_voucher_proto_depIdxs = []int32{
+	9,  // 0: voucher.CreateVoucherRequest.starts_at:type_name -> google.protobuf.Timestamp
+	9,  // 1: voucher.CreateVoucherRequest.ends_at:type_name -> google.protobuf.Timestamp
+	10, // 2: voucher.CreateVoucherRequest.max_discount_money:type_name -> common.Money
+	10, // 3: voucher.CreateVoucherRequest.min_spend_money:type_name -> common.Money
+	11, // 4: voucher.CreateVoucherResponse.base:type_name -> common.BaseResponse
+	9,  // 5: voucher.EditVoucherRequest.starts_at:type_name -> google.protobuf.Timestamp
+	9,  // 6: voucher.EditVoucherRequest.ends_at:type_name -> google.protobuf.Timestamp
+	10, // 7: voucher.EditVoucherRequest.max_discount_money:type_name -> common.Money
+	10, // 8: voucher.EditVoucherRequest.min_spend_money:type_name -> common.Money
+	11, // 9: voucher.EditVoucherResponse.base:type_name -> common.BaseResponse
+	11, // 10: voucher.DeleteVoucherResponse.base:type_name -> common.BaseResponse
+	12, // 11: voucher.ListVoucherAdminRequest.pagination:type_name -> common.PaginationRequest
+	9,  // 12: voucher.ListVoucherAdminResponseItem.starts_at:type_name -> google.protobuf.Timestamp
+	9,  // 13: voucher.ListVoucherAdminResponseItem.ends_at:type_name -> google.protobuf.Timestamp
+	10, // 14: voucher.ListVoucherAdminResponseItem.max_discount_money:type_name -> common.Money
+	10, // 15: voucher.ListVoucherAdminResponseItem.min_spend_money:type_name -> common.Money
+	11, // 16: voucher.ListVoucherAdminResponse.base:type_name -> common.BaseResponse
+	13, // 17: voucher.ListVoucherAdminResponse.pagination:type_name -> common.PaginationResponse
+	7,  // 18: voucher.ListVoucherAdminResponse.items:type_name -> voucher.ListVoucherAdminResponseItem
+	0,  // 19: voucher.VoucherService.CreateVoucher:input_type -> voucher.CreateVoucherRequest
+	2,  // 20: voucher.VoucherService.EditVoucher:input_type -> voucher.EditVoucherRequest
+	4,  // 21: voucher.VoucherService.DeleteVoucher:input_type -> voucher.DeleteVoucherRequest
+	6,  // 22: voucher.VoucherService.ListVoucherAdmin:input_type -> voucher.ListVoucherAdminRequest
+	1,  // 23: voucher.VoucherService.CreateVoucher:output_type -> voucher.CreateVoucherResponse
+	3,  // 24: voucher.VoucherService.EditVoucher:output_type -> voucher.EditVoucherResponse
+	5,  // 25: voucher.VoucherService.DeleteVoucher:output_type -> voucher.DeleteVoucherResponse
+	8,  // 26: voucher.VoucherService.ListVoucherAdmin:output_type -> voucher.ListVoucherAdminResponse
+	23, // [23:27] is the sub-list for method output_type
+	19, // [19:23] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_voucher_voucher_proto_init() }
+func file_voucher_voucher_proto_init() {
+	if File_voucher_voucher_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_voucher_voucher_proto_rawDesc), len(file_voucher_voucher_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_voucher_voucher_proto_goTypes,
+		DependencyIndexes: file_voucher_voucher_proto_depIdxs,
+		MessageInfos:      file_voucher_voucher_proto_msgTypes,
+	}.Build()
+	File_voucher_voucher_proto = out.File
+	file_voucher_voucher_proto_goTypes = nil
+	file_voucher_voucher_proto_depIdxs = nil
+}
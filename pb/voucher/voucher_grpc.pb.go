@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.0--rc1
+// source: voucher/voucher.proto
+
+package voucher
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	VoucherService_CreateVoucher_FullMethodName    = "/voucher.VoucherService/CreateVoucher"
+	VoucherService_EditVoucher_FullMethodName      = "/voucher.VoucherService/EditVoucher"
+	VoucherService_DeleteVoucher_FullMethodName    = "/voucher.VoucherService/DeleteVoucher"
+	VoucherService_ListVoucherAdmin_FullMethodName = "/voucher.VoucherService/ListVoucherAdmin"
+)
+
+// VoucherServiceClient is the client API for VoucherService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VoucherServiceClient interface {
+	CreateVoucher(ctx context.Context, in *CreateVoucherRequest, opts ...grpc.CallOption) (*CreateVoucherResponse, error)
+	EditVoucher(ctx context.Context, in *EditVoucherRequest, opts ...grpc.CallOption) (*EditVoucherResponse, error)
+	DeleteVoucher(ctx context.Context, in *DeleteVoucherRequest, opts ...grpc.CallOption) (*DeleteVoucherResponse, error)
+	ListVoucherAdmin(ctx context.Context, in *ListVoucherAdminRequest, opts ...grpc.CallOption) (*ListVoucherAdminResponse, error)
+}
+
+type voucherServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVoucherServiceClient(cc grpc.ClientConnInterface) VoucherServiceClient {
+	return &voucherServiceClient{cc}
+}
+
+func (c *voucherServiceClient) CreateVoucher(ctx context.Context, in *CreateVoucherRequest, opts ...grpc.CallOption) (*CreateVoucherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVoucherResponse)
+	err := c.cc.Invoke(ctx, VoucherService_CreateVoucher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *voucherServiceClient) EditVoucher(ctx context.Context, in *EditVoucherRequest, opts ...grpc.CallOption) (*EditVoucherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditVoucherResponse)
+	err := c.cc.Invoke(ctx, VoucherService_EditVoucher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *voucherServiceClient) DeleteVoucher(ctx context.Context, in *DeleteVoucherRequest, opts ...grpc.CallOption) (*DeleteVoucherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVoucherResponse)
+	err := c.cc.Invoke(ctx, VoucherService_DeleteVoucher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *voucherServiceClient) ListVoucherAdmin(ctx context.Context, in *ListVoucherAdminRequest, opts ...grpc.CallOption) (*ListVoucherAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVoucherAdminResponse)
+	err := c.cc.Invoke(ctx, VoucherService_ListVoucherAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VoucherServiceServer is the server API for VoucherService service.
+// All implementations must embed UnimplementedVoucherServiceServer
+// for forward compatibility.
+type VoucherServiceServer interface {
+	CreateVoucher(context.Context, *CreateVoucherRequest) (*CreateVoucherResponse, error)
+	EditVoucher(context.Context, *EditVoucherRequest) (*EditVoucherResponse, error)
+	DeleteVoucher(context.Context, *DeleteVoucherRequest) (*DeleteVoucherResponse, error)
+	ListVoucherAdmin(context.Context, *ListVoucherAdminRequest) (*ListVoucherAdminResponse, error)
+	mustEmbedUnimplementedVoucherServiceServer()
+}
+
+// UnimplementedVoucherServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVoucherServiceServer struct{}
+
+func (UnimplementedVoucherServiceServer) CreateVoucher(context.Context, *CreateVoucherRequest) (*CreateVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVoucher not implemented")
+}
+func (UnimplementedVoucherServiceServer) EditVoucher(context.Context, *EditVoucherRequest) (*EditVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditVoucher not implemented")
+}
+func (UnimplementedVoucherServiceServer) DeleteVoucher(context.Context, *DeleteVoucherRequest) (*DeleteVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVoucher not implemented")
+}
+func (UnimplementedVoucherServiceServer) ListVoucherAdmin(context.Context, *ListVoucherAdminRequest) (*ListVoucherAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVoucherAdmin not implemented")
+}
+func (UnimplementedVoucherServiceServer) mustEmbedUnimplementedVoucherServiceServer() {}
+func (UnimplementedVoucherServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeVoucherServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VoucherServiceServer will
+// result in compilation errors.
+type UnsafeVoucherServiceServer interface {
+	mustEmbedUnimplementedVoucherServiceServer()
+}
+
+func RegisterVoucherServiceServer(s grpc.ServiceRegistrar, srv VoucherServiceServer) {
+	// If the following call pancis, it indicates UnimplementedVoucherServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VoucherService_ServiceDesc, srv)
+}
+
+func _VoucherService_CreateVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVoucherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoucherServiceServer).CreateVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VoucherService_CreateVoucher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VoucherServiceServer).CreateVoucher(ctx, req.(*CreateVoucherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VoucherService_EditVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditVoucherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoucherServiceServer).EditVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VoucherService_EditVoucher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VoucherServiceServer).EditVoucher(ctx, req.(*EditVoucherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VoucherService_DeleteVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVoucherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoucherServiceServer).DeleteVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VoucherService_DeleteVoucher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VoucherServiceServer).DeleteVoucher(ctx, req.(*DeleteVoucherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VoucherService_ListVoucherAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVoucherAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoucherServiceServer).ListVoucherAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VoucherService_ListVoucherAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VoucherServiceServer).ListVoucherAdmin(ctx, req.(*ListVoucherAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VoucherService_ServiceDesc is the grpc.ServiceDesc for VoucherService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VoucherService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "voucher.VoucherService",
+	HandlerType: (*VoucherServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVoucher",
+			Handler:    _VoucherService_CreateVoucher_Handler,
+		},
+		{
+			MethodName: "EditVoucher",
+			Handler:    _VoucherService_EditVoucher_Handler,
+		},
+		{
+			MethodName: "DeleteVoucher",
+			Handler:    _VoucherService_DeleteVoucher_Handler,
+		},
+		{
+			MethodName: "ListVoucherAdmin",
+			Handler:    _VoucherService_ListVoucherAdmin_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "voucher/voucher.proto",
+}
@@ -0,0 +1,658 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: wishlist/wishlist.proto
+
+package wishlist
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/luzmareto/go-grpc-ecommerce-be/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddToWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToWishlistRequest) Reset() {
+	*x = AddToWishlistRequest{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWishlistRequest) ProtoMessage() {}
+
+func (x *AddToWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWishlistRequest.ProtoReflect.Descriptor instead.
+func (*AddToWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddToWishlistRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type AddToWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToWishlistResponse) Reset() {
+	*x = AddToWishlistResponse{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWishlistResponse) ProtoMessage() {}
+
+func (x *AddToWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWishlistResponse.ProtoReflect.Descriptor instead.
+func (*AddToWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddToWishlistResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *AddToWishlistResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveFromWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWishlistRequest) Reset() {
+	*x = RemoveFromWishlistRequest{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWishlistRequest) ProtoMessage() {}
+
+func (x *RemoveFromWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWishlistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RemoveFromWishlistRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type RemoveFromWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWishlistResponse) Reset() {
+	*x = RemoveFromWishlistResponse{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWishlistResponse) ProtoMessage() {}
+
+func (x *RemoveFromWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWishlistResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFromWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemoveFromWishlistResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListWishlistRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Currency      string                    `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistRequest) Reset() {
+	*x = ListWishlistRequest{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistRequest) ProtoMessage() {}
+
+func (x *ListWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistRequest.ProtoReflect.Descriptor instead.
+func (*ListWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListWishlistRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListWishlistRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ListWishlistResponseItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId       string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName     string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	ProductImageUrl string                 `protobuf:"bytes,4,opt,name=product_image_url,json=productImageUrl,proto3" json:"product_image_url,omitempty"`
+	// harga produk saat ini
+	ProductPrice *common.Money `protobuf:"bytes,5,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"`
+	Available    bool          `protobuf:"varint,6,opt,name=available,proto3" json:"available,omitempty"`
+	// product_deleted atau product_not_found jika available false
+	UnavailableReason string                 `protobuf:"bytes,7,opt,name=unavailable_reason,json=unavailableReason,proto3" json:"unavailable_reason,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListWishlistResponseItem) Reset() {
+	*x = ListWishlistResponseItem{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistResponseItem) ProtoMessage() {}
+
+func (x *ListWishlistResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistResponseItem.ProtoReflect.Descriptor instead.
+func (*ListWishlistResponseItem) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListWishlistResponseItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListWishlistResponseItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListWishlistResponseItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ListWishlistResponseItem) GetProductImageUrl() string {
+	if x != nil {
+		return x.ProductImageUrl
+	}
+	return ""
+}
+
+func (x *ListWishlistResponseItem) GetProductPrice() *common.Money {
+	if x != nil {
+		return x.ProductPrice
+	}
+	return nil
+}
+
+func (x *ListWishlistResponseItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *ListWishlistResponseItem) GetUnavailableReason() string {
+	if x != nil {
+		return x.UnavailableReason
+	}
+	return ""
+}
+
+func (x *ListWishlistResponseItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListWishlistResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Base          *common.BaseResponse        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Items         []*ListWishlistResponseItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistResponse) Reset() {
+	*x = ListWishlistResponse{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistResponse) ProtoMessage() {}
+
+func (x *ListWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistResponse.ProtoReflect.Descriptor instead.
+func (*ListWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListWishlistResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListWishlistResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListWishlistResponse) GetItems() []*ListWishlistResponseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type MoveWishlistToCartRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// 0 diperlakukan sebagai 1
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveWishlistToCartRequest) Reset() {
+	*x = MoveWishlistToCartRequest{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveWishlistToCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveWishlistToCartRequest) ProtoMessage() {}
+
+func (x *MoveWishlistToCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveWishlistToCartRequest.ProtoReflect.Descriptor instead.
+func (*MoveWishlistToCartRequest) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MoveWishlistToCartRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *MoveWishlistToCartRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type MoveWishlistToCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CartId        string                 `protobuf:"bytes,2,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveWishlistToCartResponse) Reset() {
+	*x = MoveWishlistToCartResponse{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveWishlistToCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveWishlistToCartResponse) ProtoMessage() {}
+
+func (x *MoveWishlistToCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveWishlistToCartResponse.ProtoReflect.Descriptor instead.
+func (*MoveWishlistToCartResponse) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MoveWishlistToCartResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *MoveWishlistToCartResponse) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+var File_wishlist_wishlist_proto protoreflect.FileDescriptor
+
+const file_wishlist_wishlist_proto_rawDesc = "" +
+	"\n" +
+	"\x17wishlist/wishlist.proto\x12\bwishlist\x1a\x1acommon/base_response.proto\x1a\x12common/money.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"?\n" +
+	"\x14AddToWishlistRequest\x12'\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tproductId\"Q\n" +
+	"\x15AddToWishlistResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"D\n" +
+	"\x19RemoveFromWishlistRequest\x12'\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tproductId\"F\n" +
+	"\x1aRemoveFromWishlistResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"}\n" +
+	"\x13ListWishlistRequest\x12A\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"pagination\x12#\n" +
+	"\bcurrency\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18\x03R\bcurrency\"\xd4\x02\n" +
+	"\x18ListWishlistResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12*\n" +
+	"\x11product_image_url\x18\x04 \x01(\tR\x0fproductImageUrl\x122\n" +
+	"\rproduct_price\x18\x05 \x01(\v2\r.common.MoneyR\fproductPrice\x12\x1c\n" +
+	"\tavailable\x18\x06 \x01(\bR\tavailable\x12-\n" +
+	"\x12unavailable_reason\x18\a \x01(\tR\x11unavailableReason\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb6\x01\n" +
+	"\x14ListWishlistResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\x128\n" +
+	"\x05items\x18\x03 \x03(\v2\".wishlist.ListWishlistResponseItemR\x05items\"l\n" +
+	"\x19MoveWishlistToCartRequest\x12'\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tproductId\x12&\n" +
+	"\bquantity\x18\x02 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xe8\a(\x00R\bquantity\"_\n" +
+	"\x1aMoveWishlistToCartResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x17\n" +
+	"\acart_id\x18\x02 \x01(\tR\x06cartId2\xf4\x02\n" +
+	"\x0fWishlistService\x12P\n" +
+	"\rAddToWishlist\x12\x1e.wishlist.AddToWishlistRequest\x1a\x1f.wishlist.AddToWishlistResponse\x12_\n" +
+	"\x12RemoveFromWishlist\x12#.wishlist.RemoveFromWishlistRequest\x1a$.wishlist.RemoveFromWishlistResponse\x12M\n" +
+	"\fListWishlist\x12\x1d.wishlist.ListWishlistRequest\x1a\x1e.wishlist.ListWishlistResponse\x12_\n" +
+	"\x12MoveWishlistToCart\x12#.wishlist.MoveWishlistToCartRequest\x1a$.wishlist.MoveWishlistToCartResponseB7Z5github.com/luzmareto/go-grpc-ecommerce-be/pb/wishlistb\x06proto3"
+
+var (
+	file_wishlist_wishlist_proto_rawDescOnce sync.Once
+	file_wishlist_wishlist_proto_rawDescData []byte
+)
+
+func file_wishlist_wishlist_proto_rawDescGZIP() []byte {
+	file_wishlist_wishlist_proto_rawDescOnce.Do(func() {
+		file_wishlist_wishlist_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_wishlist_wishlist_proto_rawDesc), len(file_wishlist_wishlist_proto_rawDesc)))
+	})
+	return file_wishlist_wishlist_proto_rawDescData
+}
+
+var file_wishlist_wishlist_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_wishlist_wishlist_proto_goTypes = []any{
+	(*AddToWishlistRequest)(nil),       // 0: wishlist.AddToWishlistRequest
+	(*AddToWishlistResponse)(nil),      // 1: wishlist.AddToWishlistResponse
+	(*RemoveFromWishlistRequest)(nil),  // 2: wishlist.RemoveFromWishlistRequest
+	(*RemoveFromWishlistResponse)(nil), // 3: wishlist.RemoveFromWishlistResponse
+	(*ListWishlistRequest)(nil),        // 4: wishlist.ListWishlistRequest
+	(*ListWishlistResponseItem)(nil),   // 5: wishlist.ListWishlistResponseItem
+	(*ListWishlistResponse)(nil),       // 6: wishlist.ListWishlistResponse
+	(*MoveWishlistToCartRequest)(nil),  // 7: wishlist.MoveWishlistToCartRequest
+	(*MoveWishlistToCartResponse)(nil), // 8: wishlist.MoveWishlistToCartResponse
+	(*common.BaseResponse)(nil),        // 9: common.BaseResponse
+	(*common.PaginationRequest)(nil),   // 10: common.PaginationRequest
+	(*common.Money)(nil),               // 11: common.Money
+	(*timestamppb.Timestamp)(nil),      // 12: google.protobuf.Timestamp
+	(*common.PaginationResponse)(nil),  // 13: common.PaginationResponse
+}
+var file_wishlist_wishlist_proto_depIdxs = []int32{
+	9,  // 0: wishlist.AddToWishlistResponse.base:type_name -> common.BaseResponse
+	9,  // 1: wishlist.RemoveFromWishlistResponse.base:type_name -> common.BaseResponse
+	10, // 2: wishlist.ListWishlistRequest.pagination:type_name -> common.PaginationRequest
+	11, // 3: wishlist.ListWishlistResponseItem.product_price:type_name -> common.Money
+	12, // 4: wishlist.ListWishlistResponseItem.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 5: wishlist.ListWishlistResponse.base:type_name -> common.BaseResponse
+	13, // 6: wishlist.ListWishlistResponse.pagination:type_name -> common.PaginationResponse
+	5,  // 7: wishlist.ListWishlistResponse.items:type_name -> wishlist.ListWishlistResponseItem
+	9,  // 8: wishlist.MoveWishlistToCartResponse.base:type_name -> common.BaseResponse
+	0,  // 9: wishlist.WishlistService.AddToWishlist:input_type -> wishlist.AddToWishlistRequest
+	2,  // 10: wishlist.WishlistService.RemoveFromWishlist:input_type -> wishlist.RemoveFromWishlistRequest
+	4,  // 11: wishlist.WishlistService.ListWishlist:input_type -> wishlist.ListWishlistRequest
+	7,  // 12: wishlist.WishlistService.MoveWishlistToCart:input_type -> wishlist.MoveWishlistToCartRequest
+	1,  // 13: wishlist.WishlistService.AddToWishlist:output_type -> wishlist.AddToWishlistResponse
+	3,  // 14: wishlist.WishlistService.RemoveFromWishlist:output_type -> wishlist.RemoveFromWishlistResponse
+	6,  // 15: wishlist.WishlistService.ListWishlist:output_type -> wishlist.ListWishlistResponse
+	8,  // 16: wishlist.WishlistService.MoveWishlistToCart:output_type -> wishlist.MoveWishlistToCartResponse
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_wishlist_wishlist_proto_init() }
+func file_wishlist_wishlist_proto_init() {
+	if File_wishlist_wishlist_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wishlist_wishlist_proto_rawDesc), len(file_wishlist_wishlist_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_wishlist_wishlist_proto_goTypes,
+		DependencyIndexes: file_wishlist_wishlist_proto_depIdxs,
+		MessageInfos:      file_wishlist_wishlist_proto_msgTypes,
+	}.Build()
+	File_wishlist_wishlist_proto = out.File
+	file_wishlist_wishlist_proto_goTypes = nil
+	file_wishlist_wishlist_proto_depIdxs = nil
+}
@@ -2,10 +2,13 @@ package database
 
 import (
 	"context"
+	"log/slog"
 
 	_ "github.com/lib/pq"
 
 	"database/sql"
+
+	"github.com/luzmareto/go-grpc-ecommerce-be/internal/metrics"
 )
 
 func ConnectDB(ctx context.Context, connstr string) *sql.DB {
@@ -19,5 +22,11 @@ func ConnectDB(ctx context.Context, connstr string) *sql.DB {
 		panic(err)
 	}
 
+	// statistik pool koneksi ditampilkan di endpoint /metrics
+	err = metrics.RegisterDBStats(db, "postgres")
+	if err != nil {
+		slog.Warn("register database metrics failed", "error", err)
+	}
+
 	return db
 }